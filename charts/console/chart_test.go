@@ -31,6 +31,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
+	"github.com/redpanda-data/redpanda-operator/gotohelm/gotohelmtest"
 	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
 	"github.com/redpanda-data/redpanda-operator/pkg/helm"
 	"github.com/redpanda-data/redpanda-operator/pkg/helm/helmtest"
@@ -254,6 +255,22 @@ func TestGoHelmEquivalence(t *testing.T) {
 	}
 }
 
+// TestGoHelmEquivalenceProperties property tests that the go and helm
+// renderers of the console chart are equivalent for values generated from its
+// JSON schema.
+func TestGoHelmEquivalenceProperties(t *testing.T) {
+	gotohelmtest.AssertGoHelmEquivalence(t, Chart, gotohelmtest.Options{
+		Scheme: Scheme,
+		Overrides: map[string]any{
+			"tests": map[string]any{"enabled": false},
+			// jwtSigningKey defaults to a random string.
+			"secret": map[string]any{
+				"authentication": map[string]any{"jwtSigningKey": "SECRET"},
+			},
+		},
+	})
+}
+
 func TestSecrets(t *testing.T) {
 	values := PartialValues{
 		Tests: &PartialEnableable{
@@ -5,7 +5,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
-{{- $listenPort := ((8080 | int) | int) -}}
+{{- $listenPort := 8080 -}}
 {{- if (ne (toJson $values.service.targetPort) "null") -}}
 {{- $listenPort = $values.service.targetPort -}}
 {{- end -}}
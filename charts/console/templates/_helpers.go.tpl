@@ -7,7 +7,7 @@
 {{- $values := $dot.Values.AsMap -}}
 {{- $name := (default $dot.Chart.Name $values.nameOverride) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "console.cleanForK8s" (dict "a" (list $name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -19,17 +19,17 @@
 {{- $values := $dot.Values.AsMap -}}
 {{- if (ne $values.fullnameOverride "") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "console.cleanForK8s" (dict "a" (list $values.fullnameOverride)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $values.fullnameOverride))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $name := (default $dot.Chart.Name $values.nameOverride) -}}
 {{- if (contains $name $dot.Release.Name) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "console.cleanForK8s" (dict "a" (list $dot.Release.Name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $dot.Release.Name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "console.cleanForK8s" (dict "a" (list (printf "%s-%s" $dot.Release.Name $name))))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 (printf "%s-%s" $dot.Release.Name $name)))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -40,7 +40,7 @@
 {{- $_is_returning := false -}}
 {{- $chart := (printf "%s-%s" $dot.Chart.Name $dot.Chart.Version) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "console.cleanForK8s" (dict "a" (list (replace "+" "_" $chart))))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 (replace "+" "_" $chart)))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -75,7 +75,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (trimSuffix "-" (trunc (63 | int) $s))) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $s))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -8,7 +8,7 @@
 {{- $commands := (list `1. Get the application URL by running these commands:`) -}}
 {{- if $values.ingress.enabled -}}
 {{- $scheme := "http" -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $values.ingress.tls)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $values.ingress.tls)))) "r") | int) 0) -}}
 {{- $scheme = "https" -}}
 {{- end -}}
 {{- range $_, $host := $values.ingress.hosts -}}
@@ -27,7 +27,8 @@ var (
 )
 
 type Values struct {
-	Globals                      map[string]any                    `json:"global,omitempty"`
+	Globals map[string]any `json:"global,omitempty"`
+	// +kubebuilder:validation:Minimum=0
 	ReplicaCount                 int32                             `json:"replicaCount"`
 	Image                        Image                             `json:"image"`
 	ImagePullSecrets             []corev1.LocalObjectReference     `json:"imagePullSecrets"`
@@ -109,8 +110,10 @@ type IngressPath struct {
 }
 
 type AutoScaling struct {
-	Enabled                           bool   `json:"enabled"`
-	MinReplicas                       int32  `json:"minReplicas"`
+	Enabled bool `json:"enabled"`
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas"`
+	// +kubebuilder:validation:Minimum=1
 	MaxReplicas                       int32  `json:"maxReplicas"`
 	TargetCPUUtilizationPercentage    *int32 `json:"targetCPUUtilizationPercentage"`
 	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
@@ -38,7 +38,7 @@ func ClientCerts(dot *helmette.Dot) []*certmanagerv1.Certificate {
 
 	var certs []*certmanagerv1.Certificate
 	for name, data := range helmette.SortedMap(values.TLS.Certs) {
-		if !helmette.Empty(data.SecretRef) || !ptr.Deref(data.Enabled, true) {
+		if data.SecretRef != nil || !ptr.Deref(data.Enabled, true) {
 			continue
 		}
 
@@ -101,7 +101,7 @@ func ClientCerts(dot *helmette.Dot) []*certmanagerv1.Certificate {
 		panic(fmt.Sprintf("Certificate %q referenced but not defined", name))
 	}
 
-	if !helmette.Empty(data.SecretRef) || !ClientAuthRequired(dot) {
+	if data.SecretRef != nil || !ClientAuthRequired(dot) {
 		return certs
 	}
 
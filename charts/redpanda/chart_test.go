@@ -46,6 +46,7 @@ import (
 
 	"github.com/redpanda-data/redpanda-operator/charts/console/v3"
 	"github.com/redpanda-data/redpanda-operator/charts/redpanda/v25"
+	"github.com/redpanda-data/redpanda-operator/gotohelm/gotohelmtest"
 	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
 	"github.com/redpanda-data/redpanda-operator/pkg/helm"
 	"github.com/redpanda-data/redpanda-operator/pkg/helm/helmtest"
@@ -1212,6 +1213,31 @@ func TestGoHelmEquivalence(t *testing.T) {
 	}
 }
 
+// TestGoHelmEquivalenceProperties property tests that the go and helm
+// renderers of the redpanda chart are equivalent for values generated from its
+// JSON schema.
+func TestGoHelmEquivalenceProperties(t *testing.T) {
+	gotohelmtest.AssertGoHelmEquivalence(t, redpanda.Chart, gotohelmtest.Options{
+		Scheme: redpanda.Scheme,
+		Overrides: map[string]any{
+			"tests": map[string]any{"enabled": false},
+			// The bootstrap user's password and console's jwtSigningKey
+			// default to random strings.
+			"auth": map[string]any{
+				"sasl": map[string]any{
+					"bootstrapUser": map[string]any{"password": "bootstrapuser-p@ssw0rd"},
+				},
+			},
+			"console": map[string]any{
+				"tests": map[string]any{"enabled": false},
+				"secret": map[string]any{
+					"authentication": map[string]any{"jwtSigningKey": "JWT_PLACEHOLDER"},
+				},
+			},
+		},
+	})
+}
+
 // TestMultiNamespaceInstall verifies that:
 // - Multiple instances of the redpanda chart with different names may be installed in the same instance.
 // - Multiple instances of the redpanda chart with the same name may be installed in different namespaces.
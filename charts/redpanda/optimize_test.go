@@ -0,0 +1,148 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/txtar"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redpanda-data/redpanda-operator/charts/redpanda/v25"
+	"github.com/redpanda-data/redpanda-operator/gotohelm"
+)
+
+// TestOptimizedTemplates asserts that gotohelm's optimizations don't change
+// the output of the redpanda chart.
+func TestOptimizedTemplates(t *testing.T) {
+	unoptimized, optimized := loadOptimizedCharts(t)
+
+	archive, err := txtar.ParseFile("testdata/template-cases.txtar")
+	require.NoError(t, err)
+
+	for _, tc := range append(archive.Files, CIGoldenTestCases(t)...) {
+		t.Run(tc.Name, func(t *testing.T) {
+			var values map[string]any
+			require.NoError(t, yaml.Unmarshal(tc.Data, &values), "input values are invalid YAML")
+
+			expected, expectedErr := renderTemplates(unoptimized, values)
+			actual, actualErr := renderTemplates(optimized, values)
+
+			if expectedErr != nil {
+				require.EqualError(t, actualErr, expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, actualErr)
+			require.Equal(t, expected, actual)
+		})
+	}
+}
+
+// BenchmarkTemplate compares the time taken to render the redpanda chart, with
+// default values, with and without gotohelm's optimizations.
+func BenchmarkTemplate(b *testing.B) {
+	unoptimized, optimized := loadOptimizedCharts(b)
+
+	for _, bc := range []struct {
+		Name  string
+		Chart string
+	}{
+		{Name: "unoptimized", Chart: unoptimized},
+		{Name: "optimized", Chart: optimized},
+	} {
+		b.Run(bc.Name, func(b *testing.B) {
+			for b.Loop() {
+				_, err := renderTemplates(bc.Chart, map[string]any{})
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// loadOptimizedCharts transpiles the redpanda chart both with and without
+// optimizations and returns the directories of the resultant charts.
+func loadOptimizedCharts(t testing.TB) (unoptimized, optimized string) {
+	pkgs, err := gotohelm.LoadPackages(&packages.Config{Dir: "."}, ".")
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+
+	load := func(opts gotohelm.Options) string {
+		transpiled, err := gotohelm.TranspileWithOptions(pkgs[0], opts)
+		require.NoError(t, err)
+
+		tmp := t.TempDir()
+		require.NoError(t, redpanda.Chart.Write(tmp))
+
+		dir := filepath.Join(tmp, "redpanda")
+		for _, f := range transpiled.Files {
+			out, err := os.Create(filepath.Join(dir, "templates", f.Name))
+			require.NoError(t, err)
+			f.Write(out)
+			require.NoError(t, out.Close())
+		}
+
+		return dir
+	}
+
+	opts := gotohelm.Options{Dependencies: []string{"github.com/redpanda-data/redpanda-operator/charts/console/v3"}}
+	optimized = load(opts)
+
+	opts.DisableOptimizations = true
+	unoptimized = load(opts)
+
+	return unoptimized, optimized
+}
+
+// renderTemplates renders the chart in the given directory in process, as helm
+// template would, without any of helm's post processing.
+func renderTemplates(dir string, values map[string]any) (map[string]string, error) {
+	// The chart is loaded afresh for every render as processing dependencies
+	// mutates it.
+	c, err := loader.Load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mirror the values set by TestTemplate to ensure the output is
+	// deterministic.
+	overrides := map[string]any{
+		"tests": map[string]any{"enabled": false},
+		"console": map[string]any{
+			"secret": map[string]any{"authentication": map[string]any{"jwtSigningKey": "SECRETKEY"}},
+		},
+		"auth": map[string]any{
+			"sasl": map[string]any{"bootstrapUser": map[string]any{"password": "changeme"}},
+		},
+	}
+	values = chartutil.MergeTables(overrides, values)
+
+	if err := chartutil.ProcessDependenciesWithMerge(c, values); err != nil {
+		return nil, err
+	}
+
+	renderValues, err := chartutil.ToRenderValues(c, values, chartutil.ReleaseOptions{
+		Name:      "redpanda",
+		Namespace: "default",
+		IsInstall: true,
+	}, chartutil.DefaultCapabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	return engine.Render(c, renderValues)
+}
@@ -24,10 +24,11 @@ func Roles(dot *helmette.Dot) []*rbacv1.Role {
 
 	// path of static role definition -> Enabled
 	mapping := map[string]bool{
-		"files/sidecar.Role.yaml":          values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC,
-		"files/pvcunbinder.Role.yaml":      values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC,
-		"files/decommission.Role.yaml":     values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC,
-		"files/rpk-debug-bundle.Role.yaml": values.RBAC.Enabled && values.RBAC.RPKDebugBundle,
+		"files/sidecar.Role.yaml":              values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC,
+		"files/pvcunbinder.Role.yaml":          values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC,
+		"files/decommission.Role.yaml":         values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC,
+		"files/certificate-rotation.Role.yaml": values.RBAC.Enabled && values.Statefulset.SideCars.Controllers.CreateRBAC && values.Statefulset.SideCars.ConfigWatcher.RestartOnCertificateRotation,
+		"files/rpk-debug-bundle.Role.yaml":     values.RBAC.Enabled && values.RBAC.RPKDebugBundle,
 	}
 
 	var roles []*rbacv1.Role
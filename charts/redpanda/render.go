@@ -12,13 +12,16 @@ package redpanda
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/cockroachdb/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/redpanda-data/redpanda-operator/charts/console/v3"
 	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
 	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
 	"github.com/redpanda-data/redpanda-operator/pkg/kube"
 )
 
@@ -50,6 +53,10 @@ func RenderResourcesFromCRD(cfg *kube.RESTConfig, release helmette.Release, clus
 	}
 
 	manifests := renderResources(dot, pools)
+	if err := withNodeValueSources(dot, cluster, pools, manifests); err != nil {
+		return nil, err
+	}
+
 	for _, chart := range dot.Subcharts {
 		// we only have a single subchart defined here, this abuses the fact that
 		// we won't have a dot context in Subcharts if its not enabled, and, if
@@ -84,7 +91,82 @@ func RenderNodePoolsFromCRD(cfg *kube.RESTConfig, release helmette.Release, clus
 
 	checkVersion(dot)
 
-	return StatefulSets(dot, pools), nil
+	sets := StatefulSets(dot, pools)
+
+	objs := make([]kube.Object, 0, len(sets))
+	for _, set := range sets {
+		objs = append(objs, set)
+	}
+	if err := withNodeValueSources(dot, cluster, pools, objs); err != nil {
+		return nil, err
+	}
+
+	return sets, nil
+}
+
+// withNodeValueSources renders the value sources of the node and rpk
+// configuration of the cluster's and pools' broker templates and of the
+// advertised addresses of its listeners into the redpanda ConfigMap among
+// objs. Brokers evaluate them as they start, once the configurator has
+// written their redpanda.yaml, which requires them to read their own Pod.
+//
+// +gotohelm:ignore=true
+func withNodeValueSources(dot *helmette.Dot, cluster *redpandav1alpha3.Redpanda, pools []*redpandav1alpha3.NodePool, objs []kube.Object) error {
+	files := map[string]string{}
+
+	sources, err := clusterconfiguration.NodeValueSources(&cluster.Spec.Listeners, &cluster.Spec.NodePoolSpec.BrokerTemplate)
+	if err != nil {
+		return err
+	}
+	if len(sources) > 0 {
+		files[clusterconfiguration.RedpandaYamlValueSourcesFile] = helmette.ToJSON(sources)
+	}
+
+	for _, pool := range pools {
+		sources, err := clusterconfiguration.NodeValueSources(nil, &pool.Spec.BrokerTemplate)
+		if err != nil {
+			return errors.Wrapf(err, "node pool %q", pool.Name)
+		}
+		if len(sources) > 0 {
+			files[clusterconfiguration.PoolValueSourcesFile(pool.Name)] = helmette.ToJSON(sources)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	for _, obj := range objs {
+		switch obj := obj.(type) {
+		case *corev1.ConfigMap:
+			if obj.Name != Fullname(dot) {
+				continue
+			}
+			for name, content := range files {
+				obj.Data[name] = content
+			}
+
+		case *appsv1.StatefulSet:
+			for i := range obj.Spec.Template.Spec.InitContainers {
+				container := &obj.Spec.Template.Spec.InitContainers[i]
+				if container.Name != bootstrapYamlTemplater(dot).Name {
+					continue
+				}
+				container.Command = append(container.Command, "--node-config", path.Join("/tmp/config", clusterconfiguration.RedpandaYamlTemplateFile))
+				container.Env = append(container.Env, corev1.EnvVar{
+					Name:      "KUBERNETES_NODE_NAME",
+					ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}},
+				})
+				container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+					Name:      ServiceAccountVolumeName,
+					MountPath: DefaultAPITokenMountPath,
+					ReadOnly:  true,
+				})
+			}
+		}
+	}
+
+	return nil
 }
 
 func checkVersion(dot *helmette.Dot) {
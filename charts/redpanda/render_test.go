@@ -0,0 +1,132 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redpanda-data/redpanda-operator/charts/redpanda/v25"
+	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
+	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
+)
+
+// TestRenderNodeValueSources asserts that the value sources of a cluster and
+// its node pools are rendered and evaluate to the redpanda.yaml of a broker.
+func TestRenderNodeValueSources(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &redpandav1alpha3.Redpanda{
+		ObjectMeta: metav1.ObjectMeta{Name: "redpanda", Namespace: "ns"},
+		Spec: redpandav1alpha3.RedpandaSpec{
+			Listeners: redpandav1alpha3.Listeners{
+				Kafka: []redpandav1alpha3.Listener{
+					{Name: "internal", Port: 9093},
+					{
+						Name:           "external",
+						Port:           9094,
+						AdvertisedHost: &redpandav1alpha3.ValueSource{Expr: `podName + ".example.com"`},
+						AdvertisedPort: &redpandav1alpha3.ValueSource{Expr: `30092 + ordinal`},
+					},
+				},
+			},
+			NodePoolSpec: redpandav1alpha3.NodePoolSpec{
+				EmbeddedNodePoolSpec: redpandav1alpha3.EmbeddedNodePoolSpec{
+					BrokerTemplate: redpandav1alpha3.BrokerTemplate{
+						NodeConfig: map[string]redpandav1alpha3.ValueSource{
+							"rack": {Expr: `nodeLabels["topology.kubernetes.io/zone"]`},
+						},
+					},
+				},
+			},
+		},
+	}
+	pools := []*redpandav1alpha3.NodePool{{
+		ObjectMeta: metav1.ObjectMeta{Name: "blue", Namespace: "ns"},
+		Spec: redpandav1alpha3.NodePoolSpec{
+			EmbeddedNodePoolSpec: redpandav1alpha3.EmbeddedNodePoolSpec{
+				BrokerTemplate: redpandav1alpha3.BrokerTemplate{
+					NodeConfig: map[string]redpandav1alpha3.ValueSource{
+						"rack": {Expr: `"blue-" + nodeLabels["topology.kubernetes.io/zone"]`},
+					},
+					RPKConfig: map[string]redpandav1alpha3.ValueSource{
+						"tune_aio_events": {Value: "true"},
+					},
+				},
+			},
+		},
+	}}
+
+	objs, err := redpanda.RenderResourcesFromCRD(nil, helmette.Release{Name: "redpanda", Namespace: "ns", Service: "Helm"}, cluster, pools)
+	require.NoError(t, err)
+
+	var configMap *corev1.ConfigMap
+	for _, obj := range objs {
+		if obj, ok := obj.(*corev1.ConfigMap); ok && obj.Name == "redpanda" {
+			configMap = obj
+		}
+	}
+	require.NotNil(t, configMap)
+
+	sets, err := redpanda.RenderNodePoolsFromCRD(nil, helmette.Release{Name: "redpanda", Namespace: "ns", Service: "Helm"}, cluster, pools)
+	require.NoError(t, err)
+	require.NotEmpty(t, sets)
+
+	// brokers evaluate the sources with the permission to read their pod
+	for _, set := range sets {
+		var bootstrap *corev1.Container
+		for i, container := range set.Spec.Template.Spec.InitContainers {
+			if container.Name == "bootstrap-yaml-envsubst" {
+				bootstrap = &set.Spec.Template.Spec.InitContainers[i]
+			}
+		}
+		require.NotNil(t, bootstrap)
+		require.Contains(t, bootstrap.Command, "--node-config")
+		require.Contains(t, bootstrap.VolumeMounts, corev1.VolumeMount{Name: redpanda.ServiceAccountVolumeName, MountPath: redpanda.DefaultAPITokenMountPath, ReadOnly: true})
+	}
+
+	// evaluate the rendered sources as the bootstrap init container of the
+	// third broker of the blue pool would
+	var sources, poolSources map[string]redpandav1alpha3.ValueSource
+	require.NoError(t, json.Unmarshal([]byte(configMap.Data[clusterconfiguration.RedpandaYamlValueSourcesFile]), &sources))
+	require.NoError(t, json.Unmarshal([]byte(configMap.Data[clusterconfiguration.PoolValueSourcesFile("blue")]), &poolSources))
+	for key, src := range poolSources {
+		sources[key] = src
+	}
+
+	node := config.ProdDefault()
+	require.NoError(t, yaml.Unmarshal([]byte(configMap.Data[clusterconfiguration.RedpandaYamlTemplateFile]), node))
+
+	scope, err := clusterconfiguration.PodScopeFor(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "redpanda-blue-2", Labels: map[string]string{labels.NodePoolKey: "blue"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a", Labels: map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}}},
+	)
+	require.NoError(t, err)
+
+	resolver := clusterconfiguration.ValueSourceResolver{
+		Namespace: "ns",
+		Factory:   clusterconfiguration.StdLibFactory(ctx, nil, nil),
+		Pod:       scope,
+	}
+	require.NoError(t, resolver.ApplyNodeConfig(ctx, node, sources))
+
+	require.Equal(t, "blue-us-east-1a", node.Redpanda.Rack)
+	require.Equal(t, []config.NamedSocketAddress{{Name: "external", Address: "redpanda-blue-2.example.com", Port: 30094}}, node.Redpanda.AdvertisedKafkaAPI[:1])
+	require.True(t, node.Rpk.Tuners.TuneAioEvents)
+}
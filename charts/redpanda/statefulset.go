@@ -859,6 +859,18 @@ func statefulSetContainerSidecar(dot *helmette.Dot) *corev1.Container {
 		}...)
 	}
 
+	if watcher := values.Statefulset.SideCars.ConfigWatcher; watcher.Enabled && watcher.WatchCertificates {
+		args = append(args, `--watch-certificates`)
+
+		if watcher.RestartOnCertificateRotation {
+			args = append(args, []string{
+				`--certificate-rotation-action=restart`,
+				// SERVICE_NAME is the name of the Pod, see statefulSetRedpandaEnv.
+				`--pod-name=$(SERVICE_NAME)`,
+			}...)
+		}
+	}
+
 	if values.Statefulset.SideCars.PVCUnbinder.Enabled {
 		args = append(args, []string{
 			`--run-pvc-unbinder`,
@@ -33,7 +33,7 @@
 {{- $template := (index $_62_template_fixups 0) -}}
 {{- $fixups := (index $_62_template_fixups 1) -}}
 {{- $fixupStr := (toJson $fixups) -}}
-{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $fixups)))) "r") | int) (0 | int)) -}}
+{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $fixups)))) "r") | int) 0) -}}
 {{- $fixupStr = `[]` -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -62,8 +62,8 @@
 {{- $_98___ok_1 := (get (fromJson (include "_shims.dicttest" (dict "a" (list $values.config.cluster "default_topic_replications" (coalesce nil))))) "r") -}}
 {{- $_ := (index $_98___ok_1 0) -}}
 {{- $ok_1 := (index $_98___ok_1 1) -}}
-{{- if (and (not $ok_1) (ge ($values.statefulset.replicas | int) (3 | int))) -}}
-{{- $_ := (set $bootstrap "default_topic_replications" (3 | int)) -}}
+{{- if (and (not $ok_1) (ge ($values.statefulset.replicas | int) 3)) -}}
+{{- $_ := (set $bootstrap "default_topic_replications" 3) -}}
 {{- end -}}
 {{- $_103___ok_2 := (get (fromJson (include "_shims.dicttest" (dict "a" (list $values.config.cluster "storage_min_free_bytes" (coalesce nil))))) "r") -}}
 {{- $_ := (index $_103___ok_2 0) -}}
@@ -107,7 +107,7 @@
 {{- $_ := (set $redpandaYaml "rpk" (get (fromJson (include "redpanda.rpkNodeConfig" (dict "a" (list $dot)))) "r")) -}}
 {{- $_ := (set $redpandaYaml "pandaproxy_client" (get (fromJson (include "redpanda.kafkaClient" (dict "a" (list $dot)))) "r")) -}}
 {{- $_ := (set $redpandaYaml "schema_registry_client" (get (fromJson (include "redpanda.kafkaClient" (dict "a" (list $dot)))) "r")) -}}
-{{- if (and (and (get (fromJson (include "redpanda.RedpandaAtLeast_23_3_0" (dict "a" (list $dot)))) "r") $values.auditLogging.enabled) (get (fromJson (include "redpanda.Auth.IsSASLEnabled" (dict "a" (list $values.auth)))) "r")) -}}
+{{- if (and (and (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.3.0-0 || <0.0.1-0")))) "r") $values.auditLogging.enabled) (get (fromJson (include "redpanda.Auth.IsSASLEnabled" (dict "a" (list $values.auth)))) "r")) -}}
 {{- $_ := (set $redpandaYaml "audit_log_client" (get (fromJson (include "redpanda.kafkaClient" (dict "a" (list $dot)))) "r")) -}}
 {{- end -}}
 {{- end -}}
@@ -139,21 +139,21 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $brokerList := (list) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
 {{- $brokerList = (concat (default (list) $brokerList) (list (printf "%s:%d" (get (fromJson (include "redpanda.advertisedHost" (dict "a" (list $dot $i)))) "r") (((get (fromJson (include "redpanda.advertisedKafkaPort" (dict "a" (list $dot $i)))) "r") | int) | int)))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
 {{- break -}}
 {{- end -}}
 {{- $adminAdvertisedList := (list) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
 {{- $adminAdvertisedList = (concat (default (list) $adminAdvertisedList) (list (printf "%s:%d" (get (fromJson (include "redpanda.advertisedHost" (dict "a" (list $dot $i)))) "r") (((get (fromJson (include "redpanda.advertisedAdminPort" (dict "a" (list $dot $i)))) "r") | int) | int)))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
 {{- break -}}
 {{- end -}}
 {{- $schemaAdvertisedList := (list) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
 {{- $schemaAdvertisedList = (concat (default (list) $schemaAdvertisedList) (list (printf "%s:%d" (get (fromJson (include "redpanda.advertisedHost" (dict "a" (list $dot $i)))) "r") (((get (fromJson (include "redpanda.advertisedSchemaPort" (dict "a" (list $dot $i)))) "r") | int) | int)))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -181,15 +181,15 @@
 {{- $_ := (set $schemaTLS "ca_file" "ca.crt") -}}
 {{- end -}}
 {{- $ka := (dict "brokers" $brokerList "tls" (coalesce nil)) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $kafkaTLS)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $kafkaTLS)))) "r") | int) 0) -}}
 {{- $_ := (set $ka "tls" $kafkaTLS) -}}
 {{- end -}}
 {{- $aa := (dict "addresses" $adminAdvertisedList "tls" (coalesce nil)) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $adminTLS)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $adminTLS)))) "r") | int) 0) -}}
 {{- $_ := (set $aa "tls" $adminTLS) -}}
 {{- end -}}
 {{- $sa := (dict "addresses" $schemaAdvertisedList "tls" (coalesce nil)) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $schemaTLS)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $schemaTLS)))) "r") | int) 0) -}}
 {{- $_ := (set $sa "tls" $schemaTLS) -}}
 {{- end -}}
 {{- $result := (dict "name" (get (fromJson (include "redpanda.getFirstExternalKafkaListener" (dict "a" (list $dot)))) "r") "kafka_api" $ka "admin_api" $aa "schema_registry" $sa) -}}
@@ -208,13 +208,13 @@
 {{- $externalKafkaListenerName := (get (fromJson (include "redpanda.getFirstExternalKafkaListener" (dict "a" (list $dot)))) "r") -}}
 {{- $listener := (ternary (index $values.listeners.kafka.external $externalKafkaListenerName) (dict "enabled" (coalesce nil) "advertisedPorts" (coalesce nil) "port" 0 "nodePort" (coalesce nil) "tls" (coalesce nil)) (hasKey $values.listeners.kafka.external $externalKafkaListenerName)) -}}
 {{- $port := (($values.listeners.kafka.port | int) | int) -}}
-{{- if (gt (($listener.port | int) | int) ((1 | int) | int)) -}}
+{{- if (gt (($listener.port | int) | int) 1) -}}
 {{- $port = (($listener.port | int) | int) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (1 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 1) -}}
 {{- $port = ((index $listener.advertisedPorts $i) | int) -}}
-{{- else -}}{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (1 | int)) -}}
-{{- $port = ((index $listener.advertisedPorts (0 | int)) | int) -}}
+{{- else -}}{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 1) -}}
+{{- $port = ((index $listener.advertisedPorts 0) | int) -}}
 {{- end -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -234,13 +234,13 @@
 {{- $externalAdminListenerName := (first $keys) -}}
 {{- $listener := (ternary (index $values.listeners.admin.external (get (fromJson (include "_shims.typeassertion" (dict "a" (list "string" $externalAdminListenerName)))) "r")) (dict "enabled" (coalesce nil) "advertisedPorts" (coalesce nil) "port" 0 "nodePort" (coalesce nil) "tls" (coalesce nil)) (hasKey $values.listeners.admin.external (get (fromJson (include "_shims.typeassertion" (dict "a" (list "string" $externalAdminListenerName)))) "r"))) -}}
 {{- $port := (($values.listeners.admin.port | int) | int) -}}
-{{- if (gt (($listener.port | int) | int) (1 | int)) -}}
+{{- if (gt (($listener.port | int) | int) 1) -}}
 {{- $port = (($listener.port | int) | int) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (1 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 1) -}}
 {{- $port = ((index $listener.advertisedPorts $i) | int) -}}
-{{- else -}}{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (1 | int)) -}}
-{{- $port = ((index $listener.advertisedPorts (0 | int)) | int) -}}
+{{- else -}}{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 1) -}}
+{{- $port = ((index $listener.advertisedPorts 0) | int) -}}
 {{- end -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -260,13 +260,13 @@
 {{- $externalSchemaListenerName := (first $keys) -}}
 {{- $listener := (ternary (index $values.listeners.schemaRegistry.external (get (fromJson (include "_shims.typeassertion" (dict "a" (list "string" $externalSchemaListenerName)))) "r")) (dict "enabled" (coalesce nil) "advertisedPorts" (coalesce nil) "port" 0 "nodePort" (coalesce nil) "tls" (coalesce nil)) (hasKey $values.listeners.schemaRegistry.external (get (fromJson (include "_shims.typeassertion" (dict "a" (list "string" $externalSchemaListenerName)))) "r"))) -}}
 {{- $port := (($values.listeners.schemaRegistry.port | int) | int) -}}
-{{- if (gt (($listener.port | int) | int) (1 | int)) -}}
+{{- if (gt (($listener.port | int) | int) 1) -}}
 {{- $port = (($listener.port | int) | int) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (1 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 1) -}}
 {{- $port = ((index $listener.advertisedPorts $i) | int) -}}
-{{- else -}}{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (1 | int)) -}}
-{{- $port = ((index $listener.advertisedPorts (0 | int)) | int) -}}
+{{- else -}}{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 1) -}}
+{{- $port = ((index $listener.advertisedPorts 0) | int) -}}
 {{- end -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -285,13 +285,13 @@
 {{- if (ne (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $values.external.domain "")))) "r") "") -}}
 {{- $address = (printf "%s.%s" $address (tpl $values.external.domain $dot)) -}}
 {{- end -}}
-{{- if (le ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) (0 | int)) -}}
+{{- if (le ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) 0) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $address) | toJson -}}
 {{- break -}}
 {{- end -}}
-{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) (1 | int)) -}}
-{{- $address = (index $values.external.addresses (0 | int)) -}}
+{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) 1) -}}
+{{- $address = (index $values.external.addresses 0) -}}
 {{- else -}}
 {{- $address = (index $values.external.addresses $i) -}}
 {{- end -}}
@@ -312,7 +312,7 @@
 {{- $keys := (keys $values.listeners.kafka.external) -}}
 {{- $_ := (sortAlpha $keys) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "_shims.typeassertion" (dict "a" (list "string" (first $keys))))) "r")) | toJson -}}
+{{- (include "_shims.typeassertion" (dict "a" (list "string" (first $keys)))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -324,7 +324,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $bl := (coalesce nil) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) ($replicas|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) ($replicas|int) (1|int) -}}
 {{- $bl = (concat (default (list) $bl) (list (printf "%s-%d.%s:%d" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r") $i (get (fromJson (include "redpanda.InternalDomain" (dict "a" (list $dot)))) "r") $port))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -344,17 +344,17 @@
 {{- $brokerList := (get (fromJson (include "redpanda.BrokerList" (dict "a" (list $dot ($values.statefulset.replicas | int) ($values.listeners.kafka.port | int))))) "r") -}}
 {{- $adminTLS := (coalesce nil) -}}
 {{- $tls_6 := (get (fromJson (include "redpanda.rpkAdminAPIClientTLSConfiguration" (dict "a" (list $dot)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_6)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_6)))) "r") | int) 0) -}}
 {{- $adminTLS = $tls_6 -}}
 {{- end -}}
 {{- $brokerTLS := (coalesce nil) -}}
 {{- $tls_7 := (get (fromJson (include "redpanda.rpkKafkaClientTLSConfiguration" (dict "a" (list $dot)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_7)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_7)))) "r") | int) 0) -}}
 {{- $brokerTLS = $tls_7 -}}
 {{- end -}}
 {{- $schemaRegistryTLS := (coalesce nil) -}}
 {{- $tls_8 := (get (fromJson (include "redpanda.rpkSchemaRegistryClientTLSConfiguration" (dict "a" (list $dot)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_8)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_8)))) "r") | int) 0) -}}
 {{- $schemaRegistryTLS = $tls_8 -}}
 {{- end -}}
 {{- $_400_lockMemory_overprovisioned_flags := (get (fromJson (include "redpanda.RedpandaAdditionalStartFlags" (dict "a" (list $values)))) "r") -}}
@@ -376,7 +376,7 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $tls := $values.listeners.kafka.tls -}}
-{{- if (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $tls $values.tls)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $tls.enabled $values.tls.enabled)))) "r") (ne $tls.cert ""))) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (dict)) | toJson -}}
 {{- break -}}
@@ -398,7 +398,7 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $tls := $values.listeners.admin.tls -}}
-{{- if (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $tls $values.tls)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $tls.enabled $values.tls.enabled)))) "r") (ne $tls.cert ""))) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (dict)) | toJson -}}
 {{- break -}}
@@ -420,7 +420,7 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $tls := $values.listeners.schemaRegistry.tls -}}
-{{- if (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $tls $values.tls)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $tls.enabled $values.tls.enabled)))) "r") (ne $tls.cert ""))) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (dict)) | toJson -}}
 {{- break -}}
@@ -442,7 +442,7 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $brokerList := (list) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
 {{- $brokerList = (concat (default (list) $brokerList) (list (dict "address" (printf "%s-%d.%s" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r") $i (get (fromJson (include "redpanda.InternalDomain" (dict "a" (list $dot)))) "r")) "port" ($values.listeners.kafka.port | int)))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -450,7 +450,7 @@
 {{- end -}}
 {{- $kafkaTLS := $values.listeners.kafka.tls -}}
 {{- $brokerTLS := (coalesce nil) -}}
-{{- if (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $values.listeners.kafka.tls $values.tls)))) "r") -}}
+{{- if (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $values.listeners.kafka.tls.enabled $values.tls.enabled)))) "r") (ne $values.listeners.kafka.tls.cert "")) -}}
 {{- $brokerTLS = (dict "enabled" true "require_client_auth" $kafkaTLS.requireClientAuth "truststore_file" (get (fromJson (include "redpanda.InternalTLS.ServerCAPath" (dict "a" (list $kafkaTLS $values.tls)))) "r")) -}}
 {{- if $kafkaTLS.requireClientAuth -}}
 {{- $_ := (set $brokerTLS "cert_file" (printf "%s/%s-client/tls.crt" "/etc/tls/certs" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r"))) -}}
@@ -458,7 +458,7 @@
 {{- end -}}
 {{- end -}}
 {{- $cfg := (dict "brokers" $brokerList) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $brokerTLS)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $brokerTLS)))) "r") | int) 0) -}}
 {{- $_ := (set $cfg "broker_tls" $brokerTLS) -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -482,16 +482,16 @@
 {{- $_ := (set $redpanda "rpc_server" (get (fromJson (include "redpanda.rpcListeners" (dict "a" (list $dot)))) "r")) -}}
 {{- $_ := (set $redpanda "admin_api_tls" (coalesce nil)) -}}
 {{- $tls_9 := (get (fromJson (include "redpanda.ListenerConfig.ListenersTLS" (dict "a" (list $values.listeners.admin $values.tls)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_9)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_9)))) "r") | int) 0) -}}
 {{- $_ := (set $redpanda "admin_api_tls" $tls_9) -}}
 {{- end -}}
 {{- $_ := (set $redpanda "kafka_api_tls" (coalesce nil)) -}}
 {{- $tls_10 := (get (fromJson (include "redpanda.ListenerConfig.ListenersTLS" (dict "a" (list $values.listeners.kafka $values.tls)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_10)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_10)))) "r") | int) 0) -}}
 {{- $_ := (set $redpanda "kafka_api_tls" $tls_10) -}}
 {{- end -}}
 {{- $tls_11 := (get (fromJson (include "redpanda.rpcListenersTLS" (dict "a" (list $dot)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_11)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_11)))) "r") | int) 0) -}}
 {{- $_ := (set $redpanda "rpc_server_tls" $tls_11) -}}
 {{- end -}}
 {{- end -}}
@@ -510,7 +510,7 @@
 {{- $_ := (set $pandaProxy "pandaproxy_api" (get (fromJson (include "redpanda.ListenerConfig.Listeners" (dict "a" (list $values.listeners.http $pandaProxyAuth)))) "r")) -}}
 {{- $_ := (set $pandaProxy "pandaproxy_api_tls" (coalesce nil)) -}}
 {{- $tls_12 := (get (fromJson (include "redpanda.ListenerConfig.ListenersTLS" (dict "a" (list $values.listeners.http $values.tls)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_12)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_12)))) "r") | int) 0) -}}
 {{- $_ := (set $pandaProxy "pandaproxy_api_tls" $tls_12) -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -528,7 +528,7 @@
 {{- $_ := (set $schemaReg "schema_registry_api" (get (fromJson (include "redpanda.ListenerConfig.Listeners" (dict "a" (list $values.listeners.schemaRegistry (coalesce nil))))) "r")) -}}
 {{- $_ := (set $schemaReg "schema_registry_api_tls" (coalesce nil)) -}}
 {{- $tls_13 := (get (fromJson (include "redpanda.ListenerConfig.ListenersTLS" (dict "a" (list $values.listeners.schemaRegistry $values.tls)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_13)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $tls_13)))) "r") | int) 0) -}}
 {{- $_ := (set $schemaReg "schema_registry_api_tls" $tls_13) -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -543,10 +543,10 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $r := $values.listeners.rpc -}}
-{{- if (and (not ((or (or (get (fromJson (include "redpanda.RedpandaAtLeast_22_2_atleast_22_2_10" (dict "a" (list $dot)))) "r") (get (fromJson (include "redpanda.RedpandaAtLeast_22_3_atleast_22_3_13" (dict "a" (list $dot)))) "r")) (get (fromJson (include "redpanda.RedpandaAtLeast_23_1_2" (dict "a" (list $dot)))) "r")))) ((or (and (eq (toJson $r.tls.enabled) "null") $values.tls.enabled) (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $r.tls.enabled false)))) "r")))) -}}
+{{- if (and (not (or (or (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.2.10-0,<22.3")))) "r") (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.3.13-0,<22.4")))) "r")) (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.1.2-0 || <0.0.1-0")))) "r"))) (or (and (eq (toJson $r.tls.enabled) "null") $values.tls.enabled) (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $r.tls.enabled false)))) "r"))) -}}
 {{- $_ := (fail (printf "Redpanda version v%s does not support TLS on the RPC port. Please upgrade. See technical service bulletin 2023-01." (trimPrefix "v" (get (fromJson (include "redpanda.Tag" (dict "a" (list $dot)))) "r")))) -}}
 {{- end -}}
-{{- if (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $r.tls $values.tls)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $r.tls.enabled $values.tls.enabled)))) "r") (ne $r.tls.cert ""))) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (dict)) | toJson -}}
 {{- break -}}
@@ -574,7 +574,7 @@
 {{- $internal := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- if (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $internal $tls)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $internal.enabled $tls.enabled)))) "r") (ne $internal.cert ""))) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (dict)) | toJson -}}
 {{- break -}}
@@ -61,7 +61,7 @@
 {{- if (and $sasl_3.enabled (ne $sasl_3.secretRef "")) -}}
 {{- $mounts = (concat (default (list) $mounts) (list (mustMergeOverwrite (dict "name" "" "mountPath" "") (dict "name" (printf "%s-users" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r")) "mountPath" "/mnt/users" "readOnly" true)))) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list (get (fromJson (include "redpanda.Listeners.TrustStores" (dict "a" (list $values.listeners $values.tls)))) "r"))))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list (get (fromJson (include "redpanda.Listeners.TrustStores" (dict "a" (list $values.listeners $values.tls)))) "r"))))) "r") | int) 0) -}}
 {{- $mounts = (concat (default (list) $mounts) (list (mustMergeOverwrite (dict "name" "" "mountPath" "") (dict "name" "truststores" "mountPath" "/etc/truststores" "readOnly" true)))) -}}
 {{- end -}}
 {{- $visitedCert := (dict) -}}
@@ -69,7 +69,7 @@
 {{- $_132___visited := (get (fromJson (include "_shims.dicttest" (dict "a" (list $visitedCert $tlsCfg.cert false)))) "r") -}}
 {{- $_ := (index $_132___visited 0) -}}
 {{- $visited := (index $_132___visited 1) -}}
-{{- if (or (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $tlsCfg $values.tls)))) "r")) $visited) -}}
+{{- if (or (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $tlsCfg.enabled $values.tls.enabled)))) "r") (ne $tlsCfg.cert ""))) $visited) -}}
 {{- continue -}}
 {{- end -}}
 {{- $_ := (set $visitedCert $tlsCfg.cert true) -}}
@@ -103,7 +103,7 @@
 {{- $_173___visited := (get (fromJson (include "_shims.dicttest" (dict "a" (list $visitedCert $tlsCfg.cert false)))) "r") -}}
 {{- $_ := (index $_173___visited 0) -}}
 {{- $visited := (index $_173___visited 1) -}}
-{{- if (or (not (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $tlsCfg $values.tls)))) "r")) $visited) -}}
+{{- if (or (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $tlsCfg.enabled $values.tls.enabled)))) "r") (ne $tlsCfg.cert ""))) $visited) -}}
 {{- continue -}}
 {{- end -}}
 {{- $_ := (set $visitedCert $tlsCfg.cert true) -}}
@@ -126,10 +126,10 @@
 {{- $schemaURLs := (coalesce nil) -}}
 {{- if $values.listeners.schemaRegistry.enabled -}}
 {{- $schema := "http" -}}
-{{- if (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $values.listeners.schemaRegistry.tls $values.tls)))) "r") -}}
+{{- if (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $values.listeners.schemaRegistry.tls.enabled $values.tls.enabled)))) "r") (ne $values.listeners.schemaRegistry.tls.cert "")) -}}
 {{- $schema = "https" -}}
 {{- end -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
 {{- $schemaURLs = (concat (default (list) $schemaURLs) (list (printf "%s://%s-%d.%s:%d" $schema (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r") $i (get (fromJson (include "redpanda.InternalDomain" (dict "a" (list $dot)))) "r") ($values.listeners.schemaRegistry.port | int)))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -137,7 +137,7 @@
 {{- end -}}
 {{- end -}}
 {{- $schema := "http" -}}
-{{- if (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $values.listeners.admin.tls $values.tls)))) "r") -}}
+{{- if (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $values.listeners.admin.tls.enabled $values.tls.enabled)))) "r") (ne $values.listeners.admin.tls.cert "")) -}}
 {{- $schema = "https" -}}
 {{- end -}}
 {{- $c := (dict "kafka" (dict "brokers" (get (fromJson (include "redpanda.BrokerList" (dict "a" (list $dot ($values.statefulset.replicas | int) ($values.listeners.kafka.port | int))))) "r") "sasl" (dict "enabled" (get (fromJson (include "redpanda.Auth.IsSASLEnabled" (dict "a" (list $values.auth)))) "r")) "tls" (get (fromJson (include "redpanda.ListenerConfig.ConsoleTLS" (dict "a" (list $values.listeners.kafka $values.tls)))) "r")) "redpanda" (dict "adminApi" (dict "enabled" true "urls" (list (printf "%s://%s:%d" $schema (get (fromJson (include "redpanda.InternalDomain" (dict "a" (list $dot)))) "r") ($values.listeners.admin.port | int))) "tls" (get (fromJson (include "redpanda.ListenerConfig.ConsoleTLS" (dict "a" (list $values.listeners.admin $values.tls)))) "r"))) "schemaRegistry" (dict "enabled" $values.listeners.schemaRegistry.enabled "urls" $schemaURLs "tls" (get (fromJson (include "redpanda.ListenerConfig.ConsoleTLS" (dict "a" (list $values.listeners.schemaRegistry $values.tls)))) "r"))) -}}
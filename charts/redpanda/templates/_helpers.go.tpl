@@ -5,7 +5,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.cleanForK8s" (dict "a" (list (replace "+" "_" (printf "%s-%s" $dot.Chart.Name $dot.Chart.Version)))))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 (replace "+" "_" (printf "%s-%s" $dot.Chart.Name $dot.Chart.Version))))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -19,11 +19,11 @@
 {{- $ok_2 := (index $_55_override_1_ok_2 1) -}}
 {{- if (and $ok_2 (ne $override_1 "")) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.cleanForK8s" (dict "a" (list $override_1)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $override_1))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.cleanForK8s" (dict "a" (list $dot.Chart.Name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $dot.Chart.Name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -37,11 +37,11 @@
 {{- $ok_4 := (index $_67_override_3_ok_4 1) -}}
 {{- if (and $ok_4 (ne $override_3 "")) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.cleanForK8s" (dict "a" (list $override_3)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $override_3))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.cleanForK8s" (dict "a" (list $dot.Release.Name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $dot.Release.Name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -55,7 +55,7 @@
 {{- if (ne (toJson $values.commonLabels) "null") -}}
 {{- $labels = $values.commonLabels -}}
 {{- end -}}
-{{- $defaults := (dict "helm.sh/chart" (get (fromJson (include "redpanda.ChartLabel" (dict "a" (list $dot)))) "r") "app.kubernetes.io/name" (get (fromJson (include "redpanda.Name" (dict "a" (list $dot)))) "r") "app.kubernetes.io/instance" $dot.Release.Name "app.kubernetes.io/managed-by" $dot.Release.Service "app.kubernetes.io/component" (get (fromJson (include "redpanda.Name" (dict "a" (list $dot)))) "r")) -}}
+{{- $defaults := (dict "helm.sh/chart" (trimSuffix "-" (trunc 63 (replace "+" "_" (printf "%s-%s" $dot.Chart.Name $dot.Chart.Version)))) "app.kubernetes.io/name" (get (fromJson (include "redpanda.Name" (dict "a" (list $dot)))) "r") "app.kubernetes.io/instance" $dot.Release.Name "app.kubernetes.io/managed-by" $dot.Release.Service "app.kubernetes.io/component" (get (fromJson (include "redpanda.Name" (dict "a" (list $dot)))) "r")) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (merge (dict) $labels $defaults)) | toJson -}}
 {{- break -}}
@@ -88,11 +88,11 @@
 {{- $values := $dot.Values.AsMap -}}
 {{- if (and (ne (toJson $values.service) "null") (ne (toJson $values.service.name) "null")) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.cleanForK8s" (dict "a" (list $values.service.name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $values.service.name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r")) | toJson -}}
+{{- (include "redpanda.Fullname" (dict "a" (list $dot))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -294,7 +294,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.2.0-0 || <0.0.1-0")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.2.0-0 || <0.0.1-0"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -304,7 +304,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.3.0-0 || <0.0.1-0")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.3.0-0 || <0.0.1-0"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -314,7 +314,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.1.1-0 || <0.0.1-0")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.1.1-0 || <0.0.1-0"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -324,7 +324,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.1.2-0 || <0.0.1-0")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.1.2-0 || <0.0.1-0"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -334,7 +334,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.3.13-0,<22.4")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.3.13-0,<22.4"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -344,7 +344,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.2.10-0,<22.3")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=22.2.10-0,<22.3"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -354,7 +354,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.2.1-0 || <0.0.1-0")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.2.1-0 || <0.0.1-0"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -364,7 +364,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.3.0-0 || <0.0.1-0")))) "r")) | toJson -}}
+{{- (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.3.0-0 || <0.0.1-0"))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -392,7 +392,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (trimSuffix "-" (trunc (63 | int) $in))) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $in))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -464,9 +464,9 @@
 {{- $overrideSpec = (mustMergeOverwrite (dict) (dict)) -}}
 {{- end -}}
 {{- $merged := (merge (dict) (mustMergeOverwrite (dict) (dict "metadata" (mustMergeOverwrite (dict) (dict "labels" $overrides.labels "annotations" $overrides.annotations)) "spec" $overrideSpec)) $original) -}}
-{{- $_ := (set $merged.spec "initContainers" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list $original.spec.initContainers $overrideSpec.initContainers "name" "redpanda.mergeContainer")))) "r")) -}}
-{{- $_ := (set $merged.spec "containers" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list $original.spec.containers $overrideSpec.containers "name" "redpanda.mergeContainer")))) "r")) -}}
-{{- $_ := (set $merged.spec "volumes" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list $original.spec.volumes $overrideSpec.volumes "name" "redpanda.mergeVolume")))) "r")) -}}
+{{- $_ := (set $merged.spec "initContainers" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list (dict "name" "" "resources" (dict)) (dict) $original.spec.initContainers $overrideSpec.initContainers "name" "redpanda.mergeContainer")))) "r")) -}}
+{{- $_ := (set $merged.spec "containers" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list (dict "name" "" "resources" (dict)) (dict) $original.spec.containers $overrideSpec.containers "name" "redpanda.mergeContainer")))) "r")) -}}
+{{- $_ := (set $merged.spec "volumes" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list (dict "name" "") (dict) $original.spec.volumes $overrideSpec.volumes "name" "redpanda.mergeVolume")))) "r")) -}}
 {{- if (eq (toJson $merged.metadata.labels) "null") -}}
 {{- $_ := (set $merged.metadata "labels" (dict)) -}}
 {{- end -}}
@@ -489,10 +489,12 @@
 {{- end -}}
 
 {{- define "redpanda.mergeSliceBy" -}}
-{{- $original := (index .a 0) -}}
-{{- $override := (index .a 1) -}}
-{{- $mergeKey := (index .a 2) -}}
-{{- $mergeFunc := (index .a 3) -}}
+{{- $_Original_zero := (index .a 0) -}}
+{{- $_Overrides_zero := (index .a 1) -}}
+{{- $original := (index .a 2) -}}
+{{- $override := (index .a 3) -}}
+{{- $mergeKey := (index .a 4) -}}
+{{- $mergeFunc := (index .a 5) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $originalKeys := (dict) -}}
@@ -515,11 +517,11 @@
 {{- $key := (index $_511_key__ 0) -}}
 {{- $_ := (index $_511_key__ 1) -}}
 {{- $_ := (set $originalKeys $key true) -}}
-{{- $_513_elOverride_7_ok_8 := (get (fromJson (include "_shims.dicttest" (dict "a" (list $overrideByKey $key (coalesce nil))))) "r") -}}
+{{- $_513_elOverride_7_ok_8 := (get (fromJson (include "_shims.dicttest" (dict "a" (list $overrideByKey $key $_Overrides_zero)))) "r") -}}
 {{- $elOverride_7 := (index $_513_elOverride_7_ok_8 0) -}}
 {{- $ok_8 := (index $_513_elOverride_7_ok_8 1) -}}
 {{- if $ok_8 -}}
-{{- $merged = (concat (default (list) $merged) (list (get (fromJson (include $mergeFunc (dict "a" (list $el $elOverride_7)))) "r"))) -}}
+{{- $merged = (concat (default (list) $merged) (list (get (fromJson (include (dig "fn" $mergeFunc (ternary $mergeFunc (dict) (kindIs "map" $mergeFunc))) (dict "a" (list $el $elOverride_7) "c" (dig "c" (list) (ternary $mergeFunc (dict) (kindIs "map" $mergeFunc)))))) "r"))) -}}
 {{- else -}}
 {{- $merged = (concat (default (list) $merged) (list $el)) -}}
 {{- end -}}
@@ -590,8 +592,8 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $merged := (merge (dict) $override $original) -}}
-{{- $_ := (set $merged "env" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list $original.env $override.env "name" "redpanda.mergeEnvVar")))) "r")) -}}
-{{- $_ := (set $merged "volumeMounts" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list $original.volumeMounts $override.volumeMounts "name" "redpanda.mergeVolumeMount")))) "r")) -}}
+{{- $_ := (set $merged "env" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list (dict "name" "") (dict) $original.env $override.env "name" "redpanda.mergeEnvVar")))) "r")) -}}
+{{- $_ := (set $merged "volumeMounts" (get (fromJson (include "redpanda.mergeSliceBy" (dict "a" (list (dict "name" "" "mountPath" "") (dict) $original.volumeMounts $override.volumeMounts "name" "redpanda.mergeVolumeMount")))) "r")) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $merged) | toJson -}}
 {{- break -}}
@@ -605,7 +607,7 @@
 {{- $parsed := (dict) -}}
 {{- $i := -1 -}}
 {{- range $_, $_ := $args -}}
-{{- $i = ((add $i (1 | int)) | int) -}}
+{{- $i = ((add $i 1) | int) -}}
 {{- if (ge $i ((get (fromJson (include "_shims.len" (dict "a" (list $args)))) "r") | int)) -}}
 {{- break -}}
 {{- end -}}
@@ -613,14 +615,14 @@
 {{- continue -}}
 {{- end -}}
 {{- $flag := (index $args $i) -}}
-{{- $spl := (mustRegexSplit " |=" $flag (2 | int)) -}}
-{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $spl)))) "r") | int) (2 | int)) -}}
-{{- $_ := (set $parsed (index $spl (0 | int)) (index $spl (1 | int))) -}}
+{{- $spl := (mustRegexSplit " |=" $flag 2) -}}
+{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $spl)))) "r") | int) 2) -}}
+{{- $_ := (set $parsed (index $spl 0) (index $spl 1)) -}}
 {{- continue -}}
 {{- end -}}
-{{- if (and (lt ((add $i (1 | int)) | int) ((get (fromJson (include "_shims.len" (dict "a" (list $args)))) "r") | int)) (not (hasPrefix "-" (index $args ((add $i (1 | int)) | int))))) -}}
-{{- $_ := (set $parsed $flag (index $args ((add $i (1 | int)) | int))) -}}
-{{- $i = ((add $i (1 | int)) | int) -}}
+{{- if (and (lt ((add $i 1) | int) ((get (fromJson (include "_shims.len" (dict "a" (list $args)))) "r") | int)) (not (hasPrefix "-" (index $args ((add $i 1) | int))))) -}}
+{{- $_ := (set $parsed $flag (index $args ((add $i 1) | int))) -}}
+{{- $i = ((add $i 1) | int) -}}
 {{- continue -}}
 {{- end -}}
 {{- $_ := (set $parsed $flag "") -}}
@@ -7,8 +7,8 @@
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
 {{- $budget := ($values.statefulset.budget.maxUnavailable | int) -}}
-{{- $minReplicas := ((div ($values.statefulset.replicas | int) (2 | int)) | int) -}}
-{{- if (and (gt $budget (1 | int)) (gt $budget $minReplicas)) -}}
+{{- $minReplicas := ((div ($values.statefulset.replicas | int) 2) | int) -}}
+{{- if (and (gt $budget 1) (gt $budget $minReplicas)) -}}
 {{- $_ := (fail (printf "statefulset.budget.maxUnavailable is set too high to maintain quorum: %d > %d" $budget $minReplicas)) -}}
 {{- end -}}
 {{- $maxUnavailable := ($budget | int) -}}
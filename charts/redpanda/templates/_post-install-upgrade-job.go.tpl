@@ -51,7 +51,7 @@
 {{- end -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.bootstrapEnvVars" (dict "a" (list $dot $envars)))) "r")) | toJson -}}
+{{- (include "redpanda.bootstrapEnvVars" (dict "a" (list $dot $envars))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
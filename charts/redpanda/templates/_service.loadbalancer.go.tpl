@@ -22,7 +22,7 @@
 {{- $selector := (get (fromJson (include "redpanda.StatefulSetPodLabelsSelector" (dict "a" (list $dot)))) "r") -}}
 {{- $services := (coalesce nil) -}}
 {{- $replicas := ($values.statefulset.replicas | int) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) (($values.statefulset.replicas | int)|int) (1|int) -}}
 {{- $podname := (printf "%s-%d" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r") $i) -}}
 {{- $annotations := (dict) -}}
 {{- range $k, $v := $values.external.annotations -}}
@@ -33,9 +33,9 @@
 {{- end -}}
 {{- if $externalDNS.enabled -}}
 {{- $prefix := $podname -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) (0 | int)) -}}
-{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) (1 | int)) -}}
-{{- $prefix = (index $values.external.addresses (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) 0) -}}
+{{- if (eq ((get (fromJson (include "_shims.len" (dict "a" (list $values.external.addresses)))) "r") | int) 1) -}}
+{{- $prefix = (index $values.external.addresses 0) -}}
 {{- else -}}
 {{- $prefix = (index $values.external.addresses $i) -}}
 {{- end -}}
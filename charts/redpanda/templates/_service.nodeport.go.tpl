@@ -17,12 +17,12 @@
 {{- end -}}
 {{- $ports := (coalesce nil) -}}
 {{- range $name, $listener := $values.listeners.admin.external -}}
-{{- if (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $listener)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $listener.enabled true)))) "r") (gt ($listener.port | int) 0))) -}}
 {{- continue -}}
 {{- end -}}
 {{- $nodePort := ($listener.port | int) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (0 | int)) -}}
-{{- $nodePort = (index $listener.advertisedPorts (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 0) -}}
+{{- $nodePort = (index $listener.advertisedPorts 0) -}}
 {{- end -}}
 {{- $ports = (concat (default (list) $ports) (list (mustMergeOverwrite (dict "port" 0 "targetPort" 0) (dict "name" (printf "admin-%s" $name) "protocol" "TCP" "port" ($listener.port | int) "nodePort" $nodePort)))) -}}
 {{- end -}}
@@ -30,12 +30,12 @@
 {{- break -}}
 {{- end -}}
 {{- range $name, $listener := $values.listeners.kafka.external -}}
-{{- if (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $listener)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $listener.enabled true)))) "r") (gt ($listener.port | int) 0))) -}}
 {{- continue -}}
 {{- end -}}
 {{- $nodePort := ($listener.port | int) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (0 | int)) -}}
-{{- $nodePort = (index $listener.advertisedPorts (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 0) -}}
+{{- $nodePort = (index $listener.advertisedPorts 0) -}}
 {{- end -}}
 {{- $ports = (concat (default (list) $ports) (list (mustMergeOverwrite (dict "port" 0 "targetPort" 0) (dict "name" (printf "kafka-%s" $name) "protocol" "TCP" "port" ($listener.port | int) "nodePort" $nodePort)))) -}}
 {{- end -}}
@@ -43,12 +43,12 @@
 {{- break -}}
 {{- end -}}
 {{- range $name, $listener := $values.listeners.http.external -}}
-{{- if (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $listener)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $listener.enabled true)))) "r") (gt ($listener.port | int) 0))) -}}
 {{- continue -}}
 {{- end -}}
 {{- $nodePort := ($listener.port | int) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (0 | int)) -}}
-{{- $nodePort = (index $listener.advertisedPorts (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 0) -}}
+{{- $nodePort = (index $listener.advertisedPorts 0) -}}
 {{- end -}}
 {{- $ports = (concat (default (list) $ports) (list (mustMergeOverwrite (dict "port" 0 "targetPort" 0) (dict "name" (printf "http-%s" $name) "protocol" "TCP" "port" ($listener.port | int) "nodePort" $nodePort)))) -}}
 {{- end -}}
@@ -56,12 +56,12 @@
 {{- break -}}
 {{- end -}}
 {{- range $name, $listener := $values.listeners.schemaRegistry.external -}}
-{{- if (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $listener)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $listener.enabled true)))) "r") (gt ($listener.port | int) 0))) -}}
 {{- continue -}}
 {{- end -}}
 {{- $nodePort := ($listener.port | int) -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) (0 | int)) -}}
-{{- $nodePort = (index $listener.advertisedPorts (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $listener.advertisedPorts)))) "r") | int) 0) -}}
+{{- $nodePort = (index $listener.advertisedPorts 0) -}}
 {{- end -}}
 {{- $ports = (concat (default (list) $ports) (list (mustMergeOverwrite (dict "port" 0 "targetPort" 0) (dict "name" (printf "schema-%s" $name) "protocol" "TCP" "port" ($listener.port | int) "nodePort" $nodePort)))) -}}
 {{- end -}}
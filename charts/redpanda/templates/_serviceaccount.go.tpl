@@ -12,7 +12,7 @@
 {{- break -}}
 {{- else -}}{{- if $serviceAccount.create -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.Fullname" (dict "a" (list $dot)))) "r")) | toJson -}}
+{{- (include "redpanda.Fullname" (dict "a" (list $dot))) -}}
 {{- break -}}
 {{- else -}}{{- if (ne $serviceAccount.name "") -}}
 {{- $_is_returning = true -}}
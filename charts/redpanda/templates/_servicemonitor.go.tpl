@@ -11,7 +11,7 @@
 {{- break -}}
 {{- end -}}
 {{- $endpoint := (mustMergeOverwrite (dict) (dict "interval" $values.monitoring.scrapeInterval "path" "/public_metrics" "port" "admin" "enableHttp2" $values.monitoring.enableHttp2 "scheme" "http")) -}}
-{{- if (or (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $values.listeners.admin.tls $values.tls)))) "r") (ne (toJson $values.monitoring.tlsConfig) "null")) -}}
+{{- if (or (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $values.listeners.admin.tls.enabled $values.tls.enabled)))) "r") (ne $values.listeners.admin.tls.cert "")) (ne (toJson $values.monitoring.tlsConfig) "null")) -}}
 {{- $_ := (set $endpoint "scheme" "https") -}}
 {{- $_ := (set $endpoint "tlsConfig" $values.monitoring.tlsConfig) -}}
 {{- if (eq (toJson $endpoint.tlsConfig) "null") -}}
@@ -7,7 +7,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $result := (dict) -}}
-{{- if (not (get (fromJson (include "redpanda.RedpandaAtLeast_23_3_0" (dict "a" (list $dot)))) "r")) -}}
+{{- if (not (get (fromJson (include "redpanda.redpandaAtLeast" (dict "a" (list $dot ">=23.3.0-0 || <0.0.1-0")))) "r")) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $result) | toJson -}}
 {{- break -}}
@@ -19,28 +19,28 @@
 {{- (dict "r" $result) | toJson -}}
 {{- break -}}
 {{- end -}}
-{{- if (ne (($a.clientMaxBufferSize | int) | int) (16777216 | int)) -}}
+{{- if (ne (($a.clientMaxBufferSize | int) | int) 16777216) -}}
 {{- $_ := (set $result "audit_client_max_buffer_size" ($a.clientMaxBufferSize | int)) -}}
 {{- end -}}
-{{- if (ne (($a.queueDrainIntervalMs | int) | int) (500 | int)) -}}
+{{- if (ne (($a.queueDrainIntervalMs | int) | int) 500) -}}
 {{- $_ := (set $result "audit_queue_drain_interval_ms" ($a.queueDrainIntervalMs | int)) -}}
 {{- end -}}
-{{- if (ne (($a.queueMaxBufferSizePerShard | int) | int) (1048576 | int)) -}}
+{{- if (ne (($a.queueMaxBufferSizePerShard | int) | int) 1048576) -}}
 {{- $_ := (set $result "audit_queue_max_buffer_size_per_shard" ($a.queueMaxBufferSizePerShard | int)) -}}
 {{- end -}}
-{{- if (ne (($a.partitions | int) | int) (12 | int)) -}}
+{{- if (ne (($a.partitions | int) | int) 12) -}}
 {{- $_ := (set $result "audit_log_num_partitions" ($a.partitions | int)) -}}
 {{- end -}}
-{{- if (ne ($a.replicationFactor | int) (0 | int)) -}}
+{{- if (ne ($a.replicationFactor | int) 0) -}}
 {{- $_ := (set $result "audit_log_replication_factor" ($a.replicationFactor | int)) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $a.enabledEventTypes)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $a.enabledEventTypes)))) "r") | int) 0) -}}
 {{- $_ := (set $result "audit_enabled_event_types" $a.enabledEventTypes) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $a.excludedTopics)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $a.excludedTopics)))) "r") | int) 0) -}}
 {{- $_ := (set $result "audit_excluded_topics" $a.excludedTopics) -}}
 {{- end -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $a.excludedPrincipals)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $a.excludedPrincipals)))) "r") | int) 0) -}}
 {{- $_ := (set $result "audit_excluded_principals" $a.excludedPrincipals) -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -151,13 +151,13 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- if (and (ne (toJson $rr.limits) "null") (ne (toJson $rr.requests) "null")) -}}
-{{- $_424_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.requests) "cpu" "0")))) "r") -}}
-{{- $cpuReq := (index $_424_cpuReq_ok 0) -}}
-{{- $ok := (index $_424_cpuReq_ok 1) -}}
+{{- $_426_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.requests) "cpu" "0")))) "r") -}}
+{{- $cpuReq := (index $_426_cpuReq_ok 0) -}}
+{{- $ok := (index $_426_cpuReq_ok 1) -}}
 {{- if (not $ok) -}}
-{{- $_426_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.limits) "cpu" "0")))) "r") -}}
-{{- $cpuReq = (index $_426_cpuReq_ok 0) -}}
-{{- $ok = (index $_426_cpuReq_ok 1) -}}
+{{- $_428_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.limits) "cpu" "0")))) "r") -}}
+{{- $cpuReq = (index $_428_cpuReq_ok 0) -}}
+{{- $ok = (index $_428_cpuReq_ok 1) -}}
 {{- end -}}
 {{- if (and $ok (lt ((get (fromJson (include "_shims.resource_MilliValue" (dict "a" (list $cpuReq)))) "r") | int64) (1000 | int64))) -}}
 {{- $_is_returning = true -}}
@@ -174,7 +174,7 @@
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $rr.cpu.overprovisioned false)))) "r")) | toJson -}}
+{{- (include "_shims.ptr_Deref" (dict "a" (list $rr.cpu.overprovisioned false))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -184,13 +184,13 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- if (and (ne (toJson $rr.limits) "null") (ne (toJson $rr.requests) "null")) -}}
-{{- $_450_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.requests) "cpu" "0")))) "r") -}}
-{{- $cpuReq := (index $_450_cpuReq_ok 0) -}}
-{{- $ok := (index $_450_cpuReq_ok 1) -}}
+{{- $_452_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.requests) "cpu" "0")))) "r") -}}
+{{- $cpuReq := (index $_452_cpuReq_ok 0) -}}
+{{- $ok := (index $_452_cpuReq_ok 1) -}}
 {{- if (not $ok) -}}
-{{- $_452_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.limits) "cpu" "0")))) "r") -}}
-{{- $cpuReq = (index $_452_cpuReq_ok 0) -}}
-{{- $ok = (index $_452_cpuReq_ok 1) -}}
+{{- $_454_cpuReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.limits) "cpu" "0")))) "r") -}}
+{{- $cpuReq = (index $_454_cpuReq_ok 0) -}}
+{{- $ok = (index $_454_cpuReq_ok 1) -}}
 {{- end -}}
 {{- if (not $ok) -}}
 {{- $_is_returning = true -}}
@@ -222,13 +222,13 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- if (and (ne (toJson $rr.limits) "null") (ne (toJson $rr.requests) "null")) -}}
-{{- $_509_memReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.requests) "memory" "0")))) "r") -}}
-{{- $memReq := (index $_509_memReq_ok 0) -}}
-{{- $ok := (index $_509_memReq_ok 1) -}}
+{{- $_511_memReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.requests) "memory" "0")))) "r") -}}
+{{- $memReq := (index $_511_memReq_ok 0) -}}
+{{- $ok := (index $_511_memReq_ok 1) -}}
 {{- if (not $ok) -}}
-{{- $_511_memReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.limits) "memory" "0")))) "r") -}}
-{{- $memReq = (index $_511_memReq_ok 0) -}}
-{{- $ok = (index $_511_memReq_ok 1) -}}
+{{- $_513_memReq_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list ($rr.limits) "memory" "0")))) "r") -}}
+{{- $memReq = (index $_513_memReq_ok 0) -}}
+{{- $ok = (index $_513_memReq_ok 1) -}}
 {{- end -}}
 {{- if (not $ok) -}}
 {{- $_is_returning = true -}}
@@ -237,14 +237,14 @@
 {{- end -}}
 {{- $memory := (((mulf (((get (fromJson (include "_shims.resource_Value" (dict "a" (list $memReq)))) "r") | int64) | float64) 0.90) | float64) | int64) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" ((div $memory ((mul (1024 | int) (1024 | int)))) | int64)) | toJson -}}
+{{- (dict "r" ((div $memory (mul 1024 1024)) | int64)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $memory := ((0 | int64) | int64) -}}
 {{- $containerMemory := ((get (fromJson (include "redpanda.RedpandaResources.containerMemory" (dict "a" (list $rr)))) "r") | int64) -}}
 {{- $rpMem_5 := $rr.memory.redpanda -}}
 {{- if (and (ne (toJson $rpMem_5) "null") (ne (toJson $rpMem_5.memory) "null")) -}}
-{{- $memory = ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rpMem_5.memory)))) "r") | int64) ((mul (1024 | int) (1024 | int)))) | int64) -}}
+{{- $memory = ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rpMem_5.memory)))) "r") | int64) (mul 1024 1024)) | int64) -}}
 {{- else -}}
 {{- $memory = (((mulf ($containerMemory | float64) 0.8) | float64) | int64) -}}
 {{- end -}}
@@ -275,7 +275,7 @@
 {{- $rpMem_6 := $rr.memory.redpanda -}}
 {{- if (and (ne (toJson $rpMem_6) "null") (ne (toJson $rpMem_6.reserveMemory) "null")) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rpMem_6.reserveMemory)))) "r") | int64) ((mul (1024 | int) (1024 | int)))) | int64)) | toJson -}}
+{{- (dict "r" ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rpMem_6.reserveMemory)))) "r") | int64) (mul 1024 1024)) | int64)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -290,11 +290,11 @@
 {{- $_is_returning := false -}}
 {{- if (ne (toJson $rr.memory.container.min) "null") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rr.memory.container.min)))) "r") | int64) ((mul (1024 | int) (1024 | int)))) | int64)) | toJson -}}
+{{- (dict "r" ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rr.memory.container.min)))) "r") | int64) (mul 1024 1024)) | int64)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rr.memory.container.max)))) "r") | int64) ((mul (1024 | int) (1024 | int)))) | int64)) | toJson -}}
+{{- (dict "r" ((div ((get (fromJson (include "_shims.resource_Value" (dict "a" (list $rr.memory.container.max)))) "r") | int64) (mul 1024 1024)) | int64)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -304,9 +304,9 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $conf := (get (fromJson (include "redpanda.Storage.GetTieredStorageConfig" (dict "a" (list $s)))) "r") -}}
-{{- $_629_b_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list $conf "cloud_storage_enabled" (coalesce nil))))) "r") -}}
-{{- $b := (index $_629_b_ok 0) -}}
-{{- $ok := (index $_629_b_ok 1) -}}
+{{- $_631_b_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list $conf "cloud_storage_enabled" (coalesce nil))))) "r") -}}
+{{- $b := (index $_631_b_ok 0) -}}
+{{- $ok := (index $_631_b_ok 1) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (and $ok (get (fromJson (include "_shims.typeassertion" (dict "a" (list "bool" $b)))) "r"))) | toJson -}}
 {{- break -}}
@@ -317,7 +317,7 @@
 {{- $s := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $s.tieredConfig)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $s.tieredConfig)))) "r") | int) 0) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $s.tieredConfig) | toJson -}}
 {{- break -}}
@@ -351,18 +351,18 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $values := $dot.Values.AsMap -}}
-{{- $_658_dir_7_ok_8 := (get (fromJson (include "_shims.typetest" (dict "a" (list "string" (index $values.config.node "cloud_storage_cache_directory") "")))) "r") -}}
-{{- $dir_7 := (index $_658_dir_7_ok_8 0) -}}
-{{- $ok_8 := (index $_658_dir_7_ok_8 1) -}}
+{{- $_660_dir_7_ok_8 := (get (fromJson (include "_shims.typetest" (dict "a" (list "string" (index $values.config.node "cloud_storage_cache_directory") "")))) "r") -}}
+{{- $dir_7 := (index $_660_dir_7_ok_8 0) -}}
+{{- $ok_8 := (index $_660_dir_7_ok_8 1) -}}
 {{- if $ok_8 -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $dir_7) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $tieredConfig := (get (fromJson (include "redpanda.Storage.GetTieredStorageConfig" (dict "a" (list $values.storage)))) "r") -}}
-{{- $_667_dir_9_ok_10 := (get (fromJson (include "_shims.typetest" (dict "a" (list "string" (index $tieredConfig "cloud_storage_cache_directory") "")))) "r") -}}
-{{- $dir_9 := (index $_667_dir_9_ok_10 0) -}}
-{{- $ok_10 := (index $_667_dir_9_ok_10 1) -}}
+{{- $_669_dir_9_ok_10 := (get (fromJson (include "_shims.typetest" (dict "a" (list "string" (index $tieredConfig "cloud_storage_cache_directory") "")))) "r") -}}
+{{- $dir_9 := (index $_669_dir_9_ok_10 0) -}}
+{{- $ok_10 := (index $_669_dir_9_ok_10 1) -}}
 {{- if $ok_10 -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $dir_9) | toJson -}}
@@ -445,12 +445,12 @@
 {{- $_is_returning := false -}}
 {{- if (and (ne (toJson $s.persistentVolume) "null") (not $s.persistentVolume.enabled)) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (5368709120 | int)) | toJson -}}
+{{- (dict "r" 5368709120) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $minimumFreeBytes := ((mulf (((get (fromJson (include "_shims.resource_Value" (dict "a" (list $s.persistentVolume.size)))) "r") | int64) | float64) 0.05) | float64) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (min (5368709120 | int) ($minimumFreeBytes | int64))) | toJson -}}
+{{- (dict "r" (min 5368709120 ($minimumFreeBytes | int64))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -462,15 +462,18 @@
 {{- $result := (dict) -}}
 {{- $s := (toJson $t) -}}
 {{- $tune := (fromJson $s) -}}
-{{- $_813_m_ok := (get (fromJson (include "_shims.typetest" (dict "a" (list (printf "map[%s]%s" "string" "interface {}") $tune (coalesce nil))))) "r") -}}
-{{- $m := (index $_813_m_ok 0) -}}
-{{- $ok := (index $_813_m_ok 1) -}}
+{{- $_818_m_ok := (get (fromJson (include "_shims.typetest" (dict "a" (list (printf "map[%s]%s" "string" "interface {}") $tune (coalesce nil))))) "r") -}}
+{{- $m := (index $_818_m_ok 0) -}}
+{{- $ok := (index $_818_m_ok 1) -}}
 {{- if (not $ok) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (dict)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- range $k, $v := $m -}}
+{{- if (empty $v) -}}
+{{- continue -}}
+{{- end -}}
 {{- $_ := (set $result $k $v) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -487,7 +490,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (or ((and $s.controllers.enabled $s.controllers.createRBAC)) (get (fromJson (include "redpanda.Sidecars.AdditionalSidecarControllersEnabled" (dict "a" (list $s)))) "r"))) | toJson -}}
+{{- (dict "r" (or (and $s.controllers.enabled $s.controllers.createRBAC) (or $s.pvcUnbinder.enabled $s.brokerDecommissioner.enabled))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -509,8 +512,8 @@
 {{- $internalDomain := (index .a 3) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $result := (coalesce nil) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) ($replicas|int) (1|int) -}}
+{{- $result := (list) -}}
+{{- range $_, $i := untilStep (0|int) ($replicas|int) (1|int) -}}
 {{- $result = (concat (default (list) $result) (list (dict "host" (dict "address" (printf "%s-%d.%s" $fullname $i $internalDomain) "port" ($l.rpc.port | int))))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -530,7 +533,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.ServerList" (dict "a" (list $replicas "" $fullname $internalDomain ($l.admin.port | int))))) "r")) | toJson -}}
+{{- (include "redpanda.ServerList" (dict "a" (list $replicas "" $fullname $internalDomain ($l.admin.port | int)))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -543,7 +546,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.ServerList" (dict "a" (list $replicas "" $fullname $internalDomain ($l.schemaRegistry.port | int))))) "r")) | toJson -}}
+{{- (include "redpanda.ServerList" (dict "a" (list $replicas "" $fullname $internalDomain ($l.schemaRegistry.port | int)))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -557,7 +560,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $result := (coalesce nil) -}}
-{{- range $_, $i := untilStep (((0 | int) | int)|int) ($replicas|int) (1|int) -}}
+{{- range $_, $i := untilStep (0|int) ($replicas|int) (1|int) -}}
 {{- $result = (concat (default (list) $result) (list (printf "%s%s-%d.%s:%d" $prefix $fullname $i $internalDomain ($port | int)))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -602,7 +605,7 @@
 {{- if $_is_returning -}}
 {{- break -}}
 {{- end -}}
-{{- if (lt ((get (fromJson (include "_shims.len" (dict "a" (list $sources)))) "r") | int) (1 | int)) -}}
+{{- if (lt ((get (fromJson (include "_shims.len" (dict "a" (list $sources)))) "r") | int) 1) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (coalesce nil)) | toJson -}}
 {{- break -}}
@@ -620,9 +623,9 @@
 {{- $seen := (dict) -}}
 {{- $deduped := (coalesce nil) -}}
 {{- range $_, $item := $items -}}
-{{- $_964___ok_11 := (get (fromJson (include "_shims.dicttest" (dict "a" (list $seen $item.key false)))) "r") -}}
-{{- $_ := (index $_964___ok_11 0) -}}
-{{- $ok_11 := (index $_964___ok_11 1) -}}
+{{- $_978___ok_11 := (get (fromJson (include "_shims.dicttest" (dict "a" (list $seen $item.key false)))) "r") -}}
+{{- $_ := (index $_978___ok_11 0) -}}
+{{- $ok_11 := (index $_978___ok_11 1) -}}
 {{- if $ok_11 -}}
 {{- continue -}}
 {{- end -}}
@@ -681,7 +684,7 @@
 {{- if (ne (toJson $v.repr) "null") -}}
 {{- $_ := (set $template $k (toString $v.repr)) -}}
 {{- else -}}{{- if (ne (toJson $v.configMapKeyRef) "null") -}}
-{{- $envName := (get (fromJson (include "redpanda.keyToEnvVar" (dict "a" (list $k)))) "r") -}}
+{{- $envName := (printf "%s%s" "REDPANDA_" (replace "." "_" (upper $k))) -}}
 {{- $envVars = (concat (default (list) $envVars) (list (mustMergeOverwrite (dict "name" "") (dict "name" $envName "valueFrom" (mustMergeOverwrite (dict) (dict "configMapKeyRef" $v.configMapKeyRef)))))) -}}
 {{- if $v.useRawValue -}}
 {{- $fixups = (concat (default (list) $fixups) (list (mustMergeOverwrite (dict "field" "" "cel" "") (dict "field" $k "cel" (printf `%s("%s")` "envString" $envName))))) -}}
@@ -689,7 +692,7 @@
 {{- $fixups = (concat (default (list) $fixups) (list (mustMergeOverwrite (dict "field" "" "cel" "") (dict "field" $k "cel" (printf `%s(%s("%s"))` "repr" "envString" $envName))))) -}}
 {{- end -}}
 {{- else -}}{{- if (ne (toJson $v.secretKeyRef) "null") -}}
-{{- $envName := (get (fromJson (include "redpanda.keyToEnvVar" (dict "a" (list $k)))) "r") -}}
+{{- $envName := (printf "%s%s" "REDPANDA_" (replace "." "_" (upper $k))) -}}
 {{- $envVars = (concat (default (list) $envVars) (list (mustMergeOverwrite (dict "name" "") (dict "name" $envName "valueFrom" (mustMergeOverwrite (dict) (dict "secretKeyRef" $v.secretKeyRef)))))) -}}
 {{- if $v.useRawValue -}}
 {{- $fixups = (concat (default (list) $fixups) (list (mustMergeOverwrite (dict "field" "" "cel" "") (dict "field" $k "cel" (printf `%s("%s")` "envString" $envName))))) -}}
@@ -734,9 +737,9 @@
 {{- $name := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_1185_cert_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list $m $name (dict "enabled" (coalesce nil) "caEnabled" false "applyInternalDNSNames" (coalesce nil) "duration" "" "issuerRef" (coalesce nil) "secretRef" (coalesce nil) "clientSecretRef" (coalesce nil)))))) "r") -}}
-{{- $cert := (index $_1185_cert_ok 0) -}}
-{{- $ok := (index $_1185_cert_ok 1) -}}
+{{- $_1204_cert_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list $m $name (dict "enabled" (coalesce nil) "caEnabled" false "applyInternalDNSNames" (coalesce nil) "duration" "" "issuerRef" (coalesce nil) "secretRef" (coalesce nil) "clientSecretRef" (coalesce nil)))))) "r") -}}
+{{- $cert := (index $_1204_cert_ok 0) -}}
+{{- $ok := (index $_1204_cert_ok 1) -}}
 {{- if (not $ok) -}}
 {{- $_ := (fail (printf "Certificate %q referenced, but not found in the tls.certs map" $name)) -}}
 {{- end -}}
@@ -872,7 +875,7 @@
 {{- $_is_returning := false -}}
 {{- if (ne (toJson $t.trustStore) "null") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.TrustStore.TrustStoreFilePath" (dict "a" (list $t.trustStore)))) "r")) | toJson -}}
+{{- (dict "r" (printf "%s/%s" "/etc/truststores" (get (fromJson (include "redpanda.TrustStore.RelativePath" (dict "a" (list $t.trustStore)))) "r"))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (get (fromJson (include "redpanda.TLSCertMap.MustGet" (dict "a" (list (deepCopy $tls.certs) $t.cert)))) "r").caEnabled -}}
@@ -893,7 +896,7 @@
 {{- $_is_returning := false -}}
 {{- if (ne (toJson $t.trustStore) "null") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.TrustStore.TrustStoreFilePath" (dict "a" (list $t.trustStore)))) "r")) | toJson -}}
+{{- (dict "r" (printf "%s/%s" "/etc/truststores" (get (fromJson (include "redpanda.TrustStore.RelativePath" (dict "a" (list $t.trustStore)))) "r"))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (get (fromJson (include "redpanda.TLSCertMap.MustGet" (dict "a" (list (deepCopy $tls.certs) $t.cert)))) "r").caEnabled -}}
@@ -914,7 +917,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.TLSCertMap.MustGet" (dict "a" (list (deepCopy $tls.certs) (get (fromJson (include "redpanda.ExternalTLS.GetCertName" (dict "a" (list $t $i)))) "r"))))) "r")) | toJson -}}
+{{- (include "redpanda.TLSCertMap.MustGet" (dict "a" (list (deepCopy $tls.certs) (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $t.cert $i.cert)))) "r")))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -925,7 +928,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $t.cert $i.cert)))) "r")) | toJson -}}
+{{- (include "_shims.ptr_Deref" (dict "a" (list $t.cert $i.cert))) -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -938,12 +941,12 @@
 {{- $_is_returning := false -}}
 {{- if (ne (toJson $t.trustStore) "null") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "redpanda.TrustStore.TrustStoreFilePath" (dict "a" (list $t.trustStore)))) "r")) | toJson -}}
+{{- (dict "r" (printf "%s/%s" "/etc/truststores" (get (fromJson (include "redpanda.TrustStore.RelativePath" (dict "a" (list $t.trustStore)))) "r"))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (get (fromJson (include "redpanda.ExternalTLS.GetCert" (dict "a" (list $t $i $tls)))) "r").caEnabled -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (printf "%s/%s/ca.crt" "/etc/tls/certs" (get (fromJson (include "redpanda.ExternalTLS.GetCertName" (dict "a" (list $t $i)))) "r"))) | toJson -}}
+{{- (dict "r" (printf "%s/%s/ca.crt" "/etc/tls/certs" (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $t.cert $i.cert)))) "r"))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -964,7 +967,7 @@
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (and (ne (get (fromJson (include "redpanda.ExternalTLS.GetCertName" (dict "a" (list $t $i)))) "r") "") (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $t.enabled (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $i $tls)))) "r"))))) "r"))) | toJson -}}
+{{- (dict "r" (and (ne (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $t.cert $i.cert)))) "r") "") (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $t.enabled (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $i.enabled $tls.enabled)))) "r") (ne $i.cert "")))))) "r"))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -981,7 +984,7 @@
 {{- continue -}}
 {{- end -}}
 {{- $fallbackPorts := (concat (default (list) $listener.advertisedPorts) (list ($l.port | int))) -}}
-{{- $ports = (concat (default (list) $ports) (list (mustMergeOverwrite (dict "port" 0 "targetPort" 0) (dict "name" (printf "%s-%s" $namePrefix $name) "protocol" "TCP" "appProtocol" $l.appProtocol "targetPort" ($listener.port | int) "port" ((get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $listener.nodePort (index $fallbackPorts (0 | int)))))) "r") | int))))) -}}
+{{- $ports = (concat (default (list) $ports) (list (mustMergeOverwrite (dict "port" 0 "targetPort" 0) (dict "name" (printf "%s-%s" $namePrefix $name) "protocol" "TCP" "appProtocol" $l.appProtocol "targetPort" ($listener.port | int) "port" ((get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $listener.nodePort (index $fallbackPorts 0))))) "r") | int))))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
 {{- break -}}
@@ -998,12 +1001,12 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $tss := (list) -}}
-{{- if (and (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $l.tls $tls)))) "r") (ne (toJson $l.tls.trustStore) "null")) -}}
+{{- if (and (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $l.tls.enabled $tls.enabled)))) "r") (ne $l.tls.cert "")) (ne (toJson $l.tls.trustStore) "null")) -}}
 {{- $tss = (concat (default (list) $tss) (list $l.tls.trustStore)) -}}
 {{- end -}}
 {{- range $_, $key := (sortAlpha (keys $l.external)) -}}
 {{- $lis := (ternary (index $l.external $key) (dict "enabled" (coalesce nil) "advertisedPorts" (coalesce nil) "port" 0 "nodePort" (coalesce nil) "tls" (coalesce nil)) (hasKey $l.external $key)) -}}
-{{- if (or (or (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $lis)))) "r")) (not (get (fromJson (include "redpanda.ExternalTLS.IsEnabled" (dict "a" (list $lis.tls $l.tls $tls)))) "r"))) (eq (toJson $lis.tls.trustStore) "null")) -}}
+{{- if (or (or (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $lis.enabled true)))) "r") (gt ($lis.port | int) 0))) (not (get (fromJson (include "redpanda.ExternalTLS.IsEnabled" (dict "a" (list $lis.tls $l.tls $tls)))) "r"))) (eq (toJson $lis.tls.trustStore) "null")) -}}
 {{- continue -}}
 {{- end -}}
 {{- $tss = (concat (default (list) $tss) (list $lis.tls.trustStore)) -}}
@@ -1030,7 +1033,7 @@
 {{- end -}}
 {{- $listeners := (list $internal) -}}
 {{- range $k, $l := $l.external -}}
-{{- if (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $l)))) "r")) -}}
+{{- if (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $l.enabled true)))) "r") (gt ($l.port | int) 0))) -}}
 {{- continue -}}
 {{- end -}}
 {{- $listener := (dict "name" $k "port" ($l.port | int) "address" "0.0.0.0") -}}
@@ -1056,14 +1059,14 @@
 {{- $_is_returning := false -}}
 {{- $pp := (list) -}}
 {{- $internal := (get (fromJson (include "redpanda.createInternalListenerTLSCfg" (dict "a" (list $tls $l.tls)))) "r") -}}
-{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $internal)))) "r") | int) (0 | int)) -}}
+{{- if (gt ((get (fromJson (include "_shims.len" (dict "a" (list $internal)))) "r") | int) 0) -}}
 {{- $pp = (concat (default (list) $pp) (list $internal)) -}}
 {{- end -}}
 {{- range $k, $lis := $l.external -}}
-{{- if (or (not (get (fromJson (include "redpanda.ExternalListener.IsEnabled" (dict "a" (list $lis)))) "r")) (not (get (fromJson (include "redpanda.ExternalTLS.IsEnabled" (dict "a" (list $lis.tls $l.tls $tls)))) "r"))) -}}
+{{- if (or (not (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $lis.enabled true)))) "r") (gt ($lis.port | int) 0))) (not (get (fromJson (include "redpanda.ExternalTLS.IsEnabled" (dict "a" (list $lis.tls $l.tls $tls)))) "r"))) -}}
 {{- continue -}}
 {{- end -}}
-{{- $certName := (get (fromJson (include "redpanda.ExternalTLS.GetCertName" (dict "a" (list $lis.tls $l.tls)))) "r") -}}
+{{- $certName := (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $lis.tls.cert $l.tls.cert)))) "r") -}}
 {{- $pp = (concat (default (list) $pp) (list (dict "name" $k "enabled" true "cert_file" (printf "%s/%s/tls.crt" "/etc/tls/certs" $certName) "key_file" (printf "%s/%s/tls.key" "/etc/tls/certs" $certName) "require_client_auth" (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $lis.tls.requireClientAuth false)))) "r") "truststore_file" (get (fromJson (include "redpanda.ExternalTLS.TrustStoreFilePath" (dict "a" (list $lis.tls $l.tls $tls)))) "r")))) -}}
 {{- end -}}
 {{- if $_is_returning -}}
@@ -1080,7 +1083,7 @@
 {{- $tls := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $t := (mustMergeOverwrite (dict "enabled" false "caFilepath" "" "certFilepath" "" "keyFilepath" "" "insecureSkipTlsVerify" false) (dict "enabled" (get (fromJson (include "redpanda.InternalTLS.IsEnabled" (dict "a" (list $l.tls $tls)))) "r"))) -}}
+{{- $t := (mustMergeOverwrite (dict "enabled" false "caFilepath" "" "certFilepath" "" "keyFilepath" "" "insecureSkipTlsVerify" false) (dict "enabled" (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $l.tls.enabled $tls.enabled)))) "r") (ne $l.tls.cert "")))) -}}
 {{- if (not $t.enabled) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" $t) | toJson -}}
@@ -1110,7 +1113,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $l.enabled true)))) "r") (gt ($l.port | int) (0 | int)))) | toJson -}}
+{{- (dict "r" (and (get (fromJson (include "_shims.ptr_Deref" (dict "a" (list $l.enabled true)))) "r") (gt ($l.port | int) 0))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -1146,9 +1149,9 @@
 {{- $result := (dict) -}}
 {{- range $k, $v := $c -}}
 {{- if (not (empty $v)) -}}
-{{- $_1634___ok_14 := (get (fromJson (include "_shims.asnumeric" (dict "a" (list $v)))) "r") -}}
-{{- $_ := ((index $_1634___ok_14 0) | float64) -}}
-{{- $ok_14 := (index $_1634___ok_14 1) -}}
+{{- $_1653___ok_14 := (get (fromJson (include "_shims.asnumeric" (dict "a" (list $v)))) "r") -}}
+{{- $_ := ((index $_1653___ok_14 0) | float64) -}}
+{{- $ok_14 := (index $_1653___ok_14 1) -}}
 {{- if $ok_14 -}}
 {{- $_ := (set $result $k $v) -}}
 {{- else -}}{{- if (kindIs "bool" $v) -}}
@@ -1174,9 +1177,9 @@
 {{- $_is_returning := false -}}
 {{- $result := (dict) -}}
 {{- range $k, $v := $c -}}
-{{- $_1654_b_15_ok_16 := (get (fromJson (include "_shims.typetest" (dict "a" (list "bool" $v false)))) "r") -}}
-{{- $b_15 := (index $_1654_b_15_ok_16 0) -}}
-{{- $ok_16 := (index $_1654_b_15_ok_16 1) -}}
+{{- $_1673_b_15_ok_16 := (get (fromJson (include "_shims.typetest" (dict "a" (list "bool" $v false)))) "r") -}}
+{{- $b_15 := (index $_1673_b_15_ok_16 0) -}}
+{{- $ok_16 := (index $_1673_b_15_ok_16 1) -}}
 {{- if $ok_16 -}}
 {{- $_ := (set $result $k $b_15) -}}
 {{- continue -}}
@@ -1219,20 +1222,20 @@
 {{- $config := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_1699___hasAccessKey := (get (fromJson (include "_shims.dicttest" (dict "a" (list $config "cloud_storage_access_key" (coalesce nil))))) "r") -}}
-{{- $_ := (index $_1699___hasAccessKey 0) -}}
-{{- $hasAccessKey := (index $_1699___hasAccessKey 1) -}}
-{{- $_1700___hasSecretKey := (get (fromJson (include "_shims.dicttest" (dict "a" (list $config "cloud_storage_secret_key" (coalesce nil))))) "r") -}}
-{{- $_ := (index $_1700___hasSecretKey 0) -}}
-{{- $hasSecretKey := (index $_1700___hasSecretKey 1) -}}
-{{- $_1701___hasSharedKey := (get (fromJson (include "_shims.dicttest" (dict "a" (list $config "cloud_storage_azure_shared_key" (coalesce nil))))) "r") -}}
-{{- $_ := (index $_1701___hasSharedKey 0) -}}
-{{- $hasSharedKey := (index $_1701___hasSharedKey 1) -}}
+{{- $_1718___hasAccessKey := (get (fromJson (include "_shims.dicttest" (dict "a" (list $config "cloud_storage_access_key" (coalesce nil))))) "r") -}}
+{{- $_ := (index $_1718___hasAccessKey 0) -}}
+{{- $hasAccessKey := (index $_1718___hasAccessKey 1) -}}
+{{- $_1719___hasSecretKey := (get (fromJson (include "_shims.dicttest" (dict "a" (list $config "cloud_storage_secret_key" (coalesce nil))))) "r") -}}
+{{- $_ := (index $_1719___hasSecretKey 0) -}}
+{{- $hasSecretKey := (index $_1719___hasSecretKey 1) -}}
+{{- $_1720___hasSharedKey := (get (fromJson (include "_shims.dicttest" (dict "a" (list $config "cloud_storage_azure_shared_key" (coalesce nil))))) "r") -}}
+{{- $_ := (index $_1720___hasSharedKey 0) -}}
+{{- $hasSharedKey := (index $_1720___hasSharedKey 1) -}}
 {{- $envvars := (coalesce nil) -}}
-{{- if (and (not $hasAccessKey) (get (fromJson (include "redpanda.SecretRef.IsValid" (dict "a" (list $tsc.accessKey)))) "r")) -}}
+{{- if (and (not $hasAccessKey) (and (and (ne (toJson $tsc.accessKey) "null") (not (empty $tsc.accessKey.key))) (not (empty $tsc.accessKey.name)))) -}}
 {{- $envvars = (concat (default (list) $envvars) (list (mustMergeOverwrite (dict "name" "") (dict "name" "REDPANDA_CLOUD_STORAGE_ACCESS_KEY" "valueFrom" (get (fromJson (include "redpanda.SecretRef.AsSource" (dict "a" (list $tsc.accessKey)))) "r"))))) -}}
 {{- end -}}
-{{- if (get (fromJson (include "redpanda.SecretRef.IsValid" (dict "a" (list $tsc.secretKey)))) "r") -}}
+{{- if (and (and (ne (toJson $tsc.secretKey) "null") (not (empty $tsc.secretKey.key))) (not (empty $tsc.secretKey.name))) -}}
 {{- if (and (not $hasSecretKey) (not (get (fromJson (include "redpanda.TieredStorageConfig.HasAzureCanaries" (dict "a" (list (deepCopy $config))))) "r"))) -}}
 {{- $envvars = (concat (default (list) $envvars) (list (mustMergeOverwrite (dict "name" "") (dict "name" "REDPANDA_CLOUD_STORAGE_SECRET_KEY" "valueFrom" (get (fromJson (include "redpanda.SecretRef.AsSource" (dict "a" (list $tsc.secretKey)))) "r"))))) -}}
 {{- else -}}{{- if (and (not $hasSharedKey) (get (fromJson (include "redpanda.TieredStorageConfig.HasAzureCanaries" (dict "a" (list (deepCopy $config))))) "r")) -}}
@@ -1250,12 +1253,12 @@
 {{- $c := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_1737___containerExists := (get (fromJson (include "_shims.dicttest" (dict "a" (list $c "cloud_storage_azure_container" (coalesce nil))))) "r") -}}
-{{- $_ := (index $_1737___containerExists 0) -}}
-{{- $containerExists := (index $_1737___containerExists 1) -}}
-{{- $_1738___accountExists := (get (fromJson (include "_shims.dicttest" (dict "a" (list $c "cloud_storage_azure_storage_account" (coalesce nil))))) "r") -}}
-{{- $_ := (index $_1738___accountExists 0) -}}
-{{- $accountExists := (index $_1738___accountExists 1) -}}
+{{- $_1756___containerExists := (get (fromJson (include "_shims.dicttest" (dict "a" (list $c "cloud_storage_azure_container" (coalesce nil))))) "r") -}}
+{{- $_ := (index $_1756___containerExists 0) -}}
+{{- $containerExists := (index $_1756___containerExists 1) -}}
+{{- $_1757___accountExists := (get (fromJson (include "_shims.dicttest" (dict "a" (list $c "cloud_storage_azure_storage_account" (coalesce nil))))) "r") -}}
+{{- $_ := (index $_1757___accountExists 0) -}}
+{{- $accountExists := (index $_1757___accountExists 1) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (and $containerExists $accountExists)) | toJson -}}
 {{- break -}}
@@ -1266,9 +1269,9 @@
 {{- $c := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_1743_value_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list $c `cloud_storage_cache_size` (coalesce nil))))) "r") -}}
-{{- $value := (index $_1743_value_ok 0) -}}
-{{- $ok := (index $_1743_value_ok 1) -}}
+{{- $_1762_value_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list $c `cloud_storage_cache_size` (coalesce nil))))) "r") -}}
+{{- $value := (index $_1762_value_ok 0) -}}
+{{- $ok := (index $_1762_value_ok 1) -}}
 {{- if (not $ok) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (coalesce nil)) | toJson -}}
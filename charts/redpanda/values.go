@@ -239,11 +239,12 @@ type TLS struct {
 }
 
 type ExternalConfig struct {
-	Addresses      []string           `json:"addresses"`
-	Annotations    map[string]string  `json:"annotations"`
-	Domain         *string            `json:"domain"`
-	Enabled        bool               `json:"enabled" jsonschema:"required"`
-	Type           corev1.ServiceType `json:"type" jsonschema:"pattern=^(LoadBalancer|NodePort)$"`
+	Addresses   []string          `json:"addresses"`
+	Annotations map[string]string `json:"annotations"`
+	Domain      *string           `json:"domain"`
+	Enabled     bool              `json:"enabled" jsonschema:"required"`
+	// +kubebuilder:validation:Enum=LoadBalancer;NodePort
+	Type           corev1.ServiceType `json:"type"`
 	PrefixTemplate string             `json:"prefixTemplate"`
 	SourceRanges   []string           `json:"sourceRanges"`
 	Service        Enableable         `json:"service"`
@@ -255,7 +256,8 @@ type Enableable struct {
 }
 
 type Logging struct {
-	LogLevel    string `json:"logLevel" jsonschema:"required,pattern=^(error|warn|info|debug|trace)$"`
+	// +kubebuilder:validation:Enum=error;warn;info;debug;trace
+	LogLevel    string `json:"logLevel" jsonschema:"required"`
 	UseageStats struct {
 		Enabled   bool    `json:"enabled" jsonschema:"required"`
 		ClusterID *string `json:"clusterId"`
@@ -754,10 +756,13 @@ type Statefulset struct {
 		MaxUnavailable int32 `json:"maxUnavailable" jsonschema:"required"`
 	} `json:"budget" jsonschema:"required"`
 	PodAntiAffinity struct {
-		TopologyKey string         `json:"topologyKey" jsonschema:"required"`
-		Type        string         `json:"type" jsonschema:"required,pattern=^(hard|soft|custom)$"`
-		Weight      int32          `json:"weight" jsonschema:"required"`
-		Custom      map[string]any `json:"custom"`
+		TopologyKey string `json:"topologyKey" jsonschema:"required"`
+		// +kubebuilder:validation:Enum=hard;soft;custom
+		Type string `json:"type" jsonschema:"required"`
+		// +kubebuilder:validation:Minimum=1
+		// +kubebuilder:validation:Maximum=100
+		Weight int32          `json:"weight" jsonschema:"required"`
+		Custom map[string]any `json:"custom"`
 	} `json:"podAntiAffinity" jsonschema:"required"`
 	SideCars       Sidecars `json:"sideCars" jsonschema:"required"`
 	InitContainers struct {
@@ -815,6 +820,11 @@ func (t *Tuning) Translate() map[string]any {
 	}
 
 	for k, v := range m {
+		// Values aren't subject to omitempty in helm, so explicitly skip
+		// empty values to match go.
+		if helmette.Empty(v) {
+			continue
+		}
 		result[k] = v
 	}
 
@@ -834,7 +844,9 @@ type Sidecars struct {
 		DecommissionRequeueTimeout string `json:"decommissionRequeueTimeout"`
 	} `json:"brokerDecommissioner"`
 	ConfigWatcher struct {
-		Enabled bool `json:"enabled"`
+		Enabled                      bool `json:"enabled"`
+		WatchCertificates            bool `json:"watchCertificates"`
+		RestartOnCertificateRotation bool `json:"restartOnCertificateRotation"`
 	} `json:"configWatcher"`
 	Controllers struct {
 		DeprecatedImage    *Image   `json:"image"`
@@ -867,7 +879,9 @@ type Listeners struct {
 }
 
 func (l *Listeners) CreateSeedServers(replicas int32, fullname, internalDomain string) []map[string]any {
-	var result []map[string]any
+	// NB: result is non-nil so that 0 replicas render identically in go and
+	// helm.
+	result := []map[string]any{}
 	for i := int32(0); i < replicas; i++ {
 		result = append(result, map[string]any{
 			"host": map[string]any{
@@ -1156,6 +1170,11 @@ type PandaProxyClient struct {
 	ConsumerHeartbeatIntervalMS int `json:"consumer_heartbeat_interval_ms"`
 }
 
+// TLSCert configures the certificate of a listener. Certificates are either
+// provided by the end user (SecretRef) or issued by cert-manager, optionally
+// via a user provided issuer (IssuerRef).
+//
+// +kubebuilder:validation:AtMostOneOf=issuerRef;secretRef
 type TLSCert struct {
 	// Enabled should be interpreted as `true` if not set.
 	Enabled               *bool                        `json:"enabled"`
@@ -1244,8 +1263,8 @@ func (b *BootstrapUser) SecretKeySelector(fullname string) *corev1.SecretKeySele
 }
 
 type SASLUser struct {
-	Name      string         `json:"name"`
-	Password  string         `json:"password"`
+	Name      string         `json:"name" jsonschema:"required"`
+	Password  string         `json:"password" jsonschema:"required"`
 	Mechanism *SASLMechanism `json:"mechanism"`
 }
 
@@ -1829,8 +1848,9 @@ type Tiered struct {
 	CredentialsSecretRef TieredStorageCredentials `json:"credentialsSecretRef"`
 	Config               TieredStorageConfig      `json:"config"`
 	HostPath             string                   `json:"hostPath"`
-	MountType            string                   `json:"mountType" jsonschema:"required,pattern=^(none|hostPath|emptyDir|persistentVolume)$"`
-	PersistentVolume     struct {
+	// +kubebuilder:validation:Enum=none;hostPath;emptyDir;persistentVolume
+	MountType        string `json:"mountType" jsonschema:"required"`
+	PersistentVolume struct {
 		Annotations   map[string]string `json:"annotations" jsonschema:"required"`
 		Enabled       bool              `json:"enabled"`
 		Labels        map[string]string `json:"labels" jsonschema:"required"`
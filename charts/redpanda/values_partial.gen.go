@@ -99,7 +99,7 @@ type PartialExternalConfig struct {
 	Annotations    map[string]string   "json:\"annotations,omitempty\""
 	Domain         *string             "json:\"domain,omitempty\""
 	Enabled        *bool               "json:\"enabled,omitempty\" jsonschema:\"required\""
-	Type           *corev1.ServiceType "json:\"type,omitempty\" jsonschema:\"pattern=^(LoadBalancer|NodePort)$\""
+	Type           *corev1.ServiceType "json:\"type,omitempty\""
 	PrefixTemplate *string             "json:\"prefixTemplate,omitempty\""
 	SourceRanges   []string            "json:\"sourceRanges,omitempty\""
 	Service        *PartialEnableable  "json:\"service,omitempty\""
@@ -107,7 +107,7 @@ type PartialExternalConfig struct {
 }
 
 type PartialLogging struct {
-	LogLevel    *string "json:\"logLevel,omitempty\" jsonschema:\"required,pattern=^(error|warn|info|debug|trace)$\""
+	LogLevel    *string "json:\"logLevel,omitempty\" jsonschema:\"required\""
 	UseageStats *struct {
 		Enabled   *bool   "json:\"enabled,omitempty\" jsonschema:\"required\""
 		ClusterID *string "json:\"clusterId,omitempty\""
@@ -181,7 +181,7 @@ type PartialStatefulset struct {
 	} "json:\"budget,omitempty\" jsonschema:\"required\""
 	PodAntiAffinity *struct {
 		TopologyKey *string        "json:\"topologyKey,omitempty\" jsonschema:\"required\""
-		Type        *string        "json:\"type,omitempty\" jsonschema:\"required,pattern=^(hard|soft|custom)$\""
+		Type        *string        "json:\"type,omitempty\" jsonschema:\"required\""
 		Weight      *int32         "json:\"weight,omitempty\" jsonschema:\"required\""
 		Custom      map[string]any "json:\"custom,omitempty\""
 	} "json:\"podAntiAffinity,omitempty\" jsonschema:\"required\""
@@ -270,7 +270,7 @@ type PartialTiered struct {
 	CredentialsSecretRef *PartialTieredStorageCredentials "json:\"credentialsSecretRef,omitempty\""
 	Config               PartialTieredStorageConfig       "json:\"config,omitempty\""
 	HostPath             *string                          "json:\"hostPath,omitempty\""
-	MountType            *string                          "json:\"mountType,omitempty\" jsonschema:\"required,pattern=^(none|hostPath|emptyDir|persistentVolume)$\""
+	MountType            *string                          "json:\"mountType,omitempty\" jsonschema:\"required\""
 	PersistentVolume     *struct {
 		Annotations   map[string]string "json:\"annotations,omitempty\" jsonschema:\"required\""
 		Enabled       *bool             "json:\"enabled,omitempty\""
@@ -296,7 +296,9 @@ type PartialSidecars struct {
 		DecommissionRequeueTimeout *string "json:\"decommissionRequeueTimeout,omitempty\""
 	} "json:\"brokerDecommissioner,omitempty\""
 	ConfigWatcher *struct {
-		Enabled *bool "json:\"enabled,omitempty\""
+		Enabled                      *bool "json:\"enabled,omitempty\""
+		WatchCertificates            *bool "json:\"watchCertificates,omitempty\""
+		RestartOnCertificateRotation *bool "json:\"restartOnCertificateRotation,omitempty\""
 	} "json:\"configWatcher,omitempty\""
 	Controllers *struct {
 		DeprecatedImage    *PartialImage "json:\"image,omitempty\""
@@ -398,8 +400,8 @@ type PartialBootstrapUser struct {
 }
 
 type PartialSASLUser struct {
-	Name      *string        "json:\"name,omitempty\""
-	Password  *string        "json:\"password,omitempty\""
+	Name      *string        "json:\"name,omitempty\" jsonschema:\"required\""
+	Password  *string        "json:\"password,omitempty\" jsonschema:\"required\""
 	Mechanism *SASLMechanism "json:\"mechanism,omitempty\""
 }
 
@@ -0,0 +1,489 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/invopop/jsonschema"
+	"golang.org/x/tools/go/packages"
+)
+
+// markerPrefix is the prefix of the kubebuilder validation markers that are
+// honored in the doc comments of types and fields of chart values, e.g.
+//
+//	// +kubebuilder:validation:Enum=error;warn;info;debug;trace
+//	LogLevel string `json:"logLevel"`
+//
+// Field markers apply to the schema of the field. Type markers apply to the
+// schema of the type wherever it's used.
+const markerPrefix = "+kubebuilder:validation:"
+
+// modPrefix restricts the packages whose source is searched for markers.
+const modPrefix = "github.com/redpanda-data/redpanda-operator/"
+
+// markers maps the qualified name of a type, e.g. pkg.TLSCert, or field,
+// e.g. pkg.Statefulset.PodAntiAffinity.Type, to the markers within its doc
+// comment.
+type markers map[string][]marker
+
+type marker struct {
+	Name  string
+	Value string
+}
+
+// loadMarkers parses the source of all packages, within this repository,
+// that declare types reachable from t and returns the markers therein.
+func loadMarkers(t reflect.Type) (markers, error) {
+	pkgPaths := map[string]bool{}
+	collectPkgPaths(t, pkgPaths, map[reflect.Type]bool{})
+
+	var patterns []string
+	for path := range pkgPaths {
+		patterns = append(patterns, path)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+	}, patterns...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return markersOf(pkgs)
+}
+
+// markersOf returns the markers within the doc comments of the types, and
+// their fields, declared by pkgs.
+func markersOf(pkgs []*packages.Package) (markers, error) {
+	m := markers{}
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					spec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					doc := spec.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+
+					key := pkg.PkgPath + "." + spec.Name.Name
+					if err := m.collect(key, doc, spec.Type); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// collect records the markers of doc under key and then those of the fields
+// of typ, if it's a struct. Fields of anonymous structs are keyed by their
+// path from the enclosing named type.
+func (m markers) collect(key string, doc *ast.CommentGroup, typ ast.Expr) error {
+	if doc != nil {
+		for _, comment := range doc.List {
+			text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+			if !strings.HasPrefix(text, markerPrefix) {
+				continue
+			}
+
+			name, value, _ := strings.Cut(strings.TrimPrefix(text, markerPrefix), "=")
+			if _, ok := markerAppliers[name]; !ok {
+				return errors.Newf("%s: unsupported marker %q", key, text)
+			}
+
+			m[key] = append(m[key], marker{Name: name, Value: value})
+		}
+	}
+
+	for {
+		switch t := typ.(type) {
+		case *ast.StarExpr:
+			typ = t.X
+			continue
+		case *ast.ArrayType:
+			typ = t.Elt
+			continue
+		case *ast.MapType:
+			typ = t.Value
+			continue
+		case *ast.StructType:
+			for _, field := range t.Fields.List {
+				for _, name := range field.Names {
+					if err := m.collect(key+"."+name.Name, field.Doc, field.Type); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// collectPkgPaths adds the packages, within this repository, of all named
+// types reachable from t to paths.
+func collectPkgPaths(t reflect.Type, paths map[string]bool, seen map[reflect.Type]bool) {
+	if seen[t] {
+		return
+	}
+	seen[t] = true
+
+	if strings.HasPrefix(t.PkgPath(), modPrefix) {
+		paths[t.PkgPath()] = true
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		collectPkgPaths(t.Elem(), paths, seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			collectPkgPaths(t.Field(i).Type, paths, seen)
+		}
+	}
+}
+
+// apply walks schema, as generated by r from t, in tandem with t and applies
+// the markers of each type and field encountered.
+func (m markers) apply(r *jsonschema.Reflector, schema *jsonschema.Schema, t reflect.Type) error {
+	return m.walk(r, schema, t, "", map[*jsonschema.Schema]bool{})
+}
+
+func (m markers) walk(r *jsonschema.Reflector, schema *jsonschema.Schema, t reflect.Type, key string, seen map[*jsonschema.Schema]bool) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// The schemas of mapped types and those of types that provide their own
+	// are not reflected from t, so there's nothing to walk.
+	if r.Mapper != nil && r.Mapper(t) != nil {
+		return nil
+	}
+	if _, ok := reflect.New(t).Interface().(interface{ JSONSchema() *jsonschema.Schema }); ok {
+		return nil
+	}
+
+	for _, schema := range nonNull(schema) {
+		if seen[schema] {
+			continue
+		}
+		seen[schema] = true
+
+		if t.Name() != "" {
+			key = qualifiedName(t)
+
+			if err := m.applyTo(key, schema); err != nil {
+				return err
+			}
+		}
+
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if schema.Items != nil {
+				if err := m.walk(r, schema.Items, t.Elem(), key, seen); err != nil {
+					return err
+				}
+			}
+
+		case reflect.Map:
+			// Some types move their additional properties into pattern
+			// properties, see redpanda.TLSCertMap.
+			elems := []*jsonschema.Schema{schema.AdditionalProperties}
+			for _, elem := range schema.PatternProperties {
+				elems = append(elems, elem)
+			}
+
+			for _, elem := range elems {
+				if elem == nil {
+					continue
+				}
+				if err := m.walk(r, elem, t.Elem(), key, seen); err != nil {
+					return err
+				}
+			}
+
+		case reflect.Struct:
+			if err := m.walkFields(r, schema, t, key, seen); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m markers) walkFields(r *jsonschema.Reflector, schema *jsonschema.Schema, t reflect.Type, key string, seen map[*jsonschema.Schema]bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		// As per encoding/json, the fields of embedded structs are inlined.
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				embeddedKey := key
+				if embedded.Name() != "" {
+					embeddedKey = qualifiedName(embedded)
+					if err := m.applyTo(embeddedKey, schema); err != nil {
+						return err
+					}
+				}
+				if err := m.walkFields(r, schema, embedded, embeddedKey, seen); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if schema.Properties == nil {
+			continue
+		}
+
+		prop, ok := schema.Properties.Get(name)
+		if !ok {
+			continue
+		}
+
+		fieldKey := key + "." + field.Name
+
+		for _, prop := range nonNull(prop) {
+			if err := m.applyTo(fieldKey, prop); err != nil {
+				return err
+			}
+		}
+
+		if err := m.walk(r, prop, field.Type, fieldKey, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyTo applies the markers of key to schema.
+func (m markers) applyTo(key string, schema *jsonschema.Schema) error {
+	for _, marker := range m[key] {
+		if err := markerAppliers[marker.Name](schema, marker.Value); err != nil {
+			return errors.Wrapf(err, "%s: %s%s=%s", key, markerPrefix, marker.Name, marker.Value)
+		}
+	}
+
+	// kubebuilder's ExclusiveMinimum and ExclusiveMaximum are booleans that
+	// modify Minimum and Maximum whereas JSON schema's are numbers.
+	for _, marker := range m[key] {
+		if marker.Value == "false" {
+			continue
+		}
+
+		switch {
+		case marker.Name == "ExclusiveMinimum" && schema.Minimum != "":
+			schema.ExclusiveMinimum, schema.Minimum = schema.Minimum, ""
+		case marker.Name == "ExclusiveMaximum" && schema.Maximum != "":
+			schema.ExclusiveMaximum, schema.Maximum = schema.Maximum, ""
+		case marker.Name == "ExclusiveMinimum" || marker.Name == "ExclusiveMaximum":
+			return errors.Newf("%s: %s%s requires %s", key, markerPrefix, marker.Name, strings.TrimPrefix(marker.Name, "Exclusive"))
+		}
+	}
+
+	return nil
+}
+
+// markerAppliers are the supported markers, keyed by their name sans
+// [markerPrefix].
+var markerAppliers = map[string]func(schema *jsonschema.Schema, value string) error{
+	"Enum": func(schema *jsonschema.Schema, value string) error {
+		for _, value := range strings.Split(value, ";") {
+			parsed, err := parseValue(schema.Type, value)
+			if err != nil {
+				return err
+			}
+			schema.Enum = append(schema.Enum, parsed)
+		}
+		return nil
+	},
+	"Minimum": func(schema *jsonschema.Schema, value string) error {
+		return setNumber(&schema.Minimum, value)
+	},
+	"Maximum": func(schema *jsonschema.Schema, value string) error {
+		return setNumber(&schema.Maximum, value)
+	},
+	// ExclusiveMinimum and ExclusiveMaximum are handled by [markers.applyTo]
+	// as they depend on Minimum and Maximum.
+	"ExclusiveMinimum": func(*jsonschema.Schema, string) error { return nil },
+	"ExclusiveMaximum": func(*jsonschema.Schema, string) error { return nil },
+	"MultipleOf": func(schema *jsonschema.Schema, value string) error {
+		return setNumber(&schema.MultipleOf, value)
+	},
+	"MinLength": func(schema *jsonschema.Schema, value string) error {
+		return setUint(&schema.MinLength, value)
+	},
+	"MaxLength": func(schema *jsonschema.Schema, value string) error {
+		return setUint(&schema.MaxLength, value)
+	},
+	"MinItems": func(schema *jsonschema.Schema, value string) error {
+		return setUint(&schema.MinItems, value)
+	},
+	"MaxItems": func(schema *jsonschema.Schema, value string) error {
+		return setUint(&schema.MaxItems, value)
+	},
+	"MinProperties": func(schema *jsonschema.Schema, value string) error {
+		return setUint(&schema.MinProperties, value)
+	},
+	"MaxProperties": func(schema *jsonschema.Schema, value string) error {
+		return setUint(&schema.MaxProperties, value)
+	},
+	"UniqueItems": func(schema *jsonschema.Schema, value string) error {
+		schema.UniqueItems = value != "false"
+		return nil
+	},
+	"Pattern": func(schema *jsonschema.Schema, value string) error {
+		schema.Pattern = unquote(value)
+		return nil
+	},
+	"Format": func(schema *jsonschema.Schema, value string) error {
+		schema.Format = value
+		return nil
+	},
+	// ExactlyOneOf, a type marker, requires exactly one of the given fields
+	// to be set to a non-null value.
+	"ExactlyOneOf": func(schema *jsonschema.Schema, value string) error {
+		var oneOf []*jsonschema.Schema
+		for _, field := range strings.Split(value, ";") {
+			oneOf = append(oneOf, isSet(field))
+		}
+		schema.AllOf = append(schema.AllOf, &jsonschema.Schema{OneOf: oneOf})
+		return nil
+	},
+	// AtMostOneOf, a type marker, permits at most one of the given fields to
+	// be set to a non-null value.
+	"AtMostOneOf": func(schema *jsonschema.Schema, value string) error {
+		var oneOf, anyOf []*jsonschema.Schema
+		for _, field := range strings.Split(value, ";") {
+			oneOf = append(oneOf, isSet(field))
+			anyOf = append(anyOf, isSet(field))
+		}
+		// Exactly one field is set or none of them are.
+		oneOf = append(oneOf, &jsonschema.Schema{Not: &jsonschema.Schema{AnyOf: anyOf}})
+		schema.AllOf = append(schema.AllOf, &jsonschema.Schema{OneOf: oneOf})
+		return nil
+	},
+}
+
+// qualifiedName returns the key of the named type t within [markers].
+func qualifiedName(t reflect.Type) string {
+	// Strip the type arguments of instantiated generic types.
+	name, _, _ := strings.Cut(t.Name(), "[")
+	return t.PkgPath() + "." + name
+}
+
+// isSet returns a schema that matches objects with field set to a non-null
+// value. Helm drops keys that are explicitly set to null, so null is
+// equivalent to unset.
+func isSet(field string) *jsonschema.Schema {
+	properties := jsonschema.NewProperties()
+	properties.Set(field, &jsonschema.Schema{Not: &jsonschema.Schema{Type: "null"}})
+
+	return &jsonschema.Schema{
+		Required:   []string{field},
+		Properties: properties,
+	}
+}
+
+// nonNull returns the non-null alternatives of schema if it's been made
+// nullable, i.e. {"oneOf": [schema, {"type": "null"}]}. Otherwise, schema is
+// returned as is.
+func nonNull(schema *jsonschema.Schema) []*jsonschema.Schema {
+	var alternatives []*jsonschema.Schema
+	for _, alt := range schema.OneOf {
+		if alt.Type != "null" {
+			alternatives = append(alternatives, alt)
+		}
+	}
+
+	if schema.Type != "" || len(alternatives) == len(schema.OneOf) {
+		return []*jsonschema.Schema{schema}
+	}
+
+	return alternatives
+}
+
+func parseValue(typ, value string) (any, error) {
+	switch typ {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return json.Number(value), nil
+	case "boolean":
+		parsed, err := strconv.ParseBool(value)
+		return parsed, errors.WithStack(err)
+	default:
+		return unquote(value), nil
+	}
+}
+
+func unquote(value string) string {
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+	return value
+}
+
+func setNumber(dst *json.Number, value string) error {
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return errors.WithStack(err)
+	}
+	*dst = json.Number(value)
+	return nil
+}
+
+func setUint(dst **uint64, value string) error {
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*dst = &parsed
+	return nil
+}
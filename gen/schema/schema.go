@@ -52,6 +52,21 @@ func Must[T any](value T, err error) T {
 }
 
 func run(cmd *cobra.Command, args []string) {
+	val, exists := schemas[append(args, "")[0]]
+	if !exists {
+		acceptable := strings.Join(slices.Collect(maps.Keys(schemas)), "|")
+		fmt.Printf("schema %q does not exist\nusage: %s <%s>\n", args[0], cmd.CalledAs(), acceptable)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s\n", Must(Generate(val)))
+}
+
+// Generate returns the JSON schema of values, a pointer to a chart's Values
+// struct. Any kubebuilder validation markers, e.g.
+// +kubebuilder:validation:Enum, in the doc comments of the types and fields
+// of values are honored. See [markerAppliers] for the supported markers.
+func Generate(values any) ([]byte, error) {
 	r := &jsonschema.Reflector{
 		//  These values are set to minimize the diff between the
 		// handwritten jsonschema and the generated jsonschema.
@@ -142,14 +157,17 @@ func run(cmd *cobra.Command, args []string) {
 		},
 	}
 
-	val, exists := schemas[append(args, "")[0]]
-	if !exists {
-		acceptable := strings.Join(slices.Collect(maps.Keys(schemas)), "|")
-		fmt.Printf("schema %q does not exist\nusage: %s <%s>\n", args[0], cmd.CalledAs(), acceptable)
-		os.Exit(1)
+	schema := r.Reflect(values)
+
+	m, err := loadMarkers(reflect.TypeOf(values))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.apply(r, schema, reflect.TypeOf(values)); err != nil {
+		return nil, err
 	}
 
-	schema := r.Reflect(val)
 	makeArrayNullableRecursive(schema)
 
 	// Leave a note to dissuade any would be manual editors.
@@ -158,10 +176,12 @@ func run(cmd *cobra.Command, args []string) {
 	// Because of jsonschema's usage of an ordered map, the key ordering is a
 	// bit strange. Round trip through an untyped map[string]any to have go
 	// sort the keys alphabetically. (This helps reduce diff churn).
-	untyped := Must(valuesutil.UnmarshalInto[any](schema))
-	data := Must(json.MarshalIndent(untyped, "", "  "))
+	untyped, err := valuesutil.UnmarshalInto[any](schema)
+	if err != nil {
+		return nil, err
+	}
 
-	fmt.Printf("%s\n", data)
+	return json.MarshalIndent(untyped, "", "  ")
 }
 
 func makeArrayNullableRecursive(schema *jsonschema.Schema) {
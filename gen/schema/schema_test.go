@@ -0,0 +1,137 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+	"helm.sh/helm/v3/pkg/chartutil"
+
+	"github.com/redpanda-data/redpanda-operator/pkg/valuesutil"
+)
+
+// TestValuesConformToSchema asserts that the default values of each chart,
+// its values.yaml, are accepted by its generated schema. Helm validates
+// values after merging them with the defaults, so a violation would render
+// the chart uninstallable.
+func TestValuesConformToSchema(t *testing.T) {
+	dirs := map[string]string{
+		"console":  "../../charts/console",
+		"redpanda": "../../charts/redpanda",
+		"operator": "../../operator/chart",
+	}
+
+	for name, values := range schemas {
+		t.Run(name, func(t *testing.T) {
+			schema, err := Generate(values)
+			require.NoError(t, err)
+
+			valuesYAML, err := os.ReadFile(filepath.Join(dirs[name], "values.yaml"))
+			require.NoError(t, err)
+
+			defaults, err := chartutil.ReadValues(valuesYAML)
+			require.NoError(t, err)
+
+			require.NoError(t, chartutil.ValidateAgainstSingleSchema(defaults, schema))
+		})
+	}
+}
+
+type markersExample struct {
+	// +kubebuilder:validation:Enum=a;b
+	Enum string `json:"enum"`
+	// +kubebuilder:validation:Enum=1;2
+	IntEnum int `json:"intEnum"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +kubebuilder:validation:ExclusiveMaximum=true
+	Bounded int `json:"bounded"`
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:UniqueItems=true
+	Items []markersLevel `json:"items"`
+	// +kubebuilder:validation:Pattern=`^[a-z]+$`
+	Pattern *string `json:"pattern"`
+	Nested  struct {
+		// +kubebuilder:validation:MaxLength=3
+		Short string `json:"short"`
+	} `json:"nested"`
+	Embedded
+}
+
+// +kubebuilder:validation:Enum=debug;info
+type markersLevel string
+
+// +kubebuilder:validation:ExactlyOneOf=a;b
+type Embedded struct {
+	A *string `json:"a"`
+	B *string `json:"b"`
+}
+
+func TestMarkers(t *testing.T) {
+	r := &jsonschema.Reflector{ExpandedStruct: true, DoNotReference: true}
+
+	schema := r.Reflect(&markersExample{})
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+		Tests: true,
+	}, ".")
+	require.NoError(t, err)
+
+	// Loading with tests allows loading the example types seen above, which
+	// are only present in the test variant of this package.
+	i := slices.IndexFunc(pkgs, func(pkg *packages.Package) bool {
+		return strings.HasSuffix(pkg.ID, ".test]")
+	})
+	require.NotEqual(t, -1, i)
+
+	m, err := markersOf(pkgs[i : i+1])
+	require.NoError(t, err)
+	require.NoError(t, m.apply(r, schema, reflect.TypeFor[markersExample]()))
+
+	prop := func(path ...string) map[string]any {
+		s := schema
+		for _, key := range path {
+			s, _ = s.Properties.Get(key)
+		}
+		out, err := valuesutil.UnmarshalInto[map[string]any](s)
+		require.NoError(t, err)
+		return out
+	}
+
+	assert.Equal(t, []any{"a", "b"}, prop("enum")["enum"])
+	assert.Equal(t, []any{1.0, 2.0}, prop("intEnum")["enum"])
+	assert.Equal(t, map[string]any{"type": "integer", "minimum": 1.0, "exclusiveMaximum": 10.0}, prop("bounded"))
+	assert.Equal(t, map[string]any{
+		"type":        "array",
+		"minItems":    1.0,
+		"uniqueItems": true,
+		"items":       map[string]any{"type": "string", "enum": []any{"debug", "info"}},
+	}, prop("items"))
+	assert.Equal(t, "^[a-z]+$", prop("pattern")["pattern"])
+	assert.Equal(t, 3.0, prop("nested", "short")["maxLength"])
+
+	oneOf, err := json.Marshal(schema.AllOf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"oneOf": [
+		{"required": ["a"], "properties": {"a": {"not": {"type": "null"}}}},
+		{"required": ["b"], "properties": {"b": {"not": {"type": "null"}}}}
+	]}]`, string(oneOf))
+}
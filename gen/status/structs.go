@@ -66,6 +66,9 @@ func (s *status) GoName() string {
 func (s *status) DefaultStatusComment() string {
 	defaultStatuses := []string{}
 	for _, condition := range s.Conditions {
+		if condition.Optional {
+			continue
+		}
 		defaultStatuses = append(defaultStatuses, condition.defaultStatus())
 	}
 	return fmt.Sprintf("// +kubebuilder:default={conditions: {%s}}", strings.Join(defaultStatuses, ", "))
@@ -83,6 +86,18 @@ func (s *status) ManualConditions() []*conditionType {
 	return conditions
 }
 
+func (s *status) RequiredConditions() []*conditionType {
+	conditions := []*conditionType{}
+
+	for _, condition := range s.ManualConditions() {
+		if !condition.Optional {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	return conditions
+}
+
 func (s *status) FinalConditions() []*conditionType {
 	conditions := []*conditionType{}
 
@@ -212,6 +227,7 @@ type conditionType struct {
 	Reasons        []*reasonType
 	Final          bool
 	Rollup         []string
+	Optional       bool
 
 	kind               string
 	defaultReason      *reasonType
@@ -298,6 +314,9 @@ func (c *conditionType) normalize(status *status) {
 	if len(c.Rollup) != 0 && len(c.Reasons) != 2 {
 		panic("Rollup conditions must be boolean in nature and have exactly two reasons")
 	}
+	if c.Optional && c.IsCalculated() {
+		panic("Final and rollup conditions are always calculated and can't be optional")
+	}
 
 	if !c.Final && len(c.Rollup) == 0 {
 		for _, err := range status.Errors {
@@ -187,7 +187,7 @@ func (s *{{ $status.GoName }}) get{{ $condition.Name }}() metav1.Condition {
     {{- if $status.HasTransientError }}
     transientErrorConditionsSet := {{ range $i, $condition := $status.TransientErrorConditions }}{{ if ne $i 0 }}|| {{ end }}s.is{{ $condition.Name }}TransientError{{ end }}
     {{- end }}
-    allConditionsSet := {{ range $i, $condition := $status.ManualConditions }}{{ if ne $i 0 }}&& {{ end }}s.is{{ $condition.Name }}Set{{ end }}
+    allConditionsSet := {{ range $i, $condition := $status.RequiredConditions }}{{ if ne $i 0 }}&& {{ end }}s.is{{ $condition.Name }}Set{{ end }}
 
     if (allConditionsSet || s.hasTerminalError) {{ if $status.HasTransientError }}&& !transientErrorConditionsSet {{end}}{
         return metav1.Condition{
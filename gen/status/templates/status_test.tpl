@@ -100,8 +100,8 @@ func Test{{ $status.Kind }}(t *testing.T) {
 
 			status := New{{ $status.Kind }}()
 
-			// attempt to set all conditions one by one until they are all set
-			{{ range $condition := $status.ManualConditions -}}			
+			// attempt to set all required conditions one by one until they are all set
+			{{ range $condition := $status.RequiredConditions -}}			
 			assertConditionStatusReason(t, conditionReason.condition, metav1.ConditionFalse, conditionReason.falseReason, status.getConditions(0))
 
 			status.Set{{ $condition.Name }}({{ (index $condition.Reasons 0).GoName }}, "reason")
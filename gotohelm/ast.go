@@ -12,8 +12,11 @@ package gotohelm
 
 import (
 	"fmt"
+	"go/token"
 	"io"
 	"strconv"
+
+	"github.com/redpanda-data/redpanda-operator/pkg/helm"
 )
 
 type Node interface {
@@ -152,6 +155,17 @@ func (c *Cast) Write(w io.Writer) {
 type Call struct {
 	FuncName  Node
 	Arguments []Node
+	// Value indicates that FuncName evaluates to a function value rather than
+	// the name of a template. Function values are either the name of a
+	// template or, for function literals that capture variables, a dict of
+	// the template's name ("fn") and the captured variables ("c").
+	Value bool
+	// Captures, if set, are passed as the captured variables of a function
+	// literal that's called directly.
+	Captures Node
+	// Raw indicates that the call evaluates to the JSON encoded result of the
+	// function, as returned by include, rather than its decoded result.
+	Raw bool
 }
 
 func litCall(funcName string, args ...Node) *Call {
@@ -171,8 +185,37 @@ func (c *Call) Write(w io.Writer) {
 		},
 	}
 
+	name := c.FuncName
+	if c.Captures != nil {
+		args.KeysValues = append(args.KeysValues, &KeyValue{Key: Quoted("c"), Value: c.Captures})
+	}
+	if c.Value {
+		// Normalize function values into a dict by way of dig's default, so
+		// either representation may be called.
+		// (dig "fn" $f (ternary $f (dict) (kindIs "map" $f)))
+		closure := &BuiltInCall{Func: Literal("ternary"), Arguments: []Node{
+			c.FuncName,
+			&DictLiteral{},
+			&BuiltInCall{Func: Literal("kindIs"), Arguments: []Node{Quoted("map"), c.FuncName}},
+		}}
+		name = &BuiltInCall{Func: Literal("dig"), Arguments: []Node{Quoted("fn"), c.FuncName, closure}}
+		args.KeysValues = append(args.KeysValues, &KeyValue{
+			Key:   Quoted("c"),
+			Value: &BuiltInCall{Func: Literal("dig"), Arguments: []Node{Quoted("c"), &BuiltInCall{Func: Literal("list")}, closure}},
+		})
+	}
+
+	if c.Raw {
+		fmt.Fprintf(w, `(include `)
+		name.Write(w)
+		fmt.Fprintf(w, ` `)
+		args.Write(w)
+		fmt.Fprintf(w, `)`)
+		return
+	}
+
 	fmt.Fprintf(w, `(get (fromJson (include `)
-	c.FuncName.Write(w)
+	name.Write(w)
 	fmt.Fprintf(w, ` `)
 	args.Write(w)
 	fmt.Fprintf(w, `)) %q)`, "r")
@@ -229,6 +272,20 @@ type File struct {
 }
 
 func (f *File) Write(w io.Writer) {
+	f.WriteSourceMapped(w, false)
+}
+
+// WriteSourceMapped is equivalent to [File.Write] but additionally returns a
+// source map of the written templates. If annotate is true, every statement
+// is prefixed with a comment of the position of the go source it was
+// transpiled from.
+func (f *File) WriteSourceMapped(w io.Writer, annotate bool) helm.TemplateSourceMap {
+	sw := newSourceWriter(w, annotate)
+	f.write(sw)
+	return helm.TemplateSourceMap{Source: f.Source, Mappings: sw.mappings}
+}
+
+func (f *File) write(w io.Writer) {
 	if f.Source != "" {
 		fmt.Fprintf(w, "{{- /* Generated from %q */ -}}\n\n", f.Source)
 	}
@@ -241,19 +298,39 @@ func (f *File) Write(w io.Writer) {
 }
 
 type Func struct {
-	Namespace  string
-	Name       string
-	Params     []Node
+	Namespace string
+	Name      string
+	Params    []Node
+	// Captures are the variables captured by a function literal, which are
+	// passed alongside its arguments.
+	Captures   []Node
 	Statements []Node
+	// Pos is the position of the go function this function was transpiled
+	// from.
+	Pos token.Position
+	// BasicResult indicates that the function returns a single bool,
+	// number, or string.
+	BasicResult bool
 }
 
 func (f *Func) Write(w io.Writer) {
+	if sw, ok := w.(*sourceWriter); ok && f.Pos.IsValid() {
+		sw.fn = f.Namespace + "." + f.Name
+		sw.push(f.Pos)
+		defer sw.pop()
+	}
+
 	fmt.Fprintf(w, "{{- define %q -}}\n", f.Namespace+"."+f.Name)
 	for i := range f.Params {
 		fmt.Fprintf(w, "{{- ")
 		f.Params[i].Write(w)
 		fmt.Fprintf(w, " := (index .a %d) -}}\n", i)
 	}
+	for i := range f.Captures {
+		fmt.Fprintf(w, "{{- ")
+		f.Captures[i].Write(w)
+		fmt.Fprintf(w, " := (index .c %d) -}}\n", i)
+	}
 	fmt.Fprintf(w, "{{- range $_ := (list 1) -}}\n")
 	fmt.Fprintf(w, "{{- $_is_returning := false -}}\n")
 	for _, s := range f.Statements {
@@ -269,9 +346,17 @@ type Return struct {
 
 func (r *Return) Write(w io.Writer) {
 	fmt.Fprintf(w, "{{- $_is_returning = true -}}\n")
-	fmt.Fprintf(w, "{{- (dict %q ", "r")
-	r.Expr.Write(w)
-	fmt.Fprintf(w, ") | toJson -}}\n")
+	// The result of a raw call is already encoded and may be passed through
+	// as is.
+	if call, ok := r.Expr.(*Call); ok && call.Raw {
+		fmt.Fprintf(w, "{{- ")
+		call.Write(w)
+		fmt.Fprintf(w, " -}}\n")
+	} else {
+		fmt.Fprintf(w, "{{- (dict %q ", "r")
+		r.Expr.Write(w)
+		fmt.Fprintf(w, ") | toJson -}}\n")
+	}
 	fmt.Fprintf(w, "{{- break -}}\n")
 }
 
@@ -347,7 +432,11 @@ func (i *IfStmt) Write(w io.Writer) {
 
 	if i.Else != nil {
 		fmt.Fprintf(w, "{{- else -}}")
-		if _, ok := i.Else.(*IfStmt); !ok {
+		elseNode := i.Else
+		if p, ok := elseNode.(*Positioned); ok {
+			elseNode = p.Node
+		}
+		if _, ok := elseNode.(*IfStmt); !ok {
 			fmt.Fprintf(w, "\n")
 		}
 		i.Else.Write(w)
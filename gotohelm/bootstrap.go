@@ -94,7 +94,7 @@ var transpileBootstrap = sync.OnceValues(func() (*File, error) {
 
 	// We call the private transpile method which doesn't bundle the _shims.tpl
 	// into the final chart.
-	bootstrapChart, err := transpile(pkgs[0])
+	bootstrapChart, err := transpile(pkgs[0], Options{})
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +108,31 @@ var transpileBootstrap = sync.OnceValues(func() (*File, error) {
 	return shims, nil
 })
 
+// optimizeBootstrap returns an optimized copy of the shims returned by
+// [transpileBootstrap].
+var optimizeBootstrap = sync.OnceValues(func() (*File, error) {
+	shims, err := transpileBootstrap()
+	if err != nil {
+		return nil, err
+	}
+
+	// Optimization swaps out the functions of a file, so operate on a copy to
+	// preserve the unoptimized shims.
+	optimized := *shims
+	optimize([]*File{&optimized})
+
+	return &optimized, nil
+})
+
+// bootstrapFor returns the shims, optimized unless
+// [Options.DisableOptimizations] is set.
+func bootstrapFor(opts Options) (*File, error) {
+	if opts.DisableOptimizations {
+		return transpileBootstrap()
+	}
+	return optimizeBootstrap()
+}
+
 // fsToOverlay translates an [embed.FS] into a map[string][]byte suitable for
 // use in [packages.Config.Overlay].
 func fsToOverlay(fsys *embed.FS, prefix string) map[string][]byte {
@@ -0,0 +1,217 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// transpileFuncLit lifts a function literal into its own function, named after
+// the function it's declared in (example.Outer.func1), and returns its
+// function value.
+//
+// Templates have no notion of closures. Instead, any variables captured by
+// the literal are copied into the function value and passed to the lifted
+// function alongside its arguments when called (See [Call]).
+//
+//	func Outer(x int) []int {
+//		return helmette.Map([]int{1, 2}, func(y int) int { return x + y })
+//	}
+//
+// Will get transpiled to:
+//
+//	{{- define "example.Outer" -}}
+//	...(include "_shims.map" (dict "a" (list (list 1 2) (dict "fn" "example.Outer.func1" "c" (list $x)))))...
+//	{{- end -}}
+//
+//	{{- define "example.Outer.func1" -}}
+//	{{- $y := (index .a 0) -}}
+//	{{- $x := (index .c 0) -}}
+//	...
+//	{{- end -}}
+func (t *Transpiler) transpileFuncLit(lit *ast.FuncLit) Node {
+	captures := t.capturesOf(lit)
+
+	fn := &Func{
+		Namespace: t.namespaceFor(t.Package.Types),
+		Name:      fmt.Sprintf("%s.func%d", t.fnName, len(t.lifted)+1),
+		Pos:       t.Fset.Position(lit.Pos()),
+	}
+
+	// Reserve our spot before transpiling the body so any nested literals
+	// are emitted after this one.
+	t.lifted = append(t.lifted, fn)
+
+	for _, param := range lit.Type.Params.List {
+		for _, name := range param.Names {
+			fn.Params = append(fn.Params, t.transpileExpr(name))
+		}
+	}
+
+	for _, v := range captures {
+		fn.Captures = append(fn.Captures, &Ident{Name: v.Name()})
+	}
+
+	// The zero values of the enclosing function's type parameters, if any,
+	// are captured as well if the literal references them. See [erase].
+	if t.zeros.within(t.TypesInfo, lit.Body) {
+		fn.Captures = append(fn.Captures, typeParamZeros(t.TypesInfo.ObjectOf(t.fn.Name).(*types.Func))...)
+	}
+
+	for _, stmt := range lit.Body.List {
+		fn.Statements = append(fn.Statements, t.transpilePositioned(stmt))
+	}
+
+	name := Quoted(fmt.Sprintf("%s.%s", fn.Namespace, fn.Name))
+
+	// Without any captures, a function literal is indistinguishable from a
+	// reference to a top level function.
+	if len(fn.Captures) == 0 {
+		return name
+	}
+
+	return &DictLiteral{KeysValues: []*KeyValue{
+		{Key: Quoted("fn"), Value: name},
+		{Key: Quoted("c"), Value: &BuiltInCall{Func: Literal("list"), Arguments: fn.Captures}},
+	}}
+}
+
+// transpileFuncValueCall transpiles a call of a function value, such as a
+// variable holding a function literal, rather than a named function.
+func (t *Transpiler) transpileFuncValueCall(n *ast.CallExpr, args []Node) Node {
+	call := &Call{FuncName: t.transpileExpr(n.Fun), Arguments: args, Value: true}
+
+	// Function literals that are called directly, func() { ... }(), don't
+	// need to be normalized.
+	switch fn := call.FuncName.(type) {
+	case Literal:
+		call = &Call{FuncName: fn, Arguments: args}
+	case *DictLiteral:
+		call = &Call{FuncName: fn.KeysValues[0].Value, Arguments: args, Captures: fn.KeysValues[1].Value}
+	}
+
+	signature := t.typeOf(n.Fun).Underlying().(*types.Signature)
+	if signature.Results().Len() == 1 {
+		return t.maybeCast(call, signature.Results().At(0).Type())
+	}
+
+	return call
+}
+
+// capturesOf returns the local variables that are referenced within but
+// declared outside of the given function literal, in order of first
+// reference.
+//
+// Variables are captured by value, so capturesOf panics if any of them are
+// reassigned after the literal has been evaluated, as go would otherwise
+// observe the reassignment. Within a loop, that includes assignments that
+// precede the literal, as they're executed again by the next iteration.
+func (t *Transpiler) capturesOf(lit *ast.FuncLit) []*types.Var {
+	var captures []*types.Var
+	captured := map[*types.Var]bool{}
+
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		v, ok := t.TypesInfo.Uses[ident].(*types.Var)
+		if !ok || v.IsField() || captured[v] {
+			return true
+		}
+
+		// Skip package level variables and variables declared within the
+		// literal itself.
+		if v.Parent() == nil || v.Parent() == v.Pkg().Scope() || (v.Pos() >= lit.Pos() && v.Pos() < lit.End()) {
+			return true
+		}
+
+		captured[v] = true
+		captures = append(captures, v)
+		return true
+	})
+
+	// The loops that the literal is declared within, from the outermost to
+	// the innermost.
+	var loops []ast.Node
+	ast.Inspect(t.fn.Body, func(n ast.Node) bool {
+		if n == nil || n.Pos() > lit.Pos() || n.End() < lit.End() {
+			return false
+		}
+		switch n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			loops = append(loops, n)
+		}
+		return true
+	})
+
+	// Any assignment to a captured variable that ends after the literal
+	// starts will happen after it's been captured. That is, assignments
+	// within the literal itself, within the statement declaring it, or after
+	// it. So will any assignment within a loop enclosing the literal to a
+	// variable declared outside of that loop, wherever it's placed.
+	after := func(stmt ast.Stmt, v *types.Var) bool {
+		if stmt.End() > lit.Pos() {
+			return true
+		}
+		for _, loop := range loops {
+			if v.Pos() < loop.Pos() && stmt.Pos() >= loop.Pos() && stmt.End() <= loop.End() {
+				return true
+			}
+		}
+		return false
+	}
+
+	reassigned := func(stmt ast.Stmt, lhs ast.Expr) {
+		if lhs == nil {
+			return
+		}
+
+		ident, ok := ast.Unparen(lhs).(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		if v, ok := t.TypesInfo.Uses[ident].(*types.Var); ok && captured[v] && after(stmt, v) {
+			panic(&Unsupported{
+				Node: stmt,
+				Fset: t.Fset,
+				Msg: fmt.Sprintf(
+					"%q is reassigned after being captured by the function literal at %s. Captured variables are copied into function literals. Instead, declare a new variable for the literal to capture",
+					v.Name(),
+					t.Fset.Position(lit.Pos()),
+				),
+			})
+		}
+	}
+
+	ast.Inspect(t.fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				reassigned(n, lhs)
+			}
+		case *ast.IncDecStmt:
+			reassigned(n, n.X)
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				reassigned(n, n.Key)
+				reassigned(n, n.Value)
+			}
+		}
+		return true
+	})
+
+	return captures
+}
@@ -10,6 +10,8 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -18,10 +20,18 @@ import (
 	"golang.org/x/tools/go/packages"
 
 	"github.com/redpanda-data/redpanda-operator/gotohelm"
+	"github.com/redpanda-data/redpanda-operator/pkg/helm"
 )
 
 func main() {
 	out := flag.String("write", "-", "The directory to write the transpiled templates to or - to write them to standard out")
+	sourceMap := flag.String("sourcemap", "", "The file to write a source map of the transpiled templates to, if any. See helm.SourceMap")
+	annotate := flag.Bool("annotate", false, "Annotate every transpiled statement with a comment of the go source it was transpiled from")
+	optimize := flag.Bool("optimize", true, "Optimize the transpiled templates")
+	ignoreTemplates := flag.Bool("ignore-templates", false, "Transpile the go bodies of functions with a +gotohelm:template directive rather than emitting their templates")
+	maxLoopIterations := flag.Int("max-loop-iterations", gotohelm.DefaultMaxLoopIterations, "The maximum number of iterations of for loops that aren't simple counted loops, e.g. for cond {}, after which the templates fail")
+	watch := flag.Bool("watch", false, "Watch the package and its local dependencies for changes and incrementally retranspile it. Requires -write")
+	command := flag.String("exec", "", "A shell command, e.g. \"helm lint .\", to run after every rebuild that changed templates. Only used with -watch")
 
 	flag.Parse()
 
@@ -30,6 +40,34 @@ func main() {
 		os.Exit(1)
 	}
 
+	opts := gotohelm.Options{
+		Dependencies:         flag.Args()[1:],
+		DisableOptimizations: !*optimize,
+		IgnoreTemplates:      *ignoreTemplates,
+		MaxLoopIterations:    *maxLoopIterations,
+	}
+
+	if *watch {
+		if *out == "-" {
+			fmt.Printf("-watch requires -write to be set to a directory\n")
+			os.Exit(1)
+		}
+
+		w := &watcher{
+			pattern:   flag.Args()[0],
+			opts:      opts,
+			out:       *out,
+			sourceMap: *sourceMap,
+			annotate:  *annotate,
+			command:   *command,
+		}
+		if err := w.Run(); err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	cwd, _ := os.Getwd()
 
 	pkgs, err := gotohelm.LoadPackages(&packages.Config{
@@ -46,41 +84,73 @@ func main() {
 
 	pkg := pkgs[0]
 
-	chart, err := gotohelm.Transpile(pkg, flag.Args()[1:]...)
+	chart, err := gotohelm.TranspileWithOptions(pkg, opts)
 	if err != nil {
 		fmt.Printf("Failed to transpile %q: %s\n", pkg.Name, err)
 		os.Exit(1)
 	}
 
+	sm := &helm.SourceMap{Templates: map[string]helm.TemplateSourceMap{}}
+
 	if *out == "-" {
-		writeToStdout(chart)
+		writeToStdout(chart, sm, *annotate)
 	} else {
-		if err := writeToDir(chart, *out); err != nil {
+		if _, err := writeToDir(chart, *out, sm, *annotate); err != nil {
+			panic(err)
+		}
+	}
+
+	if *sourceMap != "" {
+		if err := writeSourceMap(sm, *sourceMap); err != nil {
 			panic(err)
 		}
 	}
 }
 
-func writeToStdout(chart *gotohelm.Chart) {
+func writeToStdout(chart *gotohelm.Chart, sm *helm.SourceMap, annotate bool) {
 	for _, f := range chart.Files {
 		fmt.Printf("%s\n", f.Name)
-		f.Write(os.Stdout)
+		sm.Templates[f.Name] = f.WriteSourceMapped(os.Stdout, annotate)
 		fmt.Printf("\n\n")
 	}
 }
 
-func writeToDir(chart *gotohelm.Chart, dir string) error {
+// writeToDir writes the files of chart to dir and returns the names of those
+// whose content changed. Unchanged files are left untouched to avoid
+// needlessly triggering any file watchers.
+func writeToDir(chart *gotohelm.Chart, dir string, sm *helm.SourceMap, annotate bool) ([]string, error) {
+	var written []string
 	for _, f := range chart.Files {
-		file, err := os.OpenFile(path.Join(dir, f.Name), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644) //nolint:gosec
+		var buf bytes.Buffer
+		sm.Templates[f.Name] = f.WriteSourceMapped(&buf, annotate)
+
+		changed, err := writeIfChanged(path.Join(dir, f.Name), buf.Bytes())
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		f.Write(file)
-
-		if err := file.Close(); err != nil {
-			return err
+		if changed {
+			written = append(written, f.Name)
 		}
 	}
-	return nil
+	return written, nil
+}
+
+// writeIfChanged writes content to path unless it already holds content and
+// reports whether it did so.
+func writeIfChanged(path string, content []byte) (bool, error) {
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return false, nil
+	}
+	return true, os.WriteFile(path, content, 0o644) //nolint:gosec
+}
+
+func writeSourceMap(sm *helm.SourceMap, path string) error {
+	out, err := json.MarshalIndent(sm, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	_, err = writeIfChanged(path, append(out, '\n'))
+	return err
 }
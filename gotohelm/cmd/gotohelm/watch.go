@@ -0,0 +1,226 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/redpanda-data/redpanda-operator/gotohelm"
+	"github.com/redpanda-data/redpanda-operator/pkg/helm"
+)
+
+// debounce is how long to wait for further changes before rebuilding, as
+// editors and tools frequently write multiple files, or the same file
+// multiple times, in quick succession.
+const debounce = 100 * time.Millisecond
+
+// watcher implements -watch. It rebuilds the package matching pattern with a
+// [gotohelm.Builder] whenever it, or any of its dependencies within the main
+// or locally replaced modules, change.
+type watcher struct {
+	pattern   string
+	opts      gotohelm.Options
+	out       string
+	sourceMap string
+	annotate  bool
+	command   string
+
+	fsw     *fsnotify.Watcher
+	builder *gotohelm.Builder
+	watched map[string]bool
+}
+
+func (w *watcher) Run() error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer fsw.Close()
+
+	w.fsw = fsw
+	w.builder = gotohelm.NewBuilder(w.opts)
+	w.watched = map[string]bool{}
+
+	// Watch the package's directory up front in case it fails to load.
+	if stat, err := os.Stat(w.pattern); err == nil && stat.IsDir() {
+		if err := w.watch(w.pattern); err != nil {
+			return err
+		}
+	}
+
+	for {
+		w.rebuild()
+
+		fmt.Printf("watching for changes...\n")
+		if err := w.wait(); err != nil {
+			return err
+		}
+	}
+}
+
+// rebuild reloads and retranspiles the package, reporting any errors rather
+// than returning them so that watching may continue.
+func (w *watcher) rebuild() {
+	defer func() {
+		// The transpiler reports some unsupported constructs by panicking.
+		if r := recover(); r != nil {
+			fmt.Printf("failed to transpile %q: %v\n", w.pattern, r)
+		}
+	}()
+
+	start := time.Now()
+
+	cwd, _ := os.Getwd()
+
+	pkgs, err := gotohelm.LoadPackages(&packages.Config{
+		Dir:  cwd,
+		Mode: packages.NeedModule,
+	}, w.pattern)
+	if err != nil {
+		fmt.Printf("failed to load %q: %s\n", w.pattern, err)
+		return
+	}
+
+	if len(pkgs) != 1 {
+		fmt.Printf("loading %q resulted in loading more than one package.\n", w.pattern)
+		return
+	}
+
+	pkg := pkgs[0]
+
+	// (Re)establish watches as dependencies may have been added.
+	var watchErr error
+	packages.Visit(pkgs, nil, func(p *packages.Package) {
+		if watchErr != nil || len(p.GoFiles) == 0 || !isLocal(p.Module) {
+			return
+		}
+		watchErr = w.watch(filepath.Dir(p.GoFiles[0]))
+	})
+	if watchErr != nil {
+		fmt.Printf("failed to watch dependencies of %q: %s\n", w.pattern, watchErr)
+	}
+
+	chart, transpiled, err := w.builder.Build(pkg)
+	if err != nil {
+		// [gotohelm.Unsupported] errors include the position of the
+		// offending node.
+		fmt.Printf("failed to transpile %q: %s\n", pkg.Name, err)
+		return
+	}
+
+	sm := &helm.SourceMap{Templates: map[string]helm.TemplateSourceMap{}}
+
+	written, err := writeToDir(chart, w.out, sm, w.annotate)
+	if err != nil {
+		fmt.Printf("failed to write templates: %s\n", err)
+		return
+	}
+
+	if w.sourceMap != "" {
+		if err := writeSourceMap(sm, w.sourceMap); err != nil {
+			fmt.Printf("failed to write source map: %s\n", err)
+			return
+		}
+	}
+
+	for i, name := range transpiled {
+		transpiled[i] = filepath.Base(name)
+	}
+
+	fmt.Printf("transpiled [%s] and wrote [%s] in %s\n", strings.Join(transpiled, " "), strings.Join(written, " "), time.Since(start).Round(time.Millisecond))
+
+	if w.command == "" || len(written) == 0 {
+		return
+	}
+
+	cmd := exec.Command("sh", "-c", w.command)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		fmt.Printf("%q failed: %s\n", w.command, err)
+	}
+}
+
+// wait blocks until a go file within any watched directory changes.
+func (w *watcher) wait() error {
+	// Wait for the first relevant event.
+	for {
+		select {
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return nil
+			}
+			if !isRelevant(event) {
+				continue
+			}
+
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return nil
+			}
+			return err
+		}
+		break
+	}
+
+	// Then drain any that follow in quick succession.
+	timer := time.NewTimer(debounce)
+	defer timer.Stop()
+
+	for {
+		select {
+		case event := <-w.fsw.Events:
+			if isRelevant(event) {
+				timer.Reset(debounce)
+			}
+		case <-timer.C:
+			return nil
+		}
+	}
+}
+
+func (w *watcher) watch(dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+
+	if w.watched[dir] {
+		return nil
+	}
+
+	if err := w.fsw.Add(dir); err != nil {
+		return err
+	}
+
+	w.watched[dir] = true
+	return nil
+}
+
+// isRelevant reports whether event may affect the transpiled templates.
+func isRelevant(event fsnotify.Event) bool {
+	return strings.HasSuffix(event.Name, ".go") && !strings.HasSuffix(event.Name, "_test.go") && !event.Has(fsnotify.Chmod)
+}
+
+// isLocal reports whether mod is a module whose source may change, i.e. a
+// main (or workspace) module or a module replaced by a local directory.
+// Modules within the module cache are immutable.
+func isLocal(mod *packages.Module) bool {
+	return mod != nil && (mod.Main || (mod.Replace != nil && mod.Replace.Version == ""))
+}
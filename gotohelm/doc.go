@@ -29,6 +29,27 @@
 //	// +gotohelm:ignore=true          // Skips transpilation of the annotated file, function, or type.
 //	// +gotohelm:namespace=NAMESPACE  // Changes the namespace of transpiled package.
 //	// +gotohelm:builtin=BUILTIN_FUNC // Replaces transpilation of the annotated function with `BUILTIN_FUNC`.
+//	// +gotohelm:template=CONST       // Emits the string constant CONST as the template of the annotated function.
+//
+// The template directive is a trap door for anything that can't be
+// transpiled. The template is emitted verbatim in place of the function's
+// body, with its parameters bound to variables of the same names, and must
+// write its result as `{{- (dict "r" RESULT) | toJson -}}`. The go body is
+// still used by [GoChart.Render]. To ensure both implementations are
+// equivalent, the `-ignore-templates` flag transpiles the go bodies instead.
+//
+//	const quoteAllTemplate = `
+//	{{- $quoted := (list) -}}
+//	{{- range $_, $value := $values -}}
+//	{{- $quoted = (append $quoted (quote $value)) -}}
+//	{{- end -}}
+//	{{- (dict "r" $quoted) | toJson -}}
+//	`
+//
+//	// +gotohelm:template=quoteAllTemplate
+//	func quoteAll(values []string) []string {
+//		...
+//	}
 //
 // # Interop
 //
@@ -37,11 +58,50 @@
 //
 //	((include NAMESPACE.NAME (dict "a" (list ARGS...))) | fromJson | get "r")
 //
+// # Generics
+//
+// Generic functions, and methods of generic types, are transpiled once with
+// their type parameters erased. Type parameters whose type sets consist
+// solely of integers, floats, strings, or booleans, e.g. ~int | ~int64, are
+// treated as the equivalent basic type. Functions that reference the zero
+// value of a type parameter, e.g. var zero T, are passed the zero values of
+// their type arguments ahead of all other arguments, so that it works as
+// expected:
+//
+//	((include NAMESPACE.NAME (dict "a" (list ZEROS... ARGS...))) | fromJson | get "r")
+//
+// # Debugging
+//
+// The `-sourcemap FILE` flag writes a source map of the transpiled templates,
+// mapping each of their lines back to the go source it was transpiled from.
+// [helm.SourceMap.Annotate] uses it to rewrite the errors reported by helm in
+// terms of the go source. The `-annotate` flag additionally prefixes every
+// transpiled statement with a comment of its go source.
+//
+// The `-watch` flag keeps gotohelm running, retranspiling the package whenever
+// it, or any of its dependencies within local modules, change. See [Builder].
+// `-exec CMD` runs a shell command after every rebuild that changed templates.
+//
+// # Testing
+//
+// [gotohelmtest.AssertGoHelmEquivalence] property tests that a chart's go
+// renderer, [GoChart.Render], and its transpiled templates, as rendered by helm
+// template, produce equivalent objects for values generated from the chart's
+// values.schema.json. Failing values are shrunk and logged.
+//
 // # Limitations
 //
-//   - There is no "trap door" to fallback to raw templates
-//   - Switch statements, in all forms, are not currently supported
+//   - Switch statements may not use `fallthrough` or `break`. Type switches may
+//     not switch on numeric types.
+//   - Function literals capture variables by value. Captured variables may not
+//     be reassigned after the literal is declared, nor anywhere within a loop
+//     that declares the literal but not the variable.
 //   - Type assertions don't work.
+//   - Arithmetic on type parameters whose type sets mix integers and floats,
+//     e.g. ~int | ~float64, isn't supported.
+//   - For loops other than ranges and simple counted loops, e.g. for cond {} or
+//     for {}, are transpiled into ranges over until and fail after
+//     [Options.MaxLoopIterations] iterations (-max-loop-iterations).
 //   - Most forms of incompatibility are handled with panics and fmt.Sprintf.
 //   - As all data is represented as JSON within helm. All numeric types must
 //     be treated as float64s. [helmette.AsIntegral] may be used to approximate
@@ -60,6 +120,13 @@
 // [json.Marshaller] or [json.Unmarshaller], such as [resource.Quantity] will
 // need to be special cased within the transpiler itself.
 //
+// As these round trips through JSON are expensive, the transpiled templates
+// are optimized before being written. Small pure functions returning a bool,
+// number, or string are inlined, functions returning the result of another
+// call pass it through without re-encoding it, and literals are constant
+// folded. The `-optimize=false` flag disables the optimizations, which are
+// otherwise guaranteed not to change the templates' output.
+//
 // Certain types of go syntax are difficult to transpile. To preserve
 // simplicity of the transpiler, more complicated pieces of syntax are
 // re-written to equivalent syntax that can more easily be transpiled.
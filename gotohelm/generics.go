@@ -0,0 +1,336 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Generic functions, and methods of generic types, are transpiled once with
+// their type parameters erased, as all values are represented as JSON within
+// templates regardless of their go type.
+//
+// The only thing that can't be inferred from the JSON representation of a
+// value is the zero value of a type parameter, e.g. var zero T. Calls to
+// generic functions that reference such a zero value, either directly or by
+// passing it on to other generic functions, pass the zero value of each of
+// their type arguments ahead of any other arguments, receiver included. See
+// [zeroUses].
+//
+//	func Default[T comparable](value, fallback T) T {
+//		var zero T
+//		...
+//	}
+//
+//	Default("", "fallback")
+//
+// Will get transpiled to:
+//
+//	{{- define "example.Default" -}}
+//	{{- $_T_zero := (index .a 0) -}}
+//	{{- $value := (index .a 1) -}}
+//	{{- $fallback := (index .a 2) -}}
+//	{{- $zero := $_T_zero -}}
+//	...
+//	{{- end -}}
+//
+//	(get (fromJson (include "example.Default" (dict "a" (list "" "" "fallback")))) "r")
+//
+// Type parameters whose type sets consist solely of integers, floats,
+// strings, or booleans are treated as the equivalent basic type. See
+// [erase].
+
+// erase returns the basic type that represents typ within templates if typ is
+// a type parameter whose type set consists solely of integers, floats,
+// strings, or booleans. Otherwise, typ is returned as is.
+//
+// This allows arithmetic on numeric type parameters to select the correct
+// sprig functions and results to be cast appropriately.
+func erase(typ types.Type) types.Type {
+	tp, ok := typ.(*types.TypeParam)
+	if !ok {
+		return typ
+	}
+
+	iface, ok := tp.Constraint().Underlying().(*types.Interface)
+	if !ok {
+		return typ
+	}
+
+	terms := typeSetOf(iface)
+	if len(terms) == 0 {
+		return typ
+	}
+
+	for _, kind := range []struct {
+		Info types.BasicInfo
+		Type types.Type
+	}{
+		{types.IsInteger, types.Typ[types.Int]},
+		{types.IsFloat, types.Typ[types.Float64]},
+		{types.IsString, types.Typ[types.String]},
+		{types.IsBoolean, types.Typ[types.Bool]},
+	} {
+		all := true
+		for _, term := range terms {
+			basic, ok := term.Underlying().(*types.Basic)
+			all = all && ok && basic.Info()&kind.Info != 0
+		}
+		if all {
+			return kind.Type
+		}
+	}
+
+	return typ
+}
+
+// typeSetOf returns the types of the union terms of iface, including those of
+// any embedded constraints, e.g. ~int | ~int64. It returns nil if iface's type
+// set isn't restricted to a union of types, e.g. any or comparable.
+func typeSetOf(iface *types.Interface) []types.Type {
+	var terms []types.Type
+	for i := 0; i < iface.NumEmbeddeds(); i++ {
+		switch embedded := iface.EmbeddedType(i).(type) {
+		case *types.Union:
+			for j := 0; j < embedded.Len(); j++ {
+				terms = append(terms, embedded.Term(j).Type())
+			}
+		default:
+			if nested, ok := embedded.Underlying().(*types.Interface); ok {
+				nestedTerms := typeSetOf(nested)
+				if nestedTerms == nil {
+					return nil
+				}
+				terms = append(terms, nestedTerms...)
+			} else {
+				terms = append(terms, embedded)
+			}
+		}
+	}
+	return terms
+}
+
+// typeParamsOf returns the type parameters of fn, or those of its receiver if
+// fn is a method of a generic type.
+func typeParamsOf(fn *types.Func) *types.TypeParamList {
+	sig := fn.Type().(*types.Signature)
+	if sig.RecvTypeParams().Len() > 0 {
+		return sig.RecvTypeParams()
+	}
+	return sig.TypeParams()
+}
+
+// typeParamZeros returns the variables that hold the zero values of the type
+// parameters of fn, which are passed ahead of fn's other arguments.
+func typeParamZeros(fn *types.Func) []Node {
+	var zeros []Node
+	tparams := typeParamsOf(fn)
+	for i := 0; i < tparams.Len(); i++ {
+		zeros = append(zeros, typeParamZero(tparams.At(i)))
+	}
+	return zeros
+}
+
+// typeParamZero returns the variable that holds the zero value of tp.
+func typeParamZero(tp *types.TypeParam) *Ident {
+	return &Ident{Name: "_" + tp.Obj().Name() + "_zero"}
+}
+
+// typeArgZeros returns the zero values of the type arguments of a call of
+// callee, which are passed ahead of the call's other arguments if callee
+// references any of them.
+func (t *Transpiler) typeArgZeros(fun ast.Expr, callee *types.Func) []Node {
+	if !t.zeros.of(callee) {
+		return nil
+	}
+
+	var zeros []Node
+	targs := typeArgsOf(t.TypesInfo, fun, callee)
+	for i := 0; i < targs.Len(); i++ {
+		zeros = append(zeros, t.zeroOf(targs.At(i)))
+	}
+	return zeros
+}
+
+// typeArgsOf returns the type arguments of a call of callee, or those of its
+// receiver if callee is a method of a generic type.
+func typeArgsOf(info *types.Info, fun ast.Expr, callee *types.Func) *types.TypeList {
+	if recv := callee.Type().(*types.Signature).Recv(); recv != nil {
+		typ := recv.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if named, ok := typ.(*types.Named); ok {
+			return named.TypeArgs()
+		}
+	} else if ident := calleeIdent(fun); ident != nil {
+		return info.Instances[ident].TypeArgs
+	}
+	return nil
+}
+
+// calleeIdent returns the identifier of the function called by fun, e.g. Fn
+// of pkg.Fn[int].
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	switch fun := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	case *ast.IndexExpr:
+		return calleeIdent(fun.X)
+	case *ast.IndexListExpr:
+		return calleeIdent(fun.X)
+	}
+	return nil
+}
+
+// zeroUses determines which generic functions reference the zero values of
+// their type parameters. Only those functions accept the zero values ahead of
+// their other arguments, so that calls of any other generic function don't
+// needlessly carry them.
+type zeroUses struct {
+	packages     map[string]*packages.Package
+	dependencies map[string]struct{}
+	funcs        map[*types.Func]bool
+}
+
+func newZeroUses(pkgs map[string]*packages.Package, dependencies map[string]struct{}) *zeroUses {
+	return &zeroUses{
+		packages:     pkgs,
+		dependencies: dependencies,
+		funcs:        map[*types.Func]bool{},
+	}
+}
+
+// of returns whether the body of the generic function fn references the zero
+// value of any of its type parameters.
+func (z *zeroUses) of(fn *types.Func) bool {
+	// Methods of instantiated generic types are transpiled once, as their
+	// generic origin.
+	fn = fn.Origin()
+
+	if typeParamsOf(fn).Len() == 0 {
+		return false
+	}
+
+	if uses, ok := z.funcs[fn]; ok {
+		return uses
+	}
+
+	pkg := z.packages[fn.Pkg().Path()]
+	decl := findNearest[*ast.FuncDecl](pkg, fn.Pos())
+	if decl == nil || decl.Body == nil {
+		return false
+	}
+
+	// Recursive functions are assumed to reference the zero values until
+	// proven otherwise, which errs on the side of passing them needlessly.
+	z.funcs[fn] = true
+	z.funcs[fn] = z.within(pkg.TypesInfo, decl.Body)
+
+	return z.funcs[fn]
+}
+
+// within returns whether the zero value of any type parameter is referenced
+// within node, function literals included. It mirrors the callers of
+// [Transpiler.zeroOf].
+func (z *zeroUses) within(info *types.Info, node ast.Node) bool {
+	uses := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			// var x T
+			if len(n.Values) == 0 {
+				for _, name := range n.Names {
+					uses = uses || zeroUsesTypeParam(info.TypeOf(name))
+				}
+			}
+
+		case *ast.AssignStmt:
+			// x, ok := m[k] and x, ok := v.(T)
+			if len(n.Lhs) == 2 && len(n.Rhs) == 1 {
+				switch rhs := n.Rhs[0].(type) {
+				case *ast.IndexExpr:
+					uses = uses || zeroUsesTypeParam(info.TypeOf(n.Lhs[0]))
+				case *ast.TypeAssertExpr:
+					uses = uses || zeroUsesTypeParam(info.TypeOf(rhs.Type))
+				}
+			}
+
+		case *ast.CompositeLit:
+			// Struct literals are merged onto their zero value.
+			typ := info.TypeOf(n)
+			if ptr, ok := typ.(*types.Pointer); ok {
+				typ = ptr.Elem()
+			}
+			uses = uses || zeroUsesTypeParam(typ)
+
+		case *ast.IndexExpr:
+			// m[k] returns the zero value of missing keys.
+			if typ := info.TypeOf(n.X); typ != nil {
+				if m, ok := erase(typ).Underlying().(*types.Map); ok {
+					uses = uses || zeroUsesTypeParam(m.Elem())
+				}
+			}
+
+		case *ast.CallExpr:
+			// Calls of generic functions pass on the zero values of their
+			// type arguments, which may be type parameters themselves.
+			callee, ok := typeutil.Callee(info, n).(*types.Func)
+			if !ok || callee.Pkg() == nil {
+				break
+			}
+			if _, ok := z.dependencies[callee.Pkg().Path()]; !ok || !z.of(callee) {
+				break
+			}
+			targs := typeArgsOf(info, n.Fun, callee)
+			for i := 0; i < targs.Len(); i++ {
+				uses = uses || zeroUsesTypeParam(targs.At(i))
+			}
+		}
+		return !uses
+	})
+	return uses
+}
+
+// zeroUsesTypeParam returns whether the zero value of typ, as transpiled by
+// [Transpiler.zeroOf], references the zero value of a type parameter.
+func zeroUsesTypeParam(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	typ = erase(typ)
+	if _, ok := typ.(*types.TypeParam); ok {
+		return true
+	}
+
+	// The zero values of structs hold those of their fields, including
+	// those promoted from embedded structs.
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i).Type()
+		if ptr, ok := field.(*types.Pointer); ok && st.Field(i).Embedded() {
+			field = ptr.Elem()
+		}
+		if zeroUsesTypeParam(field) {
+			return true
+		}
+	}
+	return false
+}
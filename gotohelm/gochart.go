@@ -316,6 +316,11 @@ func (c *GoChart) Metadata() chart.Metadata {
 	return c.metadata
 }
 
+// ValuesSchemaJSON returns the contents of this chart's values.schema.json.
+func (c *GoChart) ValuesSchemaJSON() ([]byte, error) {
+	return fs.ReadFile(c.fs, "values.schema.json")
+}
+
 // doRender is a helper to catch any panics from renderFunc and convert them to
 // errors.
 func (c *GoChart) doRender(dot *helmette.Dot) (_ []kube.Object, err error) {
@@ -0,0 +1,153 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package gotohelmtest contains utilities for testing charts built with
+// [gotohelm.GoChart].
+package gotohelmtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"pgregory.net/rapid"
+	"sigs.k8s.io/yaml"
+
+	"github.com/redpanda-data/redpanda-operator/gotohelm"
+	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
+	"github.com/redpanda-data/redpanda-operator/pkg/helm"
+	"github.com/redpanda-data/redpanda-operator/pkg/kube"
+	"github.com/redpanda-data/redpanda-operator/pkg/rapidutil"
+	"github.com/redpanda-data/redpanda-operator/pkg/testutil"
+	"github.com/redpanda-data/redpanda-operator/pkg/valuesutil"
+)
+
+// Options configures [AssertGoHelmEquivalence].
+type Options struct {
+	// Release is the release that the chart is rendered as. Defaults to a
+	// release named "gotohelm" in the "default" namespace.
+	Release helmette.Release
+	// Scheme is used to decode the objects rendered by helm. It must contain
+	// all types that the chart may produce. Defaults to client-go's scheme.
+	Scheme *runtime.Scheme
+	// Overrides are merged on top of all generated values. They may be used
+	// to disable non-deterministic features of the chart, such as randomly
+	// generated passwords.
+	Overrides map[string]any
+	// Normalize, if provided, is called with every rendered object, from
+	// either renderer, before they're compared. It may be used to strip
+	// fields that are expected to diverge.
+	Normalize func(kube.Object)
+	// StrictErrors, if true, fails if only one of the renderers rejects the
+	// generated values. By default, values rejected by either renderer are
+	// skipped as the schemas of charts are rarely strict enough to exclude
+	// all invalid values.
+	StrictErrors bool
+}
+
+// AssertGoHelmEquivalence property tests that the go renderer of chart,
+// [gotohelm.GoChart.Render], and its transpiled templates, as rendered by helm
+// template, produce equivalent objects for values generated from the chart's
+// values.schema.json with [valuesutil.Generate].
+//
+// Upon failure, the generated values are shrunk by rapid and the minimal
+// failing values are logged. The number of checks may be controlled with
+// -rapid.checks.
+func AssertGoHelmEquivalence(t *testing.T, chart *gotohelm.GoChart, opts Options) {
+	if opts.Release.Name == "" {
+		opts.Release = helmette.Release{
+			Name:      "gotohelm",
+			Namespace: "default",
+			Service:   "Helm",
+			IsInstall: true,
+		}
+	}
+
+	schemaJSON, err := chart.ValuesSchemaJSON()
+	require.NoError(t, err)
+
+	var schema jsonschema.Schema
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+
+	overrides, err := yaml.Marshal(opts.Overrides)
+	require.NoError(t, err)
+
+	client, err := helm.New(helm.Options{ConfigHome: testutil.TempDir(t)})
+	require.NoError(t, err)
+
+	dir := testutil.TempDir(t)
+	require.NoError(t, chart.Write(dir))
+
+	chartDir := filepath.Join(dir, chart.Metadata().Name)
+
+	rendered := 0
+
+	rapid.Check(t, func(t *rapid.T) {
+		generated, err := yaml.Marshal(valuesutil.Generate(rapidutil.Rand(t), &schema))
+		require.NoError(t, err)
+
+		values, err := helm.MergeYAMLValues(generated, overrides)
+		require.NoError(t, err)
+
+		valuesYAML, err := yaml.Marshal(values)
+		require.NoError(t, err)
+
+		t.Logf("values:\n%s", valuesYAML)
+
+		goObjs, goErr := chart.Render(nil, opts.Release, values)
+
+		manifests, helmErr := client.Template(context.Background(), chartDir, helm.TemplateOptions{
+			Name:      opts.Release.Name,
+			Namespace: opts.Release.Namespace,
+			Values:    values,
+		})
+
+		if goErr != nil || helmErr != nil {
+			if opts.StrictErrors {
+				require.Equal(t, helmErr != nil, goErr != nil, "only one renderer rejected the values\ngo: %v\nhelm: %v", goErr, helmErr)
+			}
+			t.Logf("skipping rejected values\ngo: %v\nhelm: %v", goErr, helmErr)
+			return
+		}
+
+		helmObjs, err := kube.DecodeYAML(manifests, opts.Scheme)
+		require.NoError(t, err)
+
+		require.Equal(t, normalize(t, helmObjs, opts.Normalize), normalize(t, goObjs, opts.Normalize), "Divergence between go and helm renderers\nHint: Helm (Expected) is prefixed with -\n      Go (Actual) is prefixed with +")
+
+		rendered++
+	})
+
+	require.NotZero(t, rendered, "no generated values were accepted by both renderers")
+}
+
+// normalize returns the JSON representation of objs keyed by their kind,
+// namespace, and name. JSON round tripping ensures that types with multiple
+// equivalent representations, such as resource.Quantity, compare as equal.
+func normalize(t require.TestingT, objs []kube.Object, fn func(kube.Object)) map[string]map[string]any {
+	out := map[string]map[string]any{}
+	for _, obj := range objs {
+		if fn != nil {
+			fn(obj)
+		}
+
+		key := fmt.Sprintf("%s %s/%s", obj.GetObjectKind().GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+
+		normalized, err := valuesutil.UnmarshalInto[map[string]any](obj)
+		require.NoError(t, err)
+
+		out[key] = normalized
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelmtest
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redpanda-data/redpanda-operator/gotohelm"
+	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
+	"github.com/redpanda-data/redpanda-operator/pkg/kube"
+)
+
+//go:embed all:testdata/diverging
+var divergingFS embed.FS
+
+// diverging is a chart whose go renderer diverges from its template if
+// .Values.diverge is true.
+var diverging = func() *gotohelm.GoChart {
+	f, err := fs.Sub(divergingFS, "testdata/diverging")
+	if err != nil {
+		panic(err)
+	}
+
+	return gotohelm.MustLoad(f, func(dot *helmette.Dot) []kube.Object {
+		renderer := "helm"
+		if diverge, _ := dot.Values["diverge"].(bool); diverge {
+			renderer = "go"
+		}
+
+		data, _ := dot.Values["data"].(string)
+
+		return []kube.Object{
+			&corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dot.Release.Name,
+					Namespace: dot.Release.Namespace,
+				},
+				Data: map[string]string{
+					"data":     data,
+					"renderer": renderer,
+				},
+			},
+		}
+	})
+}()
+
+func TestAssertGoHelmEquivalence(t *testing.T) {
+	// Divergences fail the test that's passed to AssertGoHelmEquivalence, so
+	// the diverging case is run in a subprocess whose failure is asserted.
+	if os.Getenv("GOTOHELMTEST_DIVERGE") != "" {
+		AssertGoHelmEquivalence(t, diverging, Options{
+			Overrides: map[string]any{"diverge": true},
+		})
+		return
+	}
+
+	t.Run("Equivalent", func(t *testing.T) {
+		AssertGoHelmEquivalence(t, diverging, Options{
+			Overrides: map[string]any{"diverge": false},
+		})
+	})
+
+	t.Run("Diverging", func(t *testing.T) {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestAssertGoHelmEquivalence$", "-rapid.checks=10", "-rapid.nofailfile")
+		cmd.Env = append(os.Environ(), "GOTOHELMTEST_DIVERGE=1")
+
+		out, err := cmd.CombinedOutput()
+		require.Error(t, err, "diverging renderers must fail the test:\n%s", out)
+		require.Contains(t, string(out), "Divergence between go and helm renderers")
+	})
+}
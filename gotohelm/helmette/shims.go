@@ -175,3 +175,25 @@ func SortedMap[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
 		}
 	})
 }
+
+// Map returns a slice of the results of calling fn on each element of s. It
+// is the gotohelm compatible equivalent of a for loop that appends to a new
+// slice.
+func Map[T, U any](s []T, fn func(T) U) []U {
+	out := make([]U, 0, len(s))
+	for _, el := range s {
+		out = append(out, fn(el))
+	}
+	return out
+}
+
+// Filter returns a slice of the elements of s for which fn returns true.
+func Filter[T any](s []T, fn func(T) bool) []T {
+	out := make([]T, 0, len(s))
+	for _, el := range s {
+		if fn(el) {
+			out = append(out, el)
+		}
+	}
+	return out
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Builder transpiles a package incrementally. Successive calls to
+// [Builder.Build] only retranspile the files of the package that have changed
+// since the previous call. It's intended for long running processes, such as
+// gotohelm -watch, that repeatedly transpile the same package.
+//
+// The transpilation of a file may depend on the declarations of any other
+// file, so all files are retranspiled if:
+//   - The package's dependencies have changed.
+//   - Files have been added to or removed from the package.
+//   - The declarations, or their directives, of any changed file have changed.
+//     The values of constants are considered part of their declarations.
+//
+// Otherwise, only changed files are retranspiled. The output of a [Builder] is
+// always identical to that of [TranspileWithOptions].
+type Builder struct {
+	opts  Options
+	deps  [sha256.Size]byte
+	files map[string]*builtFile
+}
+
+type builtFile struct {
+	source [sha256.Size]byte
+	decls  [sha256.Size]byte
+	// file is the unoptimized template of the go file, if any.
+	file *File
+}
+
+// NewBuilder returns a [Builder] that transpiles packages with the given
+// options.
+func NewBuilder(opts Options) *Builder {
+	return &Builder{opts: opts}
+}
+
+// Build transpiles pkg, as loaded by [LoadPackages], and returns the resultant
+// chart along with the names of the go files that were retranspiled. Build
+// must always be called with the same package, albeit reloaded.
+func (b *Builder) Build(pkg *packages.Package) (*Chart, []string, error) {
+	deps, err := hashDependencies(pkg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zeros := newZeroUses(mkPkgTree(pkg), dependenciesOf(pkg, b.opts))
+
+	files := map[string]*builtFile{}
+	for _, f := range pkg.Syntax {
+		// NB: Positions refer to the original source even if the file has
+		// been rewritten by [LoadPackages].
+		filename := pkg.Fset.File(f.Pos()).Name()
+
+		source, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		files[filename] = &builtFile{
+			source: sha256.Sum256(source),
+			decls:  hashDecls(pkg.TypesInfo, zeros, f),
+		}
+	}
+
+	full := b.files == nil || deps != b.deps || len(files) != len(b.files)
+
+	var changed []*ast.File
+	var names []string
+	for _, f := range pkg.Syntax {
+		filename := pkg.Fset.File(f.Pos()).Name()
+
+		prev, ok := b.files[filename]
+		switch {
+		case !ok || prev.decls != files[filename].decls:
+			full = true
+		case prev.source == files[filename].source:
+			files[filename].file = prev.file
+			continue
+		}
+
+		changed = append(changed, f)
+		names = append(names, filename)
+	}
+
+	if full {
+		changed = pkg.Syntax
+		names = names[:0]
+		for _, f := range pkg.Syntax {
+			names = append(names, pkg.Fset.File(f.Pos()).Name())
+		}
+	}
+
+	if len(changed) > 0 {
+		transpiled, err := transpileFiles(pkg, b.opts, changed)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, f := range changed {
+			filename := pkg.Fset.File(f.Pos()).Name()
+			files[filename].file = nil
+
+			for _, file := range transpiled.Files {
+				if file.Source == filepath.Base(filename) {
+					files[filename].file = file
+				}
+			}
+		}
+	}
+
+	// Only commit to the new state once transpilation has succeeded so that
+	// files are always compared against the last successful build.
+	b.deps = deps
+	b.files = files
+
+	// Files are optimized as a whole, as functions may be inlined across
+	// files. Optimization happens in place, so operate on copies to preserve
+	// the unoptimized templates.
+	var chart Chart
+	for _, f := range pkg.Syntax {
+		if file := files[pkg.Fset.File(f.Pos()).Name()].file; file != nil {
+			cp := *file
+			chart.Files = append(chart.Files, &cp)
+		}
+	}
+
+	if !b.opts.DisableOptimizations {
+		optimize(chart.Files)
+	}
+
+	shims, err := bootstrapFor(b.opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chart.Files = append(chart.Files, shims)
+
+	return &chart, names, nil
+}
+
+// hashDecls returns a hash of the top level declarations of f, including
+// their doc comments, which hold any directives. It changes whenever a change
+// to f might affect the transpilation of other files, including whether its
+// generic functions accept the zero values of their type parameters.
+func hashDecls(info *types.Info, zeros *zeroUses, f *ast.File) [sha256.Size]byte {
+	h := sha256.New()
+
+	fmt.Fprintln(h, f.Doc.Text())
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			obj := info.Defs[decl.Name]
+			fn, _ := obj.(*types.Func)
+			fmt.Fprintln(h, decl.Doc.Text(), types.ObjectString(obj, nil), fn != nil && zeros.of(fn))
+
+		case *ast.GenDecl:
+			fmt.Fprintln(h, decl.Doc.Text())
+
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					// NB: The string of a struct type includes its field tags,
+					// which affect its JSON representation.
+					fmt.Fprintln(h, spec.Doc.Text(), types.ObjectString(info.Defs[spec.Name], nil))
+
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						obj := info.Defs[name]
+						if obj == nil {
+							continue
+						}
+						fmt.Fprintln(h, types.ObjectString(obj, nil))
+						// Constants are inlined into the files that reference
+						// them, so their values are part of their declaration.
+						if c, ok := obj.(*types.Const); ok {
+							fmt.Fprintln(h, c.Val().ExactString())
+						}
+					}
+				}
+			}
+		}
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// hashDependencies returns a hash of the names, sizes, and modification times
+// of the files of all packages that pkg transitively imports.
+func hashDependencies(pkg *packages.Package) ([sha256.Size]byte, error) {
+	var files []string
+	for path, dep := range mkPkgTree(pkg) {
+		if path == pkg.PkgPath {
+			continue
+		}
+		files = append(files, dep.GoFiles...)
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, file := range files {
+		stat, err := os.Stat(file)
+		if err != nil {
+			return [sha256.Size]byte{}, err
+		}
+		fmt.Fprintln(h, file, stat.Size(), stat.ModTime().UnixNano())
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum, nil
+}
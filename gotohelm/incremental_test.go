@@ -0,0 +1,211 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestBuilder(t *testing.T) {
+	gotohelmDir, err := filepath.Abs(".")
+	require.NoError(t, err)
+
+	// Create a copy of the example module, whose files may be freely
+	// modified.
+	dir := t.TempDir()
+
+	gomod, err := os.ReadFile("testdata/src/example/go.mod")
+	require.NoError(t, err)
+	gomod = bytes.ReplaceAll(gomod, []byte("=> ../../../"), []byte("=> "+gotohelmDir))
+
+	gosum, err := os.ReadFile("testdata/src/example/go.sum")
+	require.NoError(t, err)
+
+	write := func(name, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	write("go.mod", string(gomod))
+	write("go.sum", string(gosum))
+
+	write("incremental/a.go", `package incremental
+
+func A(enabled bool) string {
+	if isEnabled(enabled) {
+		return "enabled"
+	}
+	return "disabled"
+}
+`)
+
+	write("incremental/b.go", `package incremental
+
+func isEnabled(enabled bool) bool {
+	return enabled
+}
+`)
+
+	load := func() *packages.Package {
+		pkgs, err := LoadPackages(&packages.Config{
+			Dir: dir,
+			Env: append(os.Environ(), "GOWORK=off"),
+		}, "./incremental")
+		require.NoError(t, err)
+		return pkgs[0]
+	}
+
+	render := func(chart *Chart) string {
+		var out bytes.Buffer
+		for _, f := range chart.Files {
+			f.Write(&out)
+		}
+		return out.String()
+	}
+
+	builder := NewBuilder(Options{})
+
+	// build asserts that the incremental build is equivalent to a full
+	// transpilation and returns the base names of the retranspiled files.
+	build := func() []string {
+		chart, names, err := builder.Build(load())
+		require.NoError(t, err)
+
+		expected, err := TranspileWithOptions(load(), Options{})
+		require.NoError(t, err)
+
+		require.Equal(t, render(expected), render(chart))
+
+		var bases []string
+		for _, name := range names {
+			bases = append(bases, filepath.Base(name))
+		}
+		return bases
+	}
+
+	// The first build transpiles everything.
+	require.Equal(t, []string{"a.go", "b.go"}, build())
+
+	// Unchanged files aren't retranspiled.
+	require.Empty(t, build())
+
+	// Changes to the bodies of functions only retranspile the changed file,
+	// though functions inlined across files are still updated.
+	write("incremental/b.go", `package incremental
+
+func isEnabled(enabled bool) bool {
+	return !enabled
+}
+`)
+	require.Equal(t, []string{"b.go"}, build())
+
+	// Changes to declarations retranspile everything.
+	write("incremental/b.go", `package incremental
+
+// +gotohelm:name=enabled
+func isEnabled(enabled bool) bool {
+	return !enabled
+}
+`)
+	require.Equal(t, []string{"a.go", "b.go"}, build())
+
+	// Constants are inlined into the files that use them, so changes to
+	// their values retranspile everything.
+	write("incremental/a.go", `package incremental
+
+func A(enabled bool) string {
+	if isEnabled(enabled) {
+		return Greeting
+	}
+	return "disabled"
+}
+`)
+	write("incremental/b.go", `package incremental
+
+const Greeting = "hello"
+
+// +gotohelm:name=enabled
+func isEnabled(enabled bool) bool {
+	return !enabled
+}
+`)
+	require.Equal(t, []string{"a.go", "b.go"}, build())
+
+	write("incremental/b.go", `package incremental
+
+const Greeting = "goodbye"
+
+// +gotohelm:name=enabled
+func isEnabled(enabled bool) bool {
+	return !enabled
+}
+`)
+	require.Equal(t, []string{"a.go", "b.go"}, build())
+
+	// Generic functions only accept the zero values of their type
+	// parameters if they reference them, so changes to their bodies that
+	// begin to do so retranspile everything.
+	write("incremental/a.go", `package incremental
+
+func A(enabled bool) string {
+	return orDefault(Greeting, "disabled")
+}
+`)
+	write("incremental/b.go", `package incremental
+
+const Greeting = "goodbye"
+
+func orDefault[T comparable](value, fallback T) T {
+	return value
+}
+`)
+	require.Equal(t, []string{"a.go", "b.go"}, build())
+
+	write("incremental/b.go", `package incremental
+
+const Greeting = "goodbye"
+
+func orDefault[T comparable](value, fallback T) T {
+	var zero T
+	if value == zero {
+		return fallback
+	}
+	return value
+}
+`)
+	require.Equal(t, []string{"a.go", "b.go"}, build())
+
+	// Transpilation errors are reported and the next build is compared
+	// against the last successful one.
+	write("incremental/a.go", `package incremental
+
+func A(enabled bool) string {
+	x := 1
+	x <<= 2
+	return "unsupported"
+}
+`)
+	_, _, err = builder.Build(load())
+	require.ErrorContains(t, err, "a.go:5:2")
+
+	write("incremental/a.go", `package incremental
+
+func A(enabled bool) string {
+	return "fixed"
+}
+`)
+	require.Equal(t, []string{"a.go"}, build())
+}
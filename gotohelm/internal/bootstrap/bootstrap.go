@@ -89,6 +89,27 @@ func _len(m any) int {
 	return Len(m)
 }
 
+// re-implementation of helmette.Map.
+// +gotohelm:name=map
+func _map(s []any, fn func(any) any) []any {
+	out := []any{}
+	for _, el := range s {
+		out = append(out, fn(el))
+	}
+	return out
+}
+
+// re-implementation of helmette.Filter.
+func filter(s []any, fn func(any) bool) []any {
+	out := []any{}
+	for _, el := range s {
+		if fn(el) {
+			out = append(out, el)
+		}
+	}
+	return out
+}
+
 // re-implementation of k8s.io/utils/ptr.Deref.
 func ptr_Deref(ptr, def any) any {
 	if ptr != nil {
@@ -0,0 +1,365 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// pureBuiltins is the set of template and sprig functions that neither
+// mutate their arguments nor have any other side effects. Calls to them may
+// be freely moved or repeated.
+var pureBuiltins = map[string]bool{
+	"add": true, "add1": true, "and": true, "b64dec": true, "b64enc": true,
+	"ceil": true, "coalesce": true, "contains": true, "default": true,
+	"dict": true, "dig": true, "div": true, "empty": true, "eq": true,
+	"float64": true, "floor": true, "ge": true, "get": true, "gt": true,
+	"hasKey": true, "hasPrefix": true, "hasSuffix": true, "index": true,
+	"int": true, "int64": true, "join": true, "kindIs": true, "le": true,
+	"len": true, "list": true, "lower": true, "lt": true, "max": true,
+	"min": true, "mod": true, "mul": true, "ne": true, "not": true, "or": true,
+	"printf": true, "quote": true, "regexMatch": true, "replace": true,
+	"sha256sum": true, "split": true, "squote": true, "sub": true,
+	"ternary": true, "title": true, "toJson": true, "toString": true,
+	"trim": true, "trimPrefix": true, "trimSuffix": true, "trunc": true,
+	"upper": true,
+}
+
+var intLiteral = regexp.MustCompile(`^-?[0-9]+$`)
+
+// optimize rewrites the functions of the given files, in place, to avoid
+// needless round trips through include, toJson and fromJson, which dominate
+// the time taken to render large charts. Every optimization preserves the
+// output of the templates.
+//
+//   - Casts and parentheses around literals are folded.
+//   - Calls to functions that return nothing but a bool, number, or string
+//     computed from their arguments are inlined. See [optimizer.inline].
+//   - Functions that return the result of another function call pass the
+//     result of include through rather than decoding and re-encoding it.
+func optimize(files []*File) {
+	o := &optimizer{funcs: map[string]*Func{}, inlining: map[string]bool{}}
+
+	for _, f := range files {
+		for _, fn := range f.Funcs {
+			o.funcs[fn.Namespace+"."+fn.Name] = fn
+		}
+	}
+
+	// Inlining consults the original function bodies, so optimized functions
+	// are only swapped in once all have been optimized.
+	optimized := make([][]*Func, len(files))
+	for i, f := range files {
+		for _, fn := range f.Funcs {
+			optimized[i] = append(optimized[i], o.optimizeFunc(fn))
+		}
+	}
+
+	for i, f := range files {
+		f.Funcs = optimized[i]
+	}
+}
+
+type optimizer struct {
+	funcs map[string]*Func
+	// inlining holds the functions currently being inlined to prevent
+	// infinitely inlining recursive functions.
+	inlining map[string]bool
+}
+
+func (o *optimizer) optimizeFunc(fn *Func) *Func {
+	optimized := *fn
+	optimized.Statements = make([]Node, len(fn.Statements))
+	for i, stmt := range fn.Statements {
+		optimized.Statements[i] = transform(stmt, o.rewrite)
+	}
+	return &optimized
+}
+
+// rewrite optimizes a single node, whose children have already been
+// optimized. See [transform].
+func (o *optimizer) rewrite(n Node) Node {
+	switch n := n.(type) {
+	case *Cast:
+		lit, ok := n.X.(Literal)
+		if !ok || !intLiteral.MatchString(string(lit)) {
+			return n
+		}
+
+		// Integer literals are already ints within templates.
+		switch n.To {
+		case "int":
+			return lit
+		case "float64":
+			return lit + ".0"
+		}
+
+	case *ParenExpr:
+		switch x := n.Expr.(type) {
+		case Literal:
+			if isConstant(x) {
+				return x
+			}
+		case *Ident, *Nil, *BuiltInCall, *Call, *Cast, *ParenExpr, *DictLiteral:
+			// These are either atoms or already parenthesized.
+			return x
+		}
+
+	case *BuiltInCall:
+		if lit, ok := n.Func.(Literal); ok && lit == "not" && len(n.Arguments) == 1 {
+			switch n.Arguments[0] {
+			case Literal("true"):
+				return Literal("false")
+			case Literal("false"):
+				return Literal("true")
+			}
+		}
+
+	case *Call:
+		if inlined, ok := o.inline(n); ok {
+			return inlined
+		}
+
+	case *Return:
+		// The numeric casts of call results are no-ops once re-encoded as
+		// JSON as the function being called already returns an int or
+		// float.
+		expr := n.Expr
+		if cast, ok := expr.(*Cast); ok && (cast.To == "int" || cast.To == "int64" || cast.To == "float64") {
+			expr = cast.X
+		}
+
+		if call, ok := expr.(*Call); ok {
+			raw := *call
+			raw.Raw = true
+			return &Return{Expr: &raw}
+		}
+	}
+
+	return n
+}
+
+// inline returns the body of the function called by call with its parameters
+// substituted for the call's arguments, if it's safe to do so.
+//
+// To be inlined, a function must consist of a single return statement of an
+// expression that only references the function's parameters and doesn't call
+// any impure builtins. Its result must be immutable (a bool, number or
+// string) so that skipping the copy made by include doesn't matter.
+// Additionally, the call's arguments must be pure and any that are
+// referenced more than once must be trivial to evaluate.
+//
+//	{{- define "example.isEnabled" -}}
+//	{{- $c := (index .a 0) -}}
+//	...
+//	{{- (dict "r" (and $c.enabled (ne $c.name ""))) | toJson -}}
+//	...
+//	{{- end -}}
+//
+//	(get (fromJson (include "example.isEnabled" (dict "a" (list $config)))) "r")
+//
+// Will get rewritten to:
+//
+//	(and $config.enabled (ne $config.name ""))
+func (o *optimizer) inline(call *Call) (Node, bool) {
+	if call.Value || call.Captures != nil || call.Raw {
+		return nil, false
+	}
+
+	lit, ok := call.FuncName.(Literal)
+	if !ok {
+		return nil, false
+	}
+
+	name, err := strconv.Unquote(string(lit))
+	if err != nil {
+		return nil, false
+	}
+
+	fn, ok := o.funcs[name]
+	if !ok || !fn.BasicResult || o.inlining[name] || len(fn.Captures) > 0 || len(fn.Statements) != 1 || len(fn.Params) != len(call.Arguments) {
+		return nil, false
+	}
+
+	stmt := fn.Statements[0]
+	if p, ok := stmt.(*Positioned); ok {
+		stmt = p.Node
+	}
+
+	ret, ok := stmt.(*Return)
+	if !ok {
+		return nil, false
+	}
+
+	args := map[string]Node{}
+	for i, param := range fn.Params {
+		ident, ok := param.(*Ident)
+		if !ok {
+			return nil, false
+		}
+		args[ident.Name] = call.Arguments[i]
+	}
+
+	if !isPure(ret.Expr, args, true) {
+		return nil, false
+	}
+
+	uses := map[string]int{}
+	transform(ret.Expr, func(n Node) Node {
+		if ident, ok := n.(*Ident); ok {
+			uses[ident.Name]++
+		}
+		return n
+	})
+
+	for name, arg := range args {
+		if !isPure(arg, nil, false) || (uses[name] > 1 && !isTrivial(arg)) {
+			return nil, false
+		}
+	}
+
+	inlined := transform(ret.Expr, func(n Node) Node {
+		ident, ok := n.(*Ident)
+		if !ok {
+			return n
+		}
+		arg := args[ident.Name]
+		if _, ok := arg.(*Binary); ok {
+			return &ParenExpr{Expr: arg}
+		}
+		return arg
+	})
+
+	// The inlined body may itself be optimized further.
+	o.inlining[name] = true
+	defer delete(o.inlining, name)
+
+	return transform(inlined, o.rewrite), true
+}
+
+// isPure reports whether n may be evaluated any number of times, including
+// none, without side effects. If params is non-nil, n may only reference the
+// given variables. If calls is true, n may include calls to transpiled
+// functions, which are evaluated in the same order regardless.
+func isPure(n Node, params map[string]Node, calls bool) bool {
+	pure := true
+	transform(n, func(n Node) Node {
+		switch n := n.(type) {
+		case *Ident:
+			if params != nil {
+				_, ok := params[n.Name]
+				pure = pure && ok
+			}
+		case Literal:
+			// Literals may be arbitrary snippets of template code. Only
+			// trust constants and the names of pure builtins, which are
+			// checked below.
+			pure = pure && (isConstant(n) || pureBuiltins[string(n)])
+		case *BuiltInCall:
+			lit, ok := n.Func.(Literal)
+			pure = pure && ok && pureBuiltins[string(lit)]
+		case *Call:
+			pure = pure && calls && !n.Value && n.Captures == nil
+		case *Nil, *Selector, *Cast, *ParenExpr, *DictLiteral, *KeyValue, *Binary:
+		default:
+			pure = false
+		}
+		return n
+	})
+	return pure
+}
+
+// isTrivial reports whether n is a variable, constant, or field thereof.
+func isTrivial(n Node) bool {
+	switch n := n.(type) {
+	case *Ident, *Nil:
+		return true
+	case Literal:
+		return isConstant(n)
+	case *Selector:
+		return isTrivial(n.Expr)
+	}
+	return false
+}
+
+// isConstant reports whether lit is a string, number, or boolean constant.
+func isConstant(lit Literal) bool {
+	s := string(lit)
+	if s == "true" || s == "false" {
+		return true
+	}
+	if _, err := strconv.Unquote(s); err == nil {
+		return true
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// transform returns a copy of n with fn applied to every node, children
+// first.
+func transform(n Node, fn func(Node) Node) Node {
+	each := func(nodes []Node) []Node {
+		if nodes == nil {
+			return nil
+		}
+		out := make([]Node, len(nodes))
+		for i, n := range nodes {
+			out[i] = transform(n, fn)
+		}
+		return out
+	}
+
+	switch n := n.(type) {
+	case nil:
+		return nil
+	case Literal, *Ident, *Nil:
+		return fn(n)
+	case *Until:
+		return fn(&Until{Expr: transform(n.Expr, fn)})
+	case *UntilStep:
+		return fn(&UntilStep{Start: transform(n.Start, fn), Stop: transform(n.Stop, fn), Step: transform(n.Step, fn)})
+	case *ParenExpr:
+		return fn(&ParenExpr{Expr: transform(n.Expr, fn)})
+	case *Selector:
+		return fn(&Selector{Expr: transform(n.Expr, fn), Field: n.Field, Inlined: n.Inlined})
+	case *Statement:
+		return fn(&Statement{NoCapture: n.NoCapture, Expr: transform(n.Expr, fn)})
+	case *Binary:
+		return fn(&Binary{LHS: transform(n.LHS, fn), Op: n.Op, RHS: transform(n.RHS, fn)})
+	case *BuiltInCall:
+		return fn(&BuiltInCall{Func: transform(n.Func, fn), Arguments: each(n.Arguments)})
+	case *Cast:
+		return fn(&Cast{To: n.To, X: transform(n.X, fn)})
+	case *Call:
+		return fn(&Call{FuncName: transform(n.FuncName, fn), Arguments: each(n.Arguments), Value: n.Value, Captures: transform(n.Captures, fn), Raw: n.Raw})
+	case *Assignment:
+		return fn(&Assignment{LHS: transform(n.LHS, fn), New: n.New, RHS: transform(n.RHS, fn)})
+	case *DictLiteral:
+		kvs := make([]*KeyValue, len(n.KeysValues))
+		for i, kv := range n.KeysValues {
+			kvs[i] = &KeyValue{Key: transform(kv.Key, fn), Value: transform(kv.Value, fn)}
+		}
+		return fn(&DictLiteral{KeysValues: kvs})
+	case *Return:
+		return fn(&Return{Expr: transform(n.Expr, fn)})
+	case *Block:
+		return fn(&Block{Statements: each(n.Statements)})
+	case *Range:
+		return fn(&Range{Key: transform(n.Key, fn), Value: transform(n.Value, fn), Over: transform(n.Over, fn), Body: transform(n.Body, fn)})
+	case *IfStmt:
+		return fn(&IfStmt{Init: transform(n.Init, fn), Cond: transform(n.Cond, fn), Body: transform(n.Body, fn), Else: transform(n.Else, fn)})
+	case *Positioned:
+		return fn(&Positioned{Pos: n.Pos, Node: transform(n.Node, fn)})
+	default:
+		panic(fmt.Sprintf("unhandled node type %T", n))
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimize(t *testing.T) {
+	ident := func(name string) *Ident { return &Ident{Name: name} }
+	ret := func(expr Node) *Return { return &Return{Expr: expr} }
+
+	// isEnabled(c) returns c.enabled && c.name != "", which may be inlined.
+	isEnabled := &Func{
+		Namespace:   "example",
+		Name:        "isEnabled",
+		Params:      []Node{ident("c")},
+		BasicResult: true,
+		Statements: []Node{ret(&BuiltInCall{Func: Literal("and"), Arguments: []Node{
+			&Selector{Expr: ident("c"), Field: "enabled"},
+			&BuiltInCall{Func: Literal("ne"), Arguments: []Node{&Selector{Expr: ident("c"), Field: "name"}, Quoted("")}},
+		}})},
+	}
+
+	// name(c) returns a string but calls an impure builtin.
+	name := &Func{
+		Namespace:   "example",
+		Name:        "name",
+		Params:      []Node{ident("c")},
+		BasicResult: true,
+		Statements:  []Node{ret(&BuiltInCall{Func: Literal("randAlpha"), Arguments: []Node{Literal("5")}})},
+	}
+
+	// countdown(n) is recursive.
+	countdown := &Func{
+		Namespace:   "example",
+		Name:        "countdown",
+		Params:      []Node{ident("n")},
+		BasicResult: true,
+		Statements:  []Node{ret(litCall("example.countdown", &BuiltInCall{Func: Literal("sub"), Arguments: []Node{ident("n"), Literal("1")}}))},
+	}
+
+	for _, tc := range []struct {
+		In  Node
+		Out string
+	}{
+		{
+			In:  &Cast{To: "int", X: Literal("10")},
+			Out: `10`,
+		},
+		{
+			In:  &Cast{To: "float64", X: Literal("-3")},
+			Out: `-3.0`,
+		},
+		{
+			In:  &Cast{To: "int", X: ident("x")},
+			Out: `($x | int)`,
+		},
+		{
+			In:  &ParenExpr{Expr: &ParenExpr{Expr: Literal("true")}},
+			Out: `true`,
+		},
+		{
+			In:  &BuiltInCall{Func: Literal("not"), Arguments: []Node{&ParenExpr{Expr: Literal("false")}}},
+			Out: `true`,
+		},
+		{
+			In:  litCall("example.isEnabled", ident("config")),
+			Out: `(and $config.enabled (ne $config.name ""))`,
+		},
+		{
+			// Non-trivial arguments are not duplicated.
+			In:  litCall("example.isEnabled", litCall("example.config")),
+			Out: `(get (fromJson (include "example.isEnabled" (dict "a" (list (get (fromJson (include "example.config" (dict "a" (list)))) "r"))))) "r")`,
+		},
+		{
+			In:  litCall("example.name", ident("config")),
+			Out: `(get (fromJson (include "example.name" (dict "a" (list $config)))) "r")`,
+		},
+		{
+			// Recursive functions are only inlined once.
+			In:  litCall("example.countdown", Literal("3")),
+			Out: `(get (fromJson (include "example.countdown" (dict "a" (list (sub 3 1))))) "r")`,
+		},
+	} {
+		// Wrap each case in its own function to exercise [optimize] end to
+		// end.
+		fn := &Func{Namespace: "example", Name: "test", Statements: []Node{&Assignment{LHS: ident("_"), RHS: tc.In}}}
+		files := []*File{{Funcs: []*Func{isEnabled, name, countdown, fn}}}
+
+		optimize(files)
+
+		var buf bytes.Buffer
+		files[0].Funcs[3].Statements[0].(*Assignment).RHS.Write(&buf)
+		require.Equal(t, tc.Out, buf.String())
+	}
+
+	// Tail calls pass the result of include through as is.
+	fn := &Func{Namespace: "example", Name: "test", Statements: []Node{
+		ret(&Cast{To: "int", X: litCall("example.config")}),
+	}}
+	files := []*File{{Funcs: []*Func{fn}}}
+	optimize(files)
+
+	var buf bytes.Buffer
+	files[0].Funcs[0].Statements[0].Write(&buf)
+	require.Equal(t, "{{- $_is_returning = true -}}\n{{- (include \"example.config\" (dict \"a\" (list))) -}}\n{{- break -}}\n", buf.String())
+}
+
+func TestOptimizeBootstrap(t *testing.T) {
+	render := func(opts Options) string {
+		shims, err := bootstrapFor(opts)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		shims.Write(&buf)
+		return buf.String()
+	}
+
+	unoptimized := render(Options{DisableOptimizations: true})
+	optimized := render(Options{})
+
+	// The shims are subject to optimization like any other file, which must
+	// not modify the unoptimized shims.
+	require.NotEqual(t, unoptimized, optimized)
+	require.Equal(t, unoptimized, render(Options{DisableOptimizations: true}))
+}
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/token"
 	"go/types"
 
 	"github.com/cockroachdb/errors"
@@ -25,6 +26,9 @@ type astRewrite func(*packages.Package, *ast.File) (_ *ast.File, changed bool)
 
 var rewrites = []astRewrite{
 	hoistIfs,
+	// NB: rewriteSwitches runs after hoistIfs so that the names it
+	// synthesizes don't clash with those of hoisted variables.
+	rewriteSwitches,
 }
 
 // LoadPackages is a wrapper around [packages.Load] that performs a handful of
@@ -55,6 +59,11 @@ func LoadPackages(cfg *packages.Config, patterns ...string) ([]*packages.Package
 		cfg.Overlay = map[string][]byte{}
 	}
 
+	// rewritten holds the rewritten ASTs, which still hold the positions of
+	// the original source, keyed by filename.
+	rewritten := map[string]*ast.File{}
+	fsets := map[string]*token.FileSet{}
+
 	for _, pkg := range pkgs {
 		var errs []error
 		for i := range pkg.Errors {
@@ -91,6 +100,8 @@ func LoadPackages(cfg *packages.Config, patterns ...string) ([]*packages.Package
 			}
 
 			cfg.Overlay[filename] = buf.Bytes()
+			rewritten[filename] = parsed
+			fsets[filename] = pkg.Fset
 		}
 	}
 
@@ -112,11 +123,62 @@ func LoadPackages(cfg *packages.Config, patterns ...string) ([]*packages.Package
 		if len(errs) > 0 {
 			return nil, errors.Wrapf(errors.Join(errs...), "package %s", pkg.Name)
 		}
+
+		for _, parsed := range pkg.Syntax {
+			filename := pkg.Fset.File(parsed.Pos()).Name()
+			if original, ok := rewritten[filename]; ok {
+				restorePositions(fsets[filename], original, pkg.Fset, parsed)
+			}
+		}
 	}
 
 	return pkgs, nil
 }
 
+// restorePositions maps the adjusted positions (See [token.FileSet.PositionFor])
+// of the statements and functions in a reloaded rewritten file back to
+// their positions within the original source. Any synthesized nodes inherit
+// the position of the node preceding them.
+func restorePositions(originalFset *token.FileSet, original *ast.File, fset *token.FileSet, reloaded *ast.File) {
+	positioned := func(f *ast.File) []ast.Node {
+		var nodes []ast.Node
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n.(type) {
+			case ast.Stmt, *ast.FuncDecl, *ast.FuncLit:
+				nodes = append(nodes, n)
+			}
+			return true
+		})
+		return nodes
+	}
+
+	originalNodes := positioned(original)
+	reloadedNodes := positioned(reloaded)
+
+	// Printing and re-parsing an AST should preserve its structure. If it
+	// somehow hasn't, leave the positions of the rewritten file be.
+	if len(originalNodes) != len(reloadedNodes) {
+		return
+	}
+
+	file := fset.File(reloaded.Pos())
+	for i, n := range reloadedNodes {
+		// Synthesized nodes may be partially made up of the original source,
+		// such as hoisted assignments with renamed variables.
+		start := originalNodes[i].Pos()
+		if !start.IsValid() {
+			start = originalNodes[i].End()
+		}
+
+		if !start.IsValid() || !n.Pos().IsValid() {
+			continue
+		}
+
+		pos := originalFset.PositionFor(start, false)
+		file.AddLineColumnInfo(file.Offset(n.Pos()), pos.Filename, pos.Line, pos.Column)
+	}
+}
+
 // hoistIfs "hoists" all assignments within an if else chain to be above said
 // chain. It munges the variable names to ensure that variable shadowing
 // doesn't become an issues.
@@ -191,3 +253,389 @@ func hoistIfs(pkg *packages.Package, f *ast.File) (*ast.File, bool) {
 		return true
 	}).(*ast.File), count > 0
 }
+
+// rewriteSwitches rewrites switch and type switch statements into if-else
+// chains. Expressions that need to be evaluated exactly once, such as the
+// switch's init statement, tag, or type tests, are placed ahead of the chain.
+//
+//	switch x := f(); x {
+//	case 1, 2:
+//	default:
+//	case 3:
+//	}
+//
+// Will get rewritten to:
+//
+//	{
+//		x := f()
+//		if x == 1 || x == 2 {
+//		} else if x == 3 {
+//		} else {
+//		}
+//	}
+//
+// And type switches:
+//
+//	switch v := x.(type) {
+//	case string:
+//		return v
+//	case nil, bool:
+//	}
+//
+// Will get rewritten to:
+//
+//	{
+//		v_1, ok_2 := x.(string)
+//		_, ok_3 := x.(bool)
+//		if ok_2 {
+//			return v_1
+//		} else if x == nil || ok_3 {
+//		}
+//	}
+//
+// Switches that can't be expressed as an if-else chain (See
+// [unsupportedSwitch]) are left untouched for the transpiler to reject.
+func rewriteSwitches(pkg *packages.Package, f *ast.File) (*ast.File, bool) {
+	info := pkg.TypesInfo
+	changed := false
+
+	taken := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			taken[ident.Name] = true
+		}
+		return true
+	})
+
+	count := 0
+	fresh := func(name string) string {
+		for {
+			count++
+			candidate := fmt.Sprintf("%s_%d", name, count)
+			if !taken[candidate] {
+				taken[candidate] = true
+				return candidate
+			}
+		}
+	}
+
+	// Switches are rewritten on the way out so nested switches have already
+	// been rewritten by the time their parent is.
+	return astutil.Apply(f, nil, func(c *astutil.Cursor) bool {
+		// Labeled statements aren't supported by the transpiler.
+		if _, ok := c.Parent().(*ast.LabeledStmt); ok {
+			return true
+		}
+
+		var body *ast.BlockStmt
+		var stmts []ast.Stmt
+		switch node := c.Node().(type) {
+		case *ast.SwitchStmt:
+			if unsupportedSwitch(info, node) != "" {
+				return true
+			}
+			body = node.Body
+			stmts = lowerSwitch(node, fresh)
+
+		case *ast.TypeSwitchStmt:
+			if unsupportedSwitch(info, node) != "" {
+				return true
+			}
+			body = node.Body
+			stmts = lowerTypeSwitch(info, node, fresh)
+
+		default:
+			return true
+		}
+
+		changed = true
+
+		if len(stmts) == 1 {
+			c.Replace(stmts[0])
+		} else {
+			c.Replace(&ast.BlockStmt{Lbrace: c.Node().Pos(), List: stmts, Rbrace: body.Rbrace})
+		}
+
+		return true
+	}).(*ast.File), changed
+}
+
+// unsupportedSwitch returns the reason for which the given switch or type
+// switch statement can't be rewritten into an if-else chain or an empty string
+// if it can be.
+func unsupportedSwitch(info *types.Info, stmt ast.Stmt) string {
+	var body *ast.BlockStmt
+	switch stmt := stmt.(type) {
+	case *ast.SwitchStmt:
+		body = stmt.Body
+	case *ast.TypeSwitchStmt:
+		body = stmt.Body
+	}
+
+	for _, s := range body.List {
+		clause := s.(*ast.CaseClause)
+
+		if len(clause.Body) > 0 {
+			if branch, ok := clause.Body[len(clause.Body)-1].(*ast.BranchStmt); ok && branch.Tok == token.FALLTHROUGH {
+				return "`fallthrough` is not supported. Instead, combine the cases into a single multi-value case"
+			}
+		}
+
+		if breaksSwitch(clause.Body) {
+			return "`break` within a switch statement is not supported. Instead, use an if statement or early return"
+		}
+
+		if _, ok := stmt.(*ast.TypeSwitchStmt); !ok {
+			continue
+		}
+
+		for _, expr := range clause.List {
+			if basic, ok := info.TypeOf(expr).Underlying().(*types.Basic); ok && (basic.Info()&types.IsNumeric != 0) {
+				return "type switches on numeric types are unreliable due to JSON casting all numbers to float64's. Instead use `helmette.IsNumeric` or `helmette.AsIntegral`"
+			}
+		}
+	}
+
+	return ""
+}
+
+// breaksSwitch reports whether any of the given statements, which make up
+// the body of a case clause, contain an unlabeled break that targets the
+// switch statement.
+func breaksSwitch(body []ast.Stmt) bool {
+	found := false
+	for _, stmt := range body {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt, *ast.FuncLit:
+				// Breaks within these nodes target them, not our switch.
+				return false
+			case *ast.BranchStmt:
+				found = found || (n.Tok == token.BREAK && n.Label == nil)
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// NB: Nodes synthesized by the below functions are positioned alongside the
+// nodes they're derived from. Otherwise, [format.Node] would misplace comments
+// and sprinkle line breaks throughout the rewritten code.
+
+// lowerSwitch returns the statements equivalent to an expression switch. See
+// [rewriteSwitches].
+func lowerSwitch(node *ast.SwitchStmt, fresh func(string) string) []ast.Stmt {
+	var stmts []ast.Stmt
+	if node.Init != nil {
+		stmts = append(stmts, node.Init)
+	}
+
+	// Non-identifier tags are assigned to a variable to ensure that they're
+	// evaluated exactly once.
+	var tag string
+	switch expr := node.Tag.(type) {
+	case nil:
+	case *ast.Ident:
+		tag = expr.Name
+	default:
+		tag = fresh("tag")
+		stmts = append(stmts, define(tag, expr))
+	}
+
+	chain := &ifElseChain{pos: node.Pos(), rbrace: node.Body.Rbrace}
+	for _, s := range node.Body.List {
+		clause := s.(*ast.CaseClause)
+
+		var cond ast.Expr
+		for _, expr := range clause.List {
+			if tag != "" {
+				if _, ok := expr.(*ast.BinaryExpr); ok {
+					expr = &ast.ParenExpr{Lparen: expr.Pos(), X: expr, Rparen: expr.End()}
+				}
+				expr = &ast.BinaryExpr{X: ident(tag, expr.Pos()), OpPos: expr.Pos(), Op: token.EQL, Y: expr}
+			}
+			cond = or(cond, expr)
+		}
+
+		chain.add(clause, cond, clause.Body)
+	}
+
+	return append(stmts, chain.stmts()...)
+}
+
+// lowerTypeSwitch returns the statements equivalent to a type switch. See
+// [rewriteSwitches].
+func lowerTypeSwitch(info *types.Info, node *ast.TypeSwitchStmt, fresh func(string) string) []ast.Stmt {
+	var stmts []ast.Stmt
+	if node.Init != nil {
+		stmts = append(stmts, node.Init)
+	}
+
+	var binding string
+	var assert *ast.TypeAssertExpr
+	switch assign := node.Assign.(type) {
+	case *ast.ExprStmt:
+		assert = assign.X.(*ast.TypeAssertExpr)
+	case *ast.AssignStmt:
+		binding = assign.Lhs[0].(*ast.Ident).Name
+		assert = assign.Rhs[0].(*ast.TypeAssertExpr)
+	}
+
+	// Like tags, non-identifier subjects must only be evaluated once.
+	var subject string
+	if ident, ok := assert.X.(*ast.Ident); ok {
+		subject = ident.Name
+	} else {
+		subject = fresh("tag")
+		stmts = append(stmts, define(subject, assert.X))
+	}
+
+	// Type tests are hoisted above the chain, just like [hoistIfs] would.
+	typetest := func(value string, typ ast.Expr) ast.Expr {
+		ok := fresh("ok")
+		stmts = append(stmts, &ast.AssignStmt{
+			Lhs:    []ast.Expr{ident(value, assert.Pos()), ident(ok, assert.Pos())},
+			TokPos: assert.Pos(),
+			Tok:    token.DEFINE,
+			Rhs:    []ast.Expr{&ast.TypeAssertExpr{X: ident(subject, assert.Pos()), Lparen: assert.Pos(), Type: typ, Rparen: assert.Pos()}},
+		})
+		return ident(ok, typ.Pos())
+	}
+
+	chain := &ifElseChain{pos: node.Pos(), rbrace: node.Body.Rbrace}
+	for _, s := range node.Body.List {
+		clause := s.(*ast.CaseClause)
+
+		// The variable declared by the switch, if any, has a distinct object
+		// within each clause. It's typed as the case's type when there's
+		// exactly one of them or as the subject otherwise.
+		var obj types.Object
+		if binding != "" && usesObject(info, clause.Body, info.Implicits[clause]) {
+			obj = info.Implicits[clause]
+		}
+
+		if len(clause.List) == 1 && !isNil(info, clause.List[0]) {
+			value := "_"
+			if obj != nil {
+				value = fresh(binding)
+				renameObject(info, clause.Body, obj, value)
+			}
+
+			chain.add(clause, typetest(value, clause.List[0]), clause.Body)
+			continue
+		}
+
+		body := clause.Body
+		if obj != nil {
+			body = append([]ast.Stmt{define(binding, ident(subject, clause.Colon))}, body...)
+		}
+
+		var cond ast.Expr
+		for _, typ := range clause.List {
+			if isNil(info, typ) {
+				cond = or(cond, &ast.BinaryExpr{X: ident(subject, typ.Pos()), OpPos: typ.Pos(), Op: token.EQL, Y: typ})
+			} else {
+				cond = or(cond, typetest("_", typ))
+			}
+		}
+
+		chain.add(clause, cond, body)
+	}
+
+	return append(stmts, chain.stmts()...)
+}
+
+// ifElseChain accumulates the clauses of a switch statement into an if-else
+// chain.
+type ifElseChain struct {
+	pos      token.Pos
+	rbrace   token.Pos
+	ifs      []*ast.IfStmt
+	fallback *ast.BlockStmt
+}
+
+// add appends an if statement for the given clause to the chain. A nil
+// condition denotes the default clause, which always ends the chain.
+func (c *ifElseChain) add(clause *ast.CaseClause, cond ast.Expr, body []ast.Stmt) {
+	block := &ast.BlockStmt{Lbrace: clause.Colon, List: body, Rbrace: clause.End()}
+
+	if cond == nil {
+		c.fallback = block
+		return
+	}
+
+	c.ifs = append(c.ifs, &ast.IfStmt{If: clause.Case, Cond: cond, Body: block})
+}
+
+func (c *ifElseChain) stmts() []ast.Stmt {
+	// A lone default clause doesn't need to be wrapped in a block.
+	if len(c.ifs) == 0 {
+		if c.fallback == nil {
+			return nil
+		}
+		return c.fallback.List
+	}
+
+	for i, stmt := range c.ifs {
+		if i+1 < len(c.ifs) {
+			stmt.Else = c.ifs[i+1]
+		} else if c.fallback != nil {
+			stmt.Else = c.fallback
+		}
+	}
+
+	// The chain takes the place of the switch statement.
+	c.ifs[0].If = c.pos
+	if c.fallback != nil {
+		c.fallback.Rbrace = c.rbrace
+	} else {
+		c.ifs[len(c.ifs)-1].Body.Rbrace = c.rbrace
+	}
+
+	return []ast.Stmt{c.ifs[0]}
+}
+
+func ident(name string, pos token.Pos) *ast.Ident {
+	return &ast.Ident{Name: name, NamePos: pos}
+}
+
+func define(lhs string, rhs ast.Expr) *ast.AssignStmt {
+	return &ast.AssignStmt{Lhs: []ast.Expr{ident(lhs, rhs.Pos())}, TokPos: rhs.Pos(), Tok: token.DEFINE, Rhs: []ast.Expr{rhs}}
+}
+
+func or(x, y ast.Expr) ast.Expr {
+	if x == nil {
+		return y
+	}
+	return &ast.BinaryExpr{X: x, OpPos: y.Pos(), Op: token.LOR, Y: y}
+}
+
+func isNil(info *types.Info, expr ast.Expr) bool {
+	basic, ok := info.TypeOf(expr).(*types.Basic)
+	return ok && basic.Kind() == types.UntypedNil
+}
+
+func usesObject(info *types.Info, body []ast.Stmt, obj types.Object) bool {
+	found := false
+	for _, stmt := range body {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && info.Uses[ident] == obj {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+func renameObject(info *types.Info, body []ast.Stmt, obj types.Object, to string) {
+	for i := range body {
+		body[i] = astutil.Apply(body[i], nil, func(c *astutil.Cursor) bool {
+			if node, ok := c.Node().(*ast.Ident); ok && info.Uses[node] == obj {
+				c.Replace(ident(to, node.Pos()))
+			}
+			return true
+		}).(ast.Stmt)
+	}
+}
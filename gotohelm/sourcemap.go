@@ -0,0 +1,107 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"path/filepath"
+
+	"github.com/redpanda-data/redpanda-operator/pkg/helm"
+)
+
+// Positioned annotates a transpiled statement with the position of the go
+// statement it was transpiled from. It's otherwise written as is.
+type Positioned struct {
+	Pos  token.Position
+	Node Node
+}
+
+func (p *Positioned) Write(w io.Writer) {
+	sw, ok := w.(*sourceWriter)
+	if !ok {
+		p.Node.Write(w)
+		return
+	}
+
+	sw.push(p.Pos)
+	defer sw.pop()
+
+	if sw.annotate {
+		fmt.Fprintf(w, "{{- /* %s:%d */ -}}", filepath.Base(p.Pos.Filename), p.Pos.Line)
+	}
+
+	p.Node.Write(w)
+}
+
+// sourceWriter is an [io.Writer] that tracks the go source of every line
+// written to it. Each line is attributed to the innermost [Positioned] node or
+// [Func] that started writing to it.
+type sourceWriter struct {
+	io.Writer
+	// annotate, if true, prefixes every [Positioned] node with a comment of
+	// its go source.
+	annotate bool
+	// fn is the name of the function being written.
+	fn string
+
+	line     int
+	stack    []token.Position
+	mappings []helm.SourceMapping
+}
+
+func newSourceWriter(w io.Writer, annotate bool) *sourceWriter {
+	return &sourceWriter{Writer: w, annotate: annotate, line: 1}
+}
+
+func (w *sourceWriter) push(pos token.Position) {
+	w.stack = append(w.stack, pos)
+
+	// Nested nodes may begin on a line that's already been attributed to
+	// their parent, such as else if chains. Prefer the innermost node.
+	if n := len(w.mappings); n > 0 && w.mappings[n-1].Line == w.line {
+		w.mappings = w.mappings[:n-1]
+	}
+	w.mapLine()
+}
+
+func (w *sourceWriter) pop() {
+	w.stack = w.stack[:len(w.stack)-1]
+}
+
+// mapLine attributes the current line to the top of the stack, if it hasn't
+// been already.
+func (w *sourceWriter) mapLine() {
+	if len(w.stack) == 0 {
+		return
+	}
+
+	if n := len(w.mappings); n > 0 && w.mappings[n-1].Line == w.line {
+		return
+	}
+
+	w.mappings = append(w.mappings, helm.SourceMapping{
+		Line:       w.line,
+		SourceLine: w.stack[len(w.stack)-1].Line,
+		Function:   w.fn,
+	})
+}
+
+func (w *sourceWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		if b == '\n' {
+			w.line++
+		} else {
+			w.mapLine()
+		}
+	}
+	return w.Writer.Write(p)
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gotohelm
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/redpanda-data/redpanda-operator/pkg/helm"
+)
+
+func TestSourceMap(t *testing.T) {
+	td, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	pkgs, err := LoadPackages(&packages.Config{
+		Dir: filepath.Join(td, "src/example"),
+	}, "./flowcontrol")
+	require.NoError(t, err)
+
+	chart, err := transpile(pkgs[0], Options{})
+	require.NoError(t, err)
+	require.Len(t, chart.Files, 1)
+
+	source, err := os.ReadFile(filepath.Join(td, "src/example/flowcontrol/flowcontrol.go"))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	sourceMap := chart.Files[0].WriteSourceMapped(&out, false)
+	require.Equal(t, "flowcontrol.go", sourceMap.Source)
+
+	// Annotating the templates must not shift any of their lines.
+	var annotated bytes.Buffer
+	require.Equal(t, sourceMap, chart.Files[0].WriteSourceMapped(&annotated, true))
+	require.Contains(t, annotated.String(), `{{- /* flowcontrol.go:`)
+
+	lineOf := func(text, substr string) int {
+		for i, line := range strings.Split(text, "\n") {
+			if strings.Contains(line, substr) {
+				return i + 1
+			}
+		}
+		t.Fatalf("%q not found", substr)
+		return 0
+	}
+
+	sm := &helm.SourceMap{Templates: map[string]helm.TemplateSourceMap{"flowcontrol.yaml": sourceMap}}
+
+	for _, tc := range []struct {
+		Template string
+		Source   string
+		Function string
+	}{
+		// Positions refer to the original source even if the file has been
+		// rewritten by [LoadPackages].
+		// Temporary variables embed the source line, so match on their
+		// suffix to keep this test independent of the testdata's layout.
+		{`_oneToFour_ok := `, `oneToFour, ok := helmette.AsIntegral`, "flowcontrol.ifElse"},
+		{`{{- if (not $ok) -}}`, `if !ok {`, "flowcontrol.ifElse"},
+		{`{{- else -}}{{- if (eq $oneToFour (2 | int)) -}}`, `} else if oneToFour == 2 {`, "flowcontrol.ifElse"},
+		{`{{- define "flowcontrol.earlyReturn" -}}`, `func earlyReturn(`, "flowcontrol.earlyReturn"},
+	} {
+		file, mapping, ok := sm.Lookup("flowcontrol.yaml", lineOf(out.String(), tc.Template))
+		require.True(t, ok, tc.Template)
+		require.Equal(t, "flowcontrol.go", file)
+		require.Equal(t, lineOf(string(source), tc.Source), mapping.SourceLine, tc.Template)
+		require.Equal(t, tc.Function, mapping.Function, tc.Template)
+	}
+}
@@ -11,9 +11,11 @@
 // +gotohelm:namespace=_directives
 package directives
 
-func Directives() bool {
+func Directives() map[string]any {
 	// Calling Noop does nothing but asserts that it's referenced correctly
 	// with the correct namespacing.
 	Noop()
-	return true
+	return map[string]any{
+		"quoteAll": quoteAll([]string{"one", "two three"}),
+	}
 }
@@ -0,0 +1,31 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package directives
+
+import (
+	"fmt"
+)
+
+const quoteAllTemplate = `
+{{- $quoted := (list) -}}
+{{- range $_, $value := $values -}}
+{{- $quoted = (append $quoted (quote $value)) -}}
+{{- end -}}
+{{- (dict "r" $quoted) | toJson -}}
+`
+
+// +gotohelm:template=quoteAllTemplate
+func quoteAll(values []string) []string {
+	var quoted []string
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return quoted
+}
@@ -67,7 +67,7 @@
 {{- $_is_returning := false -}}
 {{- if (eq (toJson $m) "null") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (0 | int)) | toJson -}}
+{{- (dict "r" 0) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -76,6 +76,44 @@
 {{- end -}}
 {{- end -}}
 
+{{- define "_shims.map" -}}
+{{- $s := (index .a 0) -}}
+{{- $fn := (index .a 1) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $out := (list) -}}
+{{- range $_, $el := $s -}}
+{{- $out = (concat (default (list) $out) (list (get (fromJson (include (dig "fn" $fn (ternary $fn (dict) (kindIs "map" $fn))) (dict "a" (list $el) "c" (dig "c" (list) (ternary $fn (dict) (kindIs "map" $fn)))))) "r"))) -}}
+{{- end -}}
+{{- if $_is_returning -}}
+{{- break -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" $out) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "_shims.filter" -}}
+{{- $s := (index .a 0) -}}
+{{- $fn := (index .a 1) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $out := (list) -}}
+{{- range $_, $el := $s -}}
+{{- if (get (fromJson (include (dig "fn" $fn (ternary $fn (dict) (kindIs "map" $fn))) (dict "a" (list $el) "c" (dig "c" (list) (ternary $fn (dict) (kindIs "map" $fn)))))) "r") -}}
+{{- $out = (concat (default (list) $out) (list $el)) -}}
+{{- end -}}
+{{- end -}}
+{{- if $_is_returning -}}
+{{- break -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" $out) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "_shims.ptr_Deref" -}}
 {{- $ptr := (index .a 0) -}}
 {{- $def := (index .a 1) -}}
@@ -148,7 +186,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $result := (fromYaml $in) -}}
-{{- if (and (hasKey $result "Error") (eq (len $result) (1 | int))) -}}
+{{- if (and (hasKey $result "Error") (eq (len $result) 1)) -}}
 {{- $_ := (fail (printf "fromYaml: unmarshalling failed: %s" (index $result "Error"))) -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -177,7 +215,7 @@
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (list (0 | int) false)) | toJson -}}
+{{- (dict "r" (list 0 false)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -197,7 +235,7 @@
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (list (0 | int) false)) | toJson -}}
+{{- (dict "r" (list 0 false)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -219,10 +257,10 @@
 {{- end -}}
 {{- $reprStr := (toString $repr) -}}
 {{- $unit := (regexFind "(k|m|M|G|T|P|Ki|Mi|Gi|Ti|Pi)$" $repr) -}}
-{{- $numeric := (float64 (substr (0 | int) ((sub ((get (fromJson (include "_shims.len" (dict "a" (list $reprStr)))) "r") | int) ((get (fromJson (include "_shims.len" (dict "a" (list $unit)))) "r") | int)) | int) $reprStr)) -}}
-{{- $_196_scale_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list (dict "" 1.0 "m" 0.001 "k" (1000 | int) "M" (1000000 | int) "G" (1000000000 | int) "T" (1000000000000 | int) "P" (1000000000000000 | int) "Ki" (1024 | int) "Mi" (1048576 | int) "Gi" (1073741824 | int) "Ti" (1099511627776 | int) "Pi" (1125899906842624 | int)) $unit (float64 0))))) "r") -}}
-{{- $scale := ((index $_196_scale_ok 0) | float64) -}}
-{{- $ok := (index $_196_scale_ok 1) -}}
+{{- $numeric := (float64 (substr 0 ((sub ((get (fromJson (include "_shims.len" (dict "a" (list $reprStr)))) "r") | int) ((get (fromJson (include "_shims.len" (dict "a" (list $unit)))) "r") | int)) | int) $reprStr)) -}}
+{{- $_217_scale_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list (dict "" 1.0 "m" 0.001 "k" 1000 "M" 1000000 "G" 1000000000 "T" 1000000000000 "P" 1000000000000000 "Ki" 1024 "Mi" 1048576 "Gi" 1073741824 "Ti" 1099511627776 "Pi" 1125899906842624) $unit (float64 0))))) "r") -}}
+{{- $scale := ((index $_217_scale_ok 0) | float64) -}}
+{{- $ok := (index $_217_scale_ok 1) -}}
 {{- if (not $ok) -}}
 {{- $_ := (fail (printf "unknown unit: %q" $unit)) -}}
 {{- end -}}
@@ -236,11 +274,11 @@
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_219_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
-{{- $numeric := ((index $_219_numeric_scale 0) | float64) -}}
-{{- $scale := ((index $_219_numeric_scale 1) | float64) -}}
+{{- $_240_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
+{{- $numeric := ((index $_240_numeric_scale 0) | float64) -}}
+{{- $scale := ((index $_240_numeric_scale 1) | float64) -}}
 {{- $strs := (list "" "m" "k" "M" "G" "T" "P" "Ki" "Mi" "Gi" "Ti" "Pi") -}}
-{{- $scales := (list 1.0 0.001 (1000 | int) (1000000 | int) (1000000000 | int) (1000000000000 | int) (1000000000000000 | int) (1024 | int) (1048576 | int) (1073741824 | int) (1099511627776 | int) (1125899906842624 | int)) -}}
+{{- $scales := (list 1.0 0.001 1000 1000000 1000000000 1000000000000 1000000000000000 1024 1048576 1073741824 1099511627776 1125899906842624) -}}
 {{- $idx := -1 -}}
 {{- range $i, $s := $scales -}}
 {{- if (eq ($s | float64) ($scale | float64)) -}}
@@ -264,9 +302,9 @@
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_246_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
-{{- $numeric := ((index $_246_numeric_scale 0) | float64) -}}
-{{- $scale := ((index $_246_numeric_scale 1) | float64) -}}
+{{- $_267_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
+{{- $numeric := ((index $_267_numeric_scale 0) | float64) -}}
+{{- $scale := ((index $_267_numeric_scale 1) | float64) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (int64 (ceil ((mulf $numeric $scale) | float64)))) | toJson -}}
 {{- break -}}
@@ -277,9 +315,9 @@
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $_251_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
-{{- $numeric := ((index $_251_numeric_scale 0) | float64) -}}
-{{- $scale := ((index $_251_numeric_scale 1) | float64) -}}
+{{- $_272_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
+{{- $numeric := ((index $_272_numeric_scale 0) | float64) -}}
+{{- $scale := ((index $_272_numeric_scale 1) | float64) -}}
 {{- $_is_returning = true -}}
 {{- (dict "r" (int64 (ceil ((mulf ((mulf $numeric 1000.0) | float64) $scale) | float64)))) | toJson -}}
 {{- break -}}
@@ -301,7 +339,7 @@
 {{- (dict "r" (0 | int64)) | toJson -}}
 {{- break -}}
 {{- end -}}
-{{- range $_, $_ := (list (0 | int) (0 | int) (0 | int)) -}}
+{{- range $_, $_ := (list 0 0 0) -}}
 {{- if (eq $repr "") -}}
 {{- break -}}
 {{- end -}}
@@ -315,7 +353,7 @@
 {{- $_ := (fail (printf "invalid Duration: %q" $original)) -}}
 {{- end -}}
 {{- $repr = (substr ((get (fromJson (include "_shims.len" (dict "a" (list $unit)))) "r") | int) -1 $repr) -}}
-{{- $value = ((add $value (((mul (int64 $n) (ternary (index $unitMap $unit) 0 (hasKey $unitMap $unit))) | int64))) | int64) -}}
+{{- $value = ((add $value ((mul (int64 $n) (ternary (index $unitMap $unit) 0 (hasKey $unitMap $unit))) | int64)) | int64) -}}
 {{- end -}}
 {{- if $_is_returning -}}
 {{- break -}}
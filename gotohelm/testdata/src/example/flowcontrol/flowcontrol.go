@@ -21,6 +21,9 @@ func FlowControl(dot *helmette.Dot) map[string]any {
 		"sliceRanges":    sliceRanges(dot),
 		"mapRanges":      mapRanges(dot),
 		"intBinaryExprs": intBinaryExprs(),
+		"switches":       switches(dot),
+		"typeSwitches":   typeSwitches(dot),
+		"loops":          loops(dot),
 	}
 }
 
@@ -118,3 +121,151 @@ func intBinaryExprs() []int {
 		x * y,
 	}
 }
+
+func switches(dot *helmette.Dot) []any {
+	oneToFour, _ := helmette.AsIntegral[int](dot.Values["oneToFour"])
+	ints := dot.Values["ints"].([]any)
+
+	var tagged string
+	switch oneToFour {
+	case 1, 2:
+		tagged = "one or two"
+	default:
+		tagged = "four"
+	case 3:
+		tagged = "three"
+	}
+
+	var tagless string
+	switch half := oneToFour / 2; {
+	case half == 0:
+		tagless = "less than two"
+	case half == 1 && oneToFour == 2, oneToFour == 3:
+		tagless = "two or three"
+	}
+
+	// Tags that aren't identifiers are only evaluated once.
+	var length string
+	switch len(ints) {
+	case 0:
+		length = "empty"
+	case 1 + 2:
+		length = "three"
+	}
+
+	var booleans string
+	switch dot.Values["boolean"].(bool) {
+	case oneToFour > 2:
+		booleans = "matches"
+	}
+
+	nested := 0
+	for _, i := range ints {
+		switch {
+		case oneToFour%2 == 0:
+			switch i {
+			case 1.0:
+				nested = nested + 1
+			default:
+				nested = nested + 10
+			}
+		default:
+			nested = nested + 100
+		}
+	}
+
+	return []any{
+		tagged,
+		tagless,
+		length,
+		booleans,
+		nested,
+		switchReturn(oneToFour),
+	}
+}
+
+func switchReturn(x int) string {
+	switch x {
+	case 1:
+		return "returned one"
+	default:
+		return "returned something else"
+	}
+}
+
+func typeSwitches(dot *helmette.Dot) []any {
+	var results []any
+	for _, key := range []string{"ints", "boolean", "oneToFour", "missing"} {
+		switch v := dot.Values[key].(type) {
+		case bool:
+			results = append(results, !v)
+		case []any:
+			results = append(results, len(v))
+		case nil, string:
+			results = append(results, v)
+		default:
+			results = append(results, "something else")
+		}
+	}
+
+	// Type switches without a binding.
+	switch dot.Values["ints"].(type) {
+	case map[string]any:
+		results = append(results, "map")
+	case []any:
+		results = append(results, "slice")
+	}
+
+	return results
+}
+
+func loops(dot *helmette.Dot) []any {
+	oneToFour, _ := helmette.AsIntegral[int](dot.Values["oneToFour"])
+
+	var results []any
+
+	// Conditional loops.
+	n := 1
+	for n < 100 {
+		n = n * (oneToFour + 1)
+	}
+	results = append(results, n)
+
+	// Infinite loops that break.
+	i := 0
+	for {
+		i++
+		if i >= oneToFour {
+			break
+		}
+	}
+	results = append(results, i)
+
+	// Loops with arbitrary post statements.
+	var powers []int
+	for p := 1; p < 1000; p *= 10 {
+		powers = append(powers, p*oneToFour)
+	}
+	results = append(results, powers)
+
+	// Continue doesn't skip the post statement.
+	var odds []int
+	for j := 0; j < 2*oneToFour; j++ {
+		if j%2 == 0 {
+			continue
+		}
+		odds = append(odds, j)
+	}
+	results = append(results, odds)
+
+	// Early returns from within loops.
+	k := oneToFour
+	for k > 0 {
+		if k == 2 {
+			return append(results, "returned")
+		}
+		k--
+	}
+
+	return results
+}
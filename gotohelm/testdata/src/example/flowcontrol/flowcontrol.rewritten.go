@@ -22,6 +22,9 @@ func FlowControl(dot *helmette.Dot) map[string]any {
 		"sliceRanges":    sliceRanges(dot),
 		"mapRanges":      mapRanges(dot),
 		"intBinaryExprs": intBinaryExprs(),
+		"switches":       switches(dot),
+		"typeSwitches":   typeSwitches(dot),
+		"loops":          loops(dot),
 	}
 }
 
@@ -121,3 +124,164 @@ func intBinaryExprs() []int {
 		x * y,
 	}
 }
+
+func switches(dot *helmette.Dot) []any {
+	oneToFour, _ := helmette.AsIntegral[int](dot.Values["oneToFour"])
+	ints := dot.Values["ints"].([]any)
+
+	var tagged string
+	if oneToFour == 1 || oneToFour == 2 {
+		tagged = "one or two"
+	} else if oneToFour == 3 {
+		tagged = "three"
+	} else {
+		tagged = "four"
+
+	}
+
+	var tagless string
+	{
+		half := oneToFour / 2
+		if half == 0 {
+			tagless = "less than two"
+		} else if half == 1 && oneToFour == 2 || oneToFour == 3 {
+			tagless = "two or three"
+		}
+	}
+
+	// Tags that aren't identifiers are only evaluated once.
+	var length string
+	{
+		tag_1 := len(ints)
+		if tag_1 == 0 {
+			length = "empty"
+		} else if tag_1 == (1 + 2) {
+			length = "three"
+		}
+	}
+
+	var booleans string
+	{
+		tag_2 := dot.Values["boolean"].(bool)
+		if tag_2 == (oneToFour > 2) {
+			booleans = "matches"
+		}
+	}
+
+	nested := 0
+	for _, i := range ints {
+		if oneToFour%2 == 0 {
+			if i == 1.0 {
+				nested = nested + 1
+			} else {
+				nested = nested + 10
+			}
+		} else {
+			nested = nested + 100
+		}
+	}
+
+	return []any{
+		tagged,
+		tagless,
+		length,
+		booleans,
+		nested,
+		switchReturn(oneToFour),
+	}
+}
+
+func switchReturn(x int) string {
+	if x == 1 {
+		return "returned one"
+	} else {
+		return "returned something else"
+	}
+}
+
+func typeSwitches(dot *helmette.Dot) []any {
+	var results []any
+	for _, key := range []string{"ints", "boolean", "oneToFour", "missing"} {
+		{
+			tag_3 := dot.Values[key]
+			v_4, ok_5 := tag_3.(bool)
+			v_6, ok_7 := tag_3.([]any)
+			_, ok_8 := tag_3.(string)
+			if ok_5 {
+				results = append(results, !v_4)
+			} else if ok_7 {
+				results = append(results, len(v_6))
+			} else if tag_3 == nil || ok_8 {
+				v := tag_3
+				results = append(results, v)
+			} else {
+				results = append(results, "something else")
+			}
+		}
+	}
+
+	// Type switches without a binding.
+	{
+		tag_9 := dot.Values["ints"]
+		_, ok_10 := tag_9.(map[string]any)
+		_, ok_11 := tag_9.([]any)
+		if ok_10 {
+			results = append(results, "map")
+		} else if ok_11 {
+			results = append(results, "slice")
+		}
+	}
+
+	return results
+}
+
+func loops(dot *helmette.Dot) []any {
+	oneToFour, _ := helmette.AsIntegral[int](dot.Values["oneToFour"])
+
+	var results []any
+
+	// Conditional loops.
+	n := 1
+	for n < 100 {
+		n = n * (oneToFour + 1)
+	}
+	results = append(results, n)
+
+	// Infinite loops that break.
+	i := 0
+	for {
+		i++
+		if i >= oneToFour {
+			break
+		}
+	}
+	results = append(results, i)
+
+	// Loops with arbitrary post statements.
+	var powers []int
+	for p := 1; p < 1000; p *= 10 {
+		powers = append(powers, p*oneToFour)
+	}
+	results = append(results, powers)
+
+	// Continue doesn't skip the post statement.
+	var odds []int
+	for j := 0; j < 2*oneToFour; j++ {
+		if j%2 == 0 {
+			continue
+		}
+		odds = append(odds, j)
+	}
+	results = append(results, odds)
+
+	// Early returns from within loops.
+	k := oneToFour
+	for k > 0 {
+		if k == 2 {
+			return append(results, "returned")
+		}
+		k--
+	}
+
+	return results
+}
@@ -1,22 +1,17 @@
 {{- /* Generated from "bootstrap.go" */ -}}
 
-{{- define "_shims.isIntLikeFloat" -}}
-{{- $value := (index .a 0) -}}
-{{- range $_ := (list 1) -}}
-{{- (dict "r" (and (typeIs "float64" $value) (eq (((subf (float64 $value) (floor $value)) | float64)) (0.0 | float64)))) | toJson -}}
-{{- break -}}
-{{- end -}}
-{{- end -}}
-
 {{- define "_shims.typetest" -}}
 {{- $typ := (index .a 0) -}}
 {{- $value := (index .a 1) -}}
 {{- $zero := (index .a 2) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- if (typeIs $typ $value) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $value true)) | toJson -}}
 {{- break -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $zero false)) | toJson -}}
 {{- break -}}
 {{- end -}}
@@ -26,9 +21,11 @@
 {{- $typ := (index .a 0) -}}
 {{- $value := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- if (not (typeIs $typ $value)) -}}
 {{- $_ := (fail (printf "expected type of %q got: %T" $typ $value)) -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" $value) | toJson -}}
 {{- break -}}
 {{- end -}}
@@ -39,58 +36,95 @@
 {{- $key := (index .a 1) -}}
 {{- $zero := (index .a 2) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- if (hasKey $m $key) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list (index $m $key) true)) | toJson -}}
 {{- break -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $zero false)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
-{{- define "_shims.compact" -}}
-{{- $args := (index .a 0) -}}
-{{- range $_ := (list 1) -}}
-{{- $out := (dict ) -}}
-{{- range $i, $e := $args -}}
-{{- $_ := (set $out (printf "T%d" ((add (1 | int) $i) | int)) $e) -}}
-{{- end -}}
-{{- (dict "r" $out) | toJson -}}
-{{- break -}}
-{{- end -}}
-{{- end -}}
-
 {{- define "_shims.deref" -}}
 {{- $ptr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
-{{- if (eq $ptr (coalesce nil)) -}}
+{{- $_is_returning := false -}}
+{{- if (eq (toJson $ptr) "null") -}}
 {{- $_ := (fail "nil dereference") -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" $ptr) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
-{{- define "_shims._len" -}}
+{{- define "_shims.len" -}}
 {{- $m := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
-{{- if (eq $m (coalesce nil)) -}}
-{{- (dict "r" (0 | int)) | toJson -}}
+{{- $_is_returning := false -}}
+{{- if (eq (toJson $m) "null") -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" 0) | toJson -}}
 {{- break -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (len $m)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
+{{- define "_shims.map" -}}
+{{- $s := (index .a 0) -}}
+{{- $fn := (index .a 1) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $out := (list) -}}
+{{- range $_, $el := $s -}}
+{{- $out = (concat (default (list) $out) (list (get (fromJson (include (dig "fn" $fn (ternary $fn (dict) (kindIs "map" $fn))) (dict "a" (list $el) "c" (dig "c" (list) (ternary $fn (dict) (kindIs "map" $fn)))))) "r"))) -}}
+{{- end -}}
+{{- if $_is_returning -}}
+{{- break -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" $out) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "_shims.filter" -}}
+{{- $s := (index .a 0) -}}
+{{- $fn := (index .a 1) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $out := (list) -}}
+{{- range $_, $el := $s -}}
+{{- if (get (fromJson (include (dig "fn" $fn (ternary $fn (dict) (kindIs "map" $fn))) (dict "a" (list $el) "c" (dig "c" (list) (ternary $fn (dict) (kindIs "map" $fn)))))) "r") -}}
+{{- $out = (concat (default (list) $out) (list $el)) -}}
+{{- end -}}
+{{- end -}}
+{{- if $_is_returning -}}
+{{- break -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" $out) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "_shims.ptr_Deref" -}}
 {{- $ptr := (index .a 0) -}}
 {{- $def := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
-{{- if (ne $ptr (coalesce nil)) -}}
+{{- $_is_returning := false -}}
+{{- if (ne (toJson $ptr) "null") -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" $ptr) | toJson -}}
 {{- break -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" $def) | toJson -}}
 {{- break -}}
 {{- end -}}
@@ -100,47 +134,88 @@
 {{- $a := (index .a 0) -}}
 {{- $b := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
-{{- if (and (eq $a (coalesce nil)) (eq $b (coalesce nil))) -}}
+{{- $_is_returning := false -}}
+{{- if (and (eq (toJson $a) "null") (eq (toJson $b) "null")) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" true) | toJson -}}
 {{- break -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (eq $a $b)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
+{{- define "_shims.get" -}}
+{{- $dict := (index .a 0) -}}
+{{- $key := (index .a 1) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- if (not (hasKey $dict $key)) -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (list (coalesce nil) false)) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (list (get $dict $key) true)) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "_shims.lookup" -}}
 {{- $apiVersion := (index .a 0) -}}
 {{- $kind := (index .a 1) -}}
 {{- $namespace := (index .a 2) -}}
 {{- $name := (index .a 3) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- $result := (lookup $apiVersion $kind $namespace $name) -}}
 {{- if (empty $result) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list (coalesce nil) false)) | toJson -}}
 {{- break -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $result true)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
+{{- define "_shims.fromYaml" -}}
+{{- $in := (index .a 0) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $result := (fromYaml $in) -}}
+{{- if (and (hasKey $result "Error") (eq (len $result) 1)) -}}
+{{- $_ := (fail (printf "fromYaml: unmarshalling failed: %s" (index $result "Error"))) -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" $result) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "_shims.asnumeric" -}}
 {{- $value := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- if (typeIs "float64" $value) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $value true)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (typeIs "int64" $value) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $value true)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (typeIs "int" $value) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $value true)) | toJson -}}
 {{- break -}}
 {{- end -}}
-{{- (dict "r" (list (0 | int) false)) | toJson -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (list 0 false)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -148,15 +223,19 @@
 {{- define "_shims.asintegral" -}}
 {{- $value := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- if (or (typeIs "int64" $value) (typeIs "int" $value)) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $value true)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (and (typeIs "float64" $value) (eq (floor $value) $value)) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $value true)) | toJson -}}
 {{- break -}}
 {{- end -}}
-{{- (dict "r" (list (0 | int) false)) | toJson -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (list 0 false)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -164,58 +243,28 @@
 {{- define "_shims.parseResource" -}}
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
 {{- if (typeIs "float64" $repr) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list (float64 $repr) 1.0)) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- if (not (typeIs "string" $repr)) -}}
-{{- $_ := (fail (printf "invalid Quantity expected string or float64 got: %T" $repr)) -}}
+{{- $_ := (fail (printf "invalid Quantity expected string or float64 got: %T (%v)" $repr $repr)) -}}
 {{- end -}}
 {{- if (not (regexMatch `^[0-9]+(\.[0-9]{0,6})?(k|m|M|G|T|P|Ki|Mi|Gi|Ti|Pi)?$` $repr)) -}}
 {{- $_ := (fail (printf "invalid Quantity: %q" $repr)) -}}
 {{- end -}}
 {{- $reprStr := (toString $repr) -}}
 {{- $unit := (regexFind "(k|m|M|G|T|P|Ki|Mi|Gi|Ti|Pi)$" $repr) -}}
-{{- $numeric := (float64 (substr (0 | int) ((sub ((get (fromJson (include "_shims._len" (dict "a" (list $reprStr) ))) "r") | int) ((get (fromJson (include "_shims._len" (dict "a" (list $unit) ))) "r") | int)) | int) $reprStr)) -}}
-{{- $scale := (float64 0) -}}
-{{- if (eq $unit "") -}}
-{{- $scale = 1.0 -}}
-{{- else -}}{{- if (eq $unit "m") -}}
-{{- $scale = 0.001 -}}
-{{- else -}}{{- if (eq $unit "k") -}}
-{{- $scale = ((1000 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "M") -}}
-{{- $scale = ((1000000 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "G") -}}
-{{- $scale = ((1000000000 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "T") -}}
-{{- $scale = ((1000000000000 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "P") -}}
-{{- $scale = ((1000000000000000 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "Ki") -}}
-{{- $scale = ((1024 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "Mi") -}}
-{{- $scale = ((1048576 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "Gi") -}}
-{{- $scale = ((1073741824 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "Ti") -}}
-{{- $scale = ((1099511627776 | int) | float64) -}}
-{{- else -}}{{- if (eq $unit "Pi") -}}
-{{- $scale = ((1125899906842624 | int) | float64) -}}
-{{- else -}}
+{{- $numeric := (float64 (substr 0 ((sub ((get (fromJson (include "_shims.len" (dict "a" (list $reprStr)))) "r") | int) ((get (fromJson (include "_shims.len" (dict "a" (list $unit)))) "r") | int)) | int) $reprStr)) -}}
+{{- $_217_scale_ok := (get (fromJson (include "_shims.dicttest" (dict "a" (list (dict "" 1.0 "m" 0.001 "k" 1000 "M" 1000000 "G" 1000000000 "T" 1000000000000 "P" 1000000000000000 "Ki" 1024 "Mi" 1048576 "Gi" 1073741824 "Ti" 1099511627776 "Pi" 1125899906842624) $unit (float64 0))))) "r") -}}
+{{- $scale := ((index $_217_scale_ok 0) | float64) -}}
+{{- $ok := (index $_217_scale_ok 1) -}}
+{{- if (not $ok) -}}
 {{- $_ := (fail (printf "unknown unit: %q" $unit)) -}}
 {{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
-{{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (list $numeric $scale)) | toJson -}}
 {{- break -}}
 {{- end -}}
@@ -224,11 +273,12 @@
 {{- define "_shims.resource_MustParse" -}}
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
-{{- $tmp_tuple_1 := (get (fromJson (include "_shims.compact" (dict "a" (list (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr) ))) "r")) ))) "r") -}}
-{{- $scale := ($tmp_tuple_1.T2 | float64) -}}
-{{- $numeric := ($tmp_tuple_1.T1 | float64) -}}
-{{- $scales := (list 1.0 0.001 (1000 | int) (1000000 | int) (1000000000 | int) (1000000000000 | int) (1000000000000000 | int) (1024 | int) (1048576 | int) (1073741824 | int) (1099511627776 | int) (1125899906842624 | int)) -}}
-{{- $strs := (list "" "m" "K" "M" "G" "T" "P" "Ki" "Mi" "Gi" "Ti" "Pi") -}}
+{{- $_is_returning := false -}}
+{{- $_240_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
+{{- $numeric := ((index $_240_numeric_scale 0) | float64) -}}
+{{- $scale := ((index $_240_numeric_scale 1) | float64) -}}
+{{- $strs := (list "" "m" "k" "M" "G" "T" "P" "Ki" "Mi" "Gi" "Ti" "Pi") -}}
+{{- $scales := (list 1.0 0.001 1000 1000000 1000000000 1000000000000 1000000000000000 1024 1048576 1073741824 1099511627776 1125899906842624) -}}
 {{- $idx := -1 -}}
 {{- range $i, $s := $scales -}}
 {{- if (eq ($s | float64) ($scale | float64)) -}}
@@ -236,9 +286,13 @@
 {{- break -}}
 {{- end -}}
 {{- end -}}
+{{- if $_is_returning -}}
+{{- break -}}
+{{- end -}}
 {{- if (eq $idx -1) -}}
 {{- $_ := (fail (printf "unknown scale: %v" $scale)) -}}
 {{- end -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (printf "%s%s" (toString $numeric) (index $strs $idx))) | toJson -}}
 {{- break -}}
 {{- end -}}
@@ -247,25 +301,90 @@
 {{- define "_shims.resource_Value" -}}
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
-{{- $tmp_tuple_2 := (get (fromJson (include "_shims.compact" (dict "a" (list (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr) ))) "r")) ))) "r") -}}
-{{- $scale := ($tmp_tuple_2.T2 | float64) -}}
-{{- $numeric := ($tmp_tuple_2.T1 | float64) -}}
+{{- $_is_returning := false -}}
+{{- $_267_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
+{{- $numeric := ((index $_267_numeric_scale 0) | float64) -}}
+{{- $scale := ((index $_267_numeric_scale 1) | float64) -}}
+{{- $_is_returning = true -}}
 {{- (dict "r" (int64 (ceil ((mulf $numeric $scale) | float64)))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
-{{- define "_shims.resource_AsInt64" -}}
+{{- define "_shims.resource_MilliValue" -}}
 {{- $repr := (index .a 0) -}}
 {{- range $_ := (list 1) -}}
-{{- $tmp_tuple_3 := (get (fromJson (include "_shims.compact" (dict "a" (list (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr) ))) "r")) ))) "r") -}}
-{{- $scale := ($tmp_tuple_3.T2 | float64) -}}
-{{- $numeric := ($tmp_tuple_3.T1 | float64) -}}
-{{- $tmp_tuple_4 := (get (fromJson (include "_shims.compact" (dict "a" (list (get (fromJson (include "_shims.asintegral" (dict "a" (list ((mulf $numeric $scale) | float64)) ))) "r")) ))) "r") -}}
-{{- $ok := $tmp_tuple_4.T2 -}}
-{{- $asInt := $tmp_tuple_4.T1 -}}
-{{- (dict "r" (list $asInt $ok)) | toJson -}}
+{{- $_is_returning := false -}}
+{{- $_272_numeric_scale := (get (fromJson (include "_shims.parseResource" (dict "a" (list $repr)))) "r") -}}
+{{- $numeric := ((index $_272_numeric_scale 0) | float64) -}}
+{{- $scale := ((index $_272_numeric_scale 1) | float64) -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (int64 (ceil ((mulf ((mulf $numeric 1000.0) | float64) $scale) | float64)))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
 
+{{- define "_shims.time_ParseDuration" -}}
+{{- $repr := (index .a 0) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $unitMap := (dict "s" ((1000000000 | int64) | int64) "m" ((60000000000 | int64) | int64) "h" ((3600000000000 | int64) | int64)) -}}
+{{- $original := $repr -}}
+{{- $value := ((0 | int64) | int64) -}}
+{{- if (eq $repr "") -}}
+{{- $_ := (fail (printf "invalid Duration: %q" $original)) -}}
+{{- end -}}
+{{- if (eq $repr "0") -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (0 | int64)) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- range $_, $_ := (list 0 0 0) -}}
+{{- if (eq $repr "") -}}
+{{- break -}}
+{{- end -}}
+{{- $n := (regexFind `^\d+` $repr) -}}
+{{- if (eq $n "") -}}
+{{- $_ := (fail (printf "invalid Duration: %q" $original)) -}}
+{{- end -}}
+{{- $repr = (substr ((get (fromJson (include "_shims.len" (dict "a" (list $n)))) "r") | int) -1 $repr) -}}
+{{- $unit := (regexFind `^(h|m|s)` $repr) -}}
+{{- if (eq $unit "") -}}
+{{- $_ := (fail (printf "invalid Duration: %q" $original)) -}}
+{{- end -}}
+{{- $repr = (substr ((get (fromJson (include "_shims.len" (dict "a" (list $unit)))) "r") | int) -1 $repr) -}}
+{{- $value = ((add $value ((mul (int64 $n) (ternary (index $unitMap $unit) 0 (hasKey $unitMap $unit))) | int64)) | int64) -}}
+{{- end -}}
+{{- if $_is_returning -}}
+{{- break -}}
+{{- end -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" $value) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "_shims.time_Duration_String" -}}
+{{- $dur := (index .a 0) -}}
+{{- range $_ := (list 1) -}}
+{{- $_is_returning := false -}}
+{{- $_is_returning = true -}}
+{{- (dict "r" (duration ((div $dur ((1000000000 | int64) | int64)) | int64))) | toJson -}}
+{{- break -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "_shims.render-manifest" -}}
+{{- $tpl := (index . 0) -}}
+{{- $dot := (index . 1) -}}
+{{- $manifests := (get ((include $tpl (dict "a" (list $dot))) | fromJson) "r") -}}
+{{- if not (typeIs "[]interface {}" $manifests) -}}
+{{- $manifests = (list $manifests) -}}
+{{- end -}}
+{{- range $_, $manifest := $manifests -}}
+{{- if ne (toJson $manifest) "null" }}
+---
+{{toYaml (unset (unset $manifest "status") "creationTimestamp")}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
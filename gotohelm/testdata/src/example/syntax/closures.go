@@ -0,0 +1,60 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+//nolint:all
+package syntax
+
+import (
+	"fmt"
+
+	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
+)
+
+func closures() []any {
+	ints := []int{1, 2, 3, 4}
+	prefix := "item"
+	threshold := 2
+
+	// Function literals without captures are equivalent to top level
+	// functions.
+	double := func(i int) int {
+		return i * 2
+	}
+
+	// Captured variables are passed alongside arguments.
+	label := func(i int) string {
+		return fmt.Sprintf("%s-%d", prefix, i)
+	}
+
+	// Nested function literals capture through their parents.
+	adder := func(n int) func(int) int {
+		return func(i int) int {
+			return i + n + threshold
+		}
+	}
+	addTen := adder(10)
+
+	return []any{
+		double(2),
+		label(1),
+		addTen(1),
+		helmette.Map(ints, double),
+		helmette.Map(ints, label),
+		helmette.Filter(ints, func(i int) bool {
+			return i > threshold
+		}),
+		// Closures may be passed to any function accepting a function.
+		sliceOf(3, label),
+		sliceOf(2, adder(threshold)),
+		// Immediately invoked.
+		func() string {
+			return prefix + "!"
+		}(),
+	}
+}
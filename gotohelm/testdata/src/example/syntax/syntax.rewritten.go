@@ -75,6 +75,8 @@ func Syntax() map[string]any {
 		"nested-for-and-return": nestedFor(),
 		"import":                aaacommon.SharedConstant(),
 		"funArgs":               funcArgs(),
+		"closures":              closures(),
+		"declarations":          declarations(),
 	}
 }
 
@@ -320,6 +322,23 @@ func forExpr(iteration int, in Complex) [][]string {
 	return result
 }
 
+func declarations() []any {
+	var a, b = 1, "two"
+	var c, d int
+	var (
+		e    = a + 2
+		f, g = multipleReturns()
+		h    []string
+	)
+	var i, j = fmt.Sprintf("%d", c), e + d
+
+	return []any{a, b, c, d, e, f, g, h, i, j}
+}
+
+func multipleReturns() (int, bool) {
+	return 4, true
+}
+
 func appends() [][]int {
 	var x []int
 	y := []int{1, 2, 3}
@@ -335,7 +354,7 @@ func appends() [][]int {
 
 func funcArgs() []any {
 	// Showcase support for first class functions!
-	// NOTE: Closures are NOT supported.
+	// See closures.go for function literals.
 	return []any{
 		sliceOf(5, ident),
 		sliceOf(10, hello),
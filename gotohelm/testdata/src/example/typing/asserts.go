@@ -35,17 +35,16 @@ func typeAssertions(dot *helmette.Dot) string {
 }
 
 func typeSwitching(dot *helmette.Dot) string {
-	return "Not yet supported"
-	// switch dot.Values["t"].(type) {
-	// case int:
-	// 	return "it's an int!"
-	// case string:
-	// 	return "it's a string!"
-	// case float64:
-	// 	return "it's a float64!"
-	// case bool:
-	// 	return "it's a bool!"
-	// default:
-	// 	return "it's something else"
-	// }
+	// Numeric types may not be used in type switches. See typeTesting for
+	// the alternative.
+	switch t := dot.Values["t"].(type) {
+	case string:
+		return "it's a string: " + t
+	case bool:
+		return "it's a bool!"
+	case nil:
+		return "it's nil!"
+	default:
+		return "it's something else"
+	}
 }
@@ -41,17 +41,20 @@ func typeAssertions(dot *helmette.Dot) string {
 }
 
 func typeSwitching(dot *helmette.Dot) string {
-	return "Not yet supported"
-	// switch dot.Values["t"].(type) {
-	// case int:
-	// 	return "it's an int!"
-	// case string:
-	// 	return "it's a string!"
-	// case float64:
-	// 	return "it's a float64!"
-	// case bool:
-	// 	return "it's a bool!"
-	// default:
-	// 	return "it's something else"
-	// }
+	// Numeric types may not be used in type switches. See typeTesting for
+	// the alternative.
+	{
+		tag_1 := dot.Values["t"]
+		t_2, ok_4 := tag_1.(string)
+		_, ok_5 := tag_1.(bool)
+		if ok_4 {
+			return "it's a string: " + t_2
+		} else if ok_5 {
+			return "it's a bool!"
+		} else if tag_1 == nil {
+			return "it's nil!"
+		} else {
+			return "it's something else"
+		}
+	}
 }
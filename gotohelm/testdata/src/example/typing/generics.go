@@ -0,0 +1,145 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+//nolint:all
+package typing
+
+import (
+	"fmt"
+
+	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
+)
+
+type Integer interface {
+	~int | ~int32 | ~int64
+}
+
+type Number interface {
+	Integer | ~float64
+}
+
+func generics(dot *helmette.Dot) []any {
+	t := helmette.Default("unset", fmt.Sprintf("%v", dot.Values["t"]))
+
+	ints := newStack[int]()
+	ints.Push(1)
+	ints.Push(2)
+
+	names := newStack[string]()
+	names.Push("Jack")
+
+	var empty Stack[myint]
+
+	return []any{
+		defaultTo("", t),
+		defaultTo("set", t),
+		defaultTo(0, 3),
+		defaultTo(2, 3),
+		defaultTo(myint(0), getMyInt()),
+		defaultTo(false, true),
+		sum([]int{1, 2, 3}),
+		sum([]myint{getMyInt(), 5}),
+		sum([]int32{}),
+		maxOf(3, 7),
+		maxOf(getMyFloat(), 0.5),
+		maxOf(int64(-1), -2),
+		halve(7),
+		halve(myint(-9)),
+		mean([]float64{1, 2.5, 4}),
+		mean([]myfloat{}),
+		mapOf([]int{1, 2, 3}, func(i int) string { return fmt.Sprintf("%d", i) }),
+		mapOf([]Base{{X: 1}, {X: 2}}, func(b Base) int { return b.X }),
+		orElse(Base{}, Base{X: 4}, func(b Base) bool { return b.X == 0 }),
+		ints.Peek(),
+		ints.Len(),
+		names.Peek(),
+		empty.Peek(),
+		empty.Len(),
+	}
+}
+
+// defaultTo returns fallback if value is the zero value of T.
+func defaultTo[T comparable](value, fallback T) T {
+	var zero T
+	if value == zero {
+		return fallback
+	}
+	return value
+}
+
+// orElse returns fallback if isZero(value) is true.
+func orElse[T any](value, fallback T, isZero func(T) bool) T {
+	if isZero(value) {
+		return fallback
+	}
+	return value
+}
+
+func sum[T Integer](values []T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func maxOf[T Number](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func halve[T Integer](n T) T {
+	return n / 2
+}
+
+func mean[T ~float64](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total / T(len(values))
+}
+
+func mapOf[T, U any](in []T, fn func(T) U) []U {
+	var out []U
+	for _, v := range in {
+		out = append(out, fn(v))
+	}
+	return out
+}
+
+type Stack[T any] struct {
+	Items []T
+}
+
+func newStack[T any]() *Stack[T] {
+	return &Stack[T]{Items: []T{}}
+}
+
+func (s *Stack[T]) Push(item T) {
+	s.Items = append(s.Items, item)
+}
+
+func (s *Stack[T]) Peek() T {
+	if len(s.Items) == 0 {
+		var zero T
+		return zero
+	}
+	return s.Items[len(s.Items)-1]
+}
+
+func (s Stack[T]) Len() int {
+	return len(s.Items)
+}
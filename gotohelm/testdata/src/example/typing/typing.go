@@ -25,6 +25,7 @@ func Typing(dot *helmette.Dot) map[string]any {
 		"typeAssertions":    typeSwitching(dot),
 		"typeSwitching":     typeSwitching(dot),
 		"nestedFieldAccess": nestedFieldAccess(),
+		"generics":          generics(dot),
 	}
 }
 
@@ -54,13 +54,43 @@ type Chart struct {
 	Files []*File
 }
 
+// Options configures [TranspileWithOptions].
+type Options struct {
+	// Dependencies are the go package paths of any dependent charts
+	// (subcharts) whose functions may be called.
+	Dependencies []string
+	// DisableOptimizations skips optimizing the transpiled templates,
+	// including the shims.
+	DisableOptimizations bool
+	// IgnoreTemplates transpiles the go bodies of functions with a
+	// +gotohelm:template directive rather than emitting their templates. It
+	// allows testing that both implementations are equivalent.
+	IgnoreTemplates bool
+	// MaxLoopIterations is the maximum number of iterations of for loops that
+	// aren't simple counted loops, e.g. for cond {} or for {}, after which
+	// the templates fail. Defaults to [DefaultMaxLoopIterations].
+	MaxLoopIterations int
+}
+
+// DefaultMaxLoopIterations is the default value of
+// [Options.MaxLoopIterations].
+const DefaultMaxLoopIterations = 1000
+
 func Transpile(pkg *packages.Package, deps ...string) (*Chart, error) {
-	chart, err := transpile(pkg, deps...)
+	return TranspileWithOptions(pkg, Options{Dependencies: deps})
+}
+
+func TranspileWithOptions(pkg *packages.Package, opts Options) (*Chart, error) {
+	chart, err := transpile(pkg, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	shims, err := transpileBootstrap()
+	if !opts.DisableOptimizations {
+		optimize(chart.Files)
+	}
+
+	shims, err := bootstrapFor(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +104,12 @@ func Transpile(pkg *packages.Package, deps ...string) (*Chart, error) {
 // [Transpile] delegates to this method and injects the shims/bootstrap file
 // before returning the chart. This private method is necessary to prevent any
 // infinite recursion issues.
-func transpile(pkg *packages.Package, deps ...string) (_ *Chart, err error) {
+func transpile(pkg *packages.Package, opts Options) (*Chart, error) {
+	return transpileFiles(pkg, opts, pkg.Syntax)
+}
+
+// transpileFiles transpiles only the given files of pkg. See [Builder].
+func transpileFiles(pkg *packages.Package, opts Options, files []*ast.File) (_ *Chart, err error) {
 	defer func() {
 		switch v := recover().(type) {
 		case nil:
@@ -85,21 +120,28 @@ func transpile(pkg *packages.Package, deps ...string) (_ *Chart, err error) {
 		}
 	}()
 
-	dependencies := map[string]struct{}{}
-	for _, path := range append(deps, pkg.PkgPath) {
-		dependencies[path] = struct{}{}
+	maxLoopIterations := opts.MaxLoopIterations
+	if maxLoopIterations <= 0 {
+		maxLoopIterations = DefaultMaxLoopIterations
 	}
 
+	dependencies := dependenciesOf(pkg, opts)
+	pkgs := mkPkgTree(pkg)
+
 	t := &Transpiler{
 		Package:   pkg,
 		Fset:      pkg.Fset,
 		TypesInfo: pkg.TypesInfo,
-		Files:     pkg.Syntax,
+		Files:     files,
+
+		ignoreTemplates:   opts.IgnoreTemplates,
+		maxLoopIterations: maxLoopIterations,
 
-		packages:     mkPkgTree(pkg),
+		packages:     pkgs,
 		namespaces:   map[*types.Package]string{},
 		dependencies: dependencies,
 		names:        map[*types.Func]string{},
+		zeros:        newZeroUses(pkgs, dependencies),
 		builtins: map[string]string{
 			"fmt.Sprintf":                "printf",
 			"golang.org/x/exp/maps.Keys": "keys",
@@ -136,6 +178,22 @@ type Transpiler struct {
 	// names is a cache for holding the transpiled name of a function.
 	// It's exclusively used by `funcNameFor`.
 	names map[*types.Func]string
+	// ignoreTemplates, if true, ignores +gotohelm:template directives. See
+	// [Options].
+	ignoreTemplates bool
+	// maxLoopIterations is the maximum number of iterations of loops
+	// transpiled by `transpileBoundedLoop`. See [Options].
+	maxLoopIterations int
+	// fn is the function declaration currently being transpiled and fnName
+	// its transpiled name.
+	fn     *ast.FuncDecl
+	fnName string
+	// lifted holds the function literals within fn that have been lifted into
+	// their own functions. See `transpileFuncLit`.
+	lifted []*Func
+	// zeros determines which generic functions accept the zero values of
+	// their type parameters. See [zeroUses].
+	zeros *zeroUses
 }
 
 func (t *Transpiler) Transpile() *Chart {
@@ -180,7 +238,18 @@ func (t *Transpiler) transpileFile(f *ast.File) *File {
 			continue
 		}
 
+		obj := t.Package.TypesInfo.ObjectOf(fn.Name).(*types.Func)
+
+		t.fn = fn
+		t.fnName = t.funcNameFor(obj)
+		t.lifted = nil
+
+		// Generic functions accept the zero values of their type parameters
+		// ahead of all other arguments, if they reference any. See [erase].
 		var params []Node
+		if t.zeros.of(obj) {
+			params = typeParamZeros(obj)
+		}
 		if fn.Recv != nil {
 			for _, param := range fn.Recv.List {
 				for _, name := range param.Names {
@@ -195,18 +264,33 @@ func (t *Transpiler) transpileFile(f *ast.File) *File {
 			}
 		}
 
+		if name, ok := funcDirectives["template"]; ok && !t.ignoreTemplates {
+			funcs = append(funcs, &Func{
+				Name:       t.fnName,
+				Namespace:  t.namespaceFor(t.Package.Types),
+				Params:     params,
+				Statements: []Node{t.templateFor(fn, name)},
+				Pos:        t.Fset.Position(fn.Pos()),
+			})
+			continue
+		}
+
 		var statements []Node
 		for _, stmt := range fn.Body.List {
-			statements = append(statements, t.transpileStatement(stmt))
+			statements = append(statements, t.transpilePositioned(stmt))
 		}
 
-		// TODO add a source field here? Ideally with a line number.
 		funcs = append(funcs, &Func{
-			Name:       t.funcNameFor(t.Package.TypesInfo.ObjectOf(fn.Name).(*types.Func)),
-			Namespace:  t.namespaceFor(t.Package.Types),
-			Params:     params,
-			Statements: statements,
+			Name:        t.fnName,
+			Namespace:   t.namespaceFor(t.Package.Types),
+			Params:      params,
+			Statements:  statements,
+			Pos:         t.Fset.Position(fn.Pos()),
+			BasicResult: isBasicResult(obj.Type().(*types.Signature)),
 		})
+
+		// Function literals are emitted alongside their enclosing function.
+		funcs = append(funcs, t.lifted...)
 	}
 
 	return &File{
@@ -216,6 +300,48 @@ func (t *Transpiler) transpileFile(f *ast.File) *File {
 	}
 }
 
+// templateFor returns the verbatim template of fn, as declared by a
+// +gotohelm:template directive, which is emitted in place of its transpiled
+// body. name is the name of a string constant, within the same package, that
+// holds the template.
+func (t *Transpiler) templateFor(fn *ast.FuncDecl, name string) Node {
+	c, ok := t.Package.Types.Scope().Lookup(name).(*types.Const)
+	if !ok || c.Val().Kind() != constant.String {
+		panic(&Unsupported{
+			Node: fn,
+			Fset: t.Fset,
+			Msg:  fmt.Sprintf("+gotohelm:template=%s must refer to a string constant", name),
+		})
+	}
+
+	return &Positioned{
+		Pos:  t.Fset.Position(c.Pos()),
+		Node: Literal(constant.StringVal(c.Val())),
+	}
+}
+
+// isBasicResult reports whether sig returns a single bool, number, or string.
+func isBasicResult(sig *types.Signature) bool {
+	if sig.Results().Len() != 1 {
+		return false
+	}
+
+	basic, ok := sig.Results().At(0).Type().Underlying().(*types.Basic)
+	return ok && basic.Info()&(types.IsBoolean|types.IsNumeric|types.IsString) != 0
+}
+
+// transpilePositioned transpiles stmt and annotates the result with stmt's
+// position for the purpose of source maps. See [Positioned]. Blocks are left
+// as is as their statements are annotated individually.
+func (t *Transpiler) transpilePositioned(stmt ast.Stmt) Node {
+	node := t.transpileStatement(stmt)
+	if _, ok := stmt.(*ast.BlockStmt); ok || node == nil || !stmt.Pos().IsValid() {
+		return node
+	}
+
+	return &Positioned{Pos: t.Fset.Position(stmt.Pos()), Node: node}
+}
+
 func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 	switch stmt := stmt.(type) {
 	case nil:
@@ -224,35 +350,23 @@ func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 	case *ast.DeclStmt:
 		switch d := stmt.Decl.(type) {
 		case *ast.GenDecl:
+			// "unroll" declarations of multiple specs.
+			// var (
+			//   x = 1
+			//   y = 2
+			// )
+			// becomes
+			// x := 1
+			// y := 2
 			if len(d.Specs) > 1 {
-				// TODO could just return multiple statements.
-				panic(&Unsupported{
-					Node: d,
-					Fset: t.Fset,
-					Msg:  "declarations may only contain 1 spec",
-				})
-			}
-
-			spec := d.Specs[0].(*ast.ValueSpec)
-
-			if len(spec.Names) > 1 || len(spec.Values) > 1 {
-				panic(&Unsupported{
-					Node: d,
-					Fset: t.Fset,
-					Msg:  "specs may only contain 1 value",
-				})
-			}
-
-			rhs := t.zeroOf(t.TypesInfo.TypeOf(spec.Names[0]))
-			if len(spec.Values) > 0 {
-				rhs = t.transpileExpr(spec.Values[0])
+				var stmts []Node
+				for _, spec := range d.Specs {
+					stmts = append(stmts, t.transpileValueSpec(spec.(*ast.ValueSpec)))
+				}
+				return &Block{Statements: stmts}
 			}
 
-			return &Assignment{
-				LHS: t.transpileExpr(spec.Names[0]),
-				New: true,
-				RHS: rhs,
-			}
+			return t.transpileValueSpec(d.Specs[0].(*ast.ValueSpec))
 
 		default:
 			panic(fmt.Sprintf("unsupported declaration: %#v", d))
@@ -311,6 +425,14 @@ func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 		// another AST rewrite.
 		switch stmt.Tok {
 		case token.ASSIGN, token.DEFINE:
+		case token.ADD_ASSIGN, token.SUB_ASSIGN, token.MUL_ASSIGN, token.QUO_ASSIGN, token.REM_ASSIGN:
+			// x += y -> x = x + y
+			return t.transpileStatement(&ast.AssignStmt{
+				Lhs:    stmt.Lhs,
+				TokPos: stmt.TokPos,
+				Tok:    token.ASSIGN,
+				Rhs:    []ast.Expr{&ast.BinaryExpr{X: stmt.Lhs[0], OpPos: stmt.TokPos, Op: opAssignTokens[stmt.Tok], Y: stmt.Rhs[0]}},
+			})
 		default:
 			panic(&Unsupported{
 				Node: stmt,
@@ -319,50 +441,21 @@ func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 			})
 		}
 
-		// TODO could simplify this by performing a type switch on the
-		// transpiled result of lhs.
-		if _, ok := stmt.Lhs[0].(*ast.SelectorExpr); ok {
-			var selector *Selector
-			switch expr := t.transpileExpr(stmt.Lhs[0]).(type) {
-			case *Selector:
-				selector = expr
-			case *Cast:
-				selector = expr.X.(*Selector)
-			default:
-				panic(fmt.Sprintf("unhandled case %T: %v", expr, expr))
-			}
+		return t.transpileAssignment(stmt.Lhs[0], stmt.Tok, t.transpileExpr(stmt.Rhs[0]))
 
-			return &Statement{
-				Expr: &BuiltInCall{
-					Func: Literal("set"),
-					Arguments: []Node{
-						selector.Expr,
-						Quoted(selector.Field),
-						t.transpileExpr(stmt.Rhs[0]),
-					},
-				},
-			}
-		}
+	case *ast.IncDecStmt:
+		// x++ -> x = x + 1
+		// The 1 is typed as x to select the correct arithmetic builtin.
+		typ := t.typeOf(stmt.X)
 
-		// TODO could simplify this by implementing an IndexExpr node and then
-		// performing a type switch on the transpiled result of lhs.
-		if idx, ok := stmt.Lhs[0].(*ast.IndexExpr); ok {
-			return &Statement{
-				Expr: &BuiltInCall{
-					Func: Literal("set"),
-					Arguments: []Node{
-						t.transpileExpr(idx.X),
-						t.transpileExpr(idx.Index),
-						t.transpileExpr(stmt.Rhs[0]),
-					},
-				},
-			}
+		op := token.ADD
+		if stmt.Tok == token.DEC {
+			op = token.SUB
 		}
 
-		rhs := t.transpileExpr(stmt.Rhs[0])
-		lhs := t.transpileExpr(stmt.Lhs[0])
+		rhs := t.transpileBinaryOp(stmt, op, typ, typ, t.transpileExpr(stmt.X), t.maybeCast(Literal("1"), typ))
 
-		return &Assignment{RHS: rhs, LHS: lhs, New: stmt.Tok.String() == ":="}
+		return t.transpileAssignment(stmt.X, token.ASSIGN, rhs)
 
 	case *ast.RangeStmt:
 		if _, isMap := t.typeOf(stmt.X).Underlying().(*types.Map); isMap {
@@ -397,18 +490,35 @@ func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 	case *ast.BlockStmt:
 		var out []Node
 		for _, s := range stmt.List {
-			out = append(out, t.transpileStatement(s))
+			out = append(out, t.transpilePositioned(s))
 		}
 		return &Block{Statements: out}
 
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+		// Switches are rewritten into if-else chains by [rewriteSwitches]. Any
+		// that remain couldn't be.
+		msg := unsupportedSwitch(t.TypesInfo, stmt)
+		if msg == "" {
+			msg = "unhandled switch statement"
+		}
+		panic(&Unsupported{
+			Node: stmt,
+			Fset: t.Fset,
+			Msg:  msg,
+		})
+
 	case *ast.IfStmt:
 		return &IfStmt{
 			Init: t.transpileStatement(stmt.Init),
 			Cond: t.transpileExpr(stmt.Cond),
 			Body: t.transpileStatement(stmt.Body),
-			Else: t.transpileStatement(stmt.Else),
+			Else: t.transpilePositioned(stmt.Else),
 		}
 	case *ast.ForStmt:
+		if !isCountedLoop(stmt) {
+			return t.transpileBoundedLoop(stmt)
+		}
+
 		var start, stop Node
 		if b, ok := stmt.Cond.(*ast.BinaryExpr); ok {
 			switch b.Op {
@@ -469,13 +579,14 @@ func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 		switch p := stmt.Post.(type) {
 		case *ast.AssignStmt:
 			if b, ok := p.Rhs[0].(*ast.BasicLit); ok && p.Tok == token.SUB_ASSIGN {
-				b.Value = fmt.Sprintf("-%s", b.Value)
 				// switch start with stop expression as step is decreasing
 				step = start
 				start = stop
 				stop = step
 
-				step = t.transpileExpr(b)
+				// Negate the literal without modifying the AST, which may
+				// be transpiled again.
+				step = t.maybeCast(Literal(fmt.Sprintf("-%s", b.Value)), t.typeOf(b))
 			} else {
 				step = t.transpileExpr(b)
 			}
@@ -520,6 +631,177 @@ func (t *Transpiler) transpileStatement(stmt ast.Stmt) Node {
 	})
 }
 
+// opAssignTokens maps the tokens of arithmetic assignments to their binary
+// operators.
+var opAssignTokens = map[token.Token]token.Token{
+	token.ADD_ASSIGN: token.ADD,
+	token.SUB_ASSIGN: token.SUB,
+	token.MUL_ASSIGN: token.MUL,
+	token.QUO_ASSIGN: token.QUO,
+	token.REM_ASSIGN: token.REM,
+}
+
+// transpileValueSpec transpiles a single spec of a var declaration. Specs
+// with multiple names are "unrolled" into sequential assignments.
+// var x, y = 1, 2
+// becomes
+// x := 1
+// y := 2
+func (t *Transpiler) transpileValueSpec(spec *ast.ValueSpec) Node {
+	// var x, y = fn() is equivalent to x, y := fn().
+	if len(spec.Names) > 1 && len(spec.Values) == 1 {
+		lhs := make([]ast.Expr, len(spec.Names))
+		for i, name := range spec.Names {
+			lhs[i] = name
+		}
+		return t.transpileMVAssignStmt(&ast.AssignStmt{Lhs: lhs, TokPos: spec.Pos(), Tok: token.DEFINE, Rhs: spec.Values})
+	}
+
+	var stmts []Node
+	for i, name := range spec.Names {
+		rhs := t.zeroOf(t.TypesInfo.TypeOf(name))
+		if len(spec.Values) > 0 {
+			rhs = t.transpileExpr(spec.Values[i])
+		}
+
+		stmts = append(stmts, &Assignment{
+			LHS: t.transpileExpr(name),
+			New: true,
+			RHS: rhs,
+		})
+	}
+
+	if len(stmts) == 1 {
+		return stmts[0]
+	}
+	return &Block{Statements: stmts}
+}
+
+// isCountedLoop reports whether stmt is a counted loop that may be transpiled
+// into a range over untilStep, e.g. for i := 0; i < n; i++ {}.
+func isCountedLoop(stmt *ast.ForStmt) bool {
+	init, ok := stmt.Init.(*ast.AssignStmt)
+	if !ok || len(init.Lhs) != 1 {
+		return false
+	}
+	if _, ok := init.Lhs[0].(*ast.Ident); !ok {
+		return false
+	}
+
+	cond, ok := stmt.Cond.(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+
+	switch cond.Op {
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+	default:
+		return false
+	}
+
+	// Bounds must either be selectors or variables with a known initial
+	// value.
+	for _, bound := range []ast.Expr{cond.X, cond.Y} {
+		switch bound := bound.(type) {
+		case *ast.SelectorExpr:
+		case *ast.Ident:
+			if bound.Obj == nil {
+				return false
+			}
+			switch bound.Obj.Decl.(type) {
+			case *ast.AssignStmt, *ast.Field:
+			default:
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	switch post := stmt.Post.(type) {
+	case *ast.IncDecStmt:
+		return true
+	case *ast.AssignStmt:
+		_, ok := post.Rhs[0].(*ast.BasicLit)
+		return ok && (post.Tok == token.ADD_ASSIGN || post.Tok == token.SUB_ASSIGN)
+	}
+	return false
+}
+
+// transpileBoundedLoop transpiles for loops that aren't counted loops, e.g.
+// for cond {} or for {}, into a range over until with a maximum number of
+// iterations. If the loop is still running after the maximum number of
+// iterations, the template fails.
+//
+//	for i := 1; i < n; i *= 2 {
+//		...
+//	}
+//
+// Will get transpiled to:
+//
+//	{{- $i := 1 -}}
+//	{{- range $_, $_123_iteration := until (1001|int) -}}
+//	{{- if (ne $_123_iteration 0) -}}
+//	{{- $i = (mul $i 2) -}}
+//	{{- end -}}
+//	{{- if (not (lt $i $n)) -}}
+//	{{- break -}}
+//	{{- end -}}
+//	{{- if (eq $_123_iteration 1000) -}}
+//	{{- $_ := (fail "...") -}}
+//	{{- end -}}
+//	...
+//	{{- end -}}
+//
+// The post statement is executed at the start of every iteration but the
+// first so that continue statements don't skip it.
+func (t *Transpiler) transpileBoundedLoop(stmt *ast.ForStmt) Node {
+	pos := t.Fset.PositionFor(stmt.Pos(), false)
+
+	// NB: Line number is used here as .Pos seems to be unstable. See
+	// [Transpiler.transpileMVAssignStmt].
+	iteration := &Ident{Name: fmt.Sprintf("_%d_iteration", pos.Line)}
+
+	var body []Node
+
+	if stmt.Post != nil {
+		body = append(body, &IfStmt{
+			Cond: &BuiltInCall{Func: Literal("ne"), Arguments: []Node{iteration, Literal("0")}},
+			Body: t.transpilePositioned(stmt.Post),
+		})
+	}
+
+	if stmt.Cond != nil {
+		body = append(body, &IfStmt{
+			Cond: &BuiltInCall{Func: Literal("not"), Arguments: []Node{t.transpileExpr(stmt.Cond)}},
+			Body: &Statement{NoCapture: true, Expr: Literal("break")},
+		})
+	}
+
+	msg := fmt.Sprintf("%s:%d: for loop exceeded the maximum of %d iterations", filepath.Base(pos.Filename), pos.Line, t.maxLoopIterations)
+
+	body = append(body, &IfStmt{
+		Cond: &BuiltInCall{Func: Literal("eq"), Arguments: []Node{iteration, Literal(strconv.Itoa(t.maxLoopIterations))}},
+		Body: &Statement{Expr: &BuiltInCall{Func: Literal("fail"), Arguments: []Node{Quoted(msg)}}},
+	})
+
+	body = append(body, t.transpileStatement(stmt.Body))
+
+	loop := &Range{
+		Key:   &Ident{Name: "_"},
+		Value: iteration,
+		// The loop may run one extra iteration to check its condition.
+		Over: &Until{Expr: Literal(strconv.Itoa(t.maxLoopIterations + 1))},
+		Body: &Block{Statements: body},
+	}
+
+	if stmt.Init == nil {
+		return loop
+	}
+
+	return &Block{Statements: []Node{t.transpilePositioned(stmt.Init), loop}}
+}
+
 // transpileMVAssignStmt handles transpiling assignments where the RHS has
 // exactly one expression and the LHS has more than one. E.g type checks, map
 // checks, and functions with multiple return values.
@@ -531,7 +813,7 @@ func (t *Transpiler) transpileMVAssignStmt(stmt *ast.AssignStmt) Node {
 	// deterministic, and mildly human readable.
 	// foo, ok := ... -> $_123_foo_ok := ...
 	// NB: Line number is used here as .Pos seems to be unstable.
-	intermediate := &Ident{Name: fmt.Sprintf("_%d", t.Fset.Position(stmt.Pos()).Line)}
+	intermediate := &Ident{Name: fmt.Sprintf("_%d", t.Fset.PositionFor(stmt.Pos(), false).Line)}
 	for _, ident := range stmt.Lhs {
 		intermediate.Name += "_"
 		intermediate.Name += ident.(*ast.Ident).Name
@@ -719,6 +1001,9 @@ func (t *Transpiler) transpileExpr(n ast.Expr) Node {
 	case *ast.CallExpr:
 		return t.transpileCallExpr(n)
 
+	case *ast.FuncLit:
+		return t.transpileFuncLit(n)
+
 	case *ast.Ident:
 		switch obj := t.TypesInfo.ObjectOf(n).(type) {
 		case *types.Const:
@@ -798,130 +1083,7 @@ func (t *Transpiler) transpileExpr(n ast.Expr) Node {
 		})
 
 	case *ast.BinaryExpr:
-		// Closure helpers to make the following logic a bit nicer.
-
-		builtin := func(name string, args ...Node) Node {
-			return &BuiltInCall{Func: Literal(name), Arguments: args}
-		}
-
-		f := func(op string) func(a, b Node) Node {
-			return func(a, b Node) Node {
-				return builtin(op, a, b)
-			}
-		}
-
-		wrapWithCast := func(op, cast string) func(a, b Node) Node {
-			return func(a, b Node) Node {
-				return &Cast{To: cast, X: &BuiltInCall{Func: Literal(op), Arguments: []Node{a, b}}}
-			}
-		}
-
-		// Nasty workaround to support versions of helm compiled with go < 1.19.
-		// text/template failed to handle $var ==/!= nil. To get this to work
-		// we marshal $var to json and compare to the string literal "null".
-		//
-		// See also:
-		// - https://github.com/redpanda-data/helm-charts/issues/1454
-		// - https://github.com/golang/go/commit/c58f1bb65f2187d79a5842bb19f4db4cafd22794#diff-eaf3618e0348f6d918eede2b03dd275ed9129dcdf10d7cf470137c1af7c755f4L474
-		// - TestTemplateHelm310 in charts/redpanda
-		go119eq := func(op string, side string) func(a, b Node) Node {
-			return func(a, b Node) Node {
-				switch side {
-				case "lhs":
-					return builtin(op, builtin("toJson", a), Quoted("null"))
-				case "rhs":
-					return builtin(op, builtin("toJson", b), Quoted("null"))
-				default:
-					panic("side should only be lhs or rhs")
-				}
-			}
-		}
-
-		strConcat := func(a, b Node) Node {
-			return builtin("printf", Quoted("%s%s"), a, b)
-		}
-
-		// Poor man's pattern matching :[
-		mapping := map[[3]string]func(a, b Node) Node{
-			{"_", token.EQL.String(), "_"}:  f("eq"),
-			{"_", token.NEQ.String(), "_"}:  f("ne"),
-			{"_", token.LAND.String(), "_"}: f("and"),
-			{"_", token.LOR.String(), "_"}:  f("or"),
-			{"_", token.GTR.String(), "_"}:  f("gt"),
-			{"_", token.LSS.String(), "_"}:  f("lt"),
-			{"_", token.GEQ.String(), "_"}:  f("ge"),
-			{"_", token.LEQ.String(), "_"}:  f("le"),
-
-			{"_", token.EQL.String(), "untyped nil"}: go119eq("eq", "lhs"),
-			{"_", token.NEQ.String(), "untyped nil"}: go119eq("ne", "lhs"),
-			{"untyped nil", token.EQL.String(), "_"}: go119eq("eq", "rhs"),
-			{"untyped nil", token.NEQ.String(), "_"}: go119eq("ne", "rhs"),
-
-			// Support for string + string!
-			{"string", token.ADD.String(), "string"}:                 strConcat,
-			{"string", token.ADD.String(), "untyped string"}:         strConcat,
-			{"untyped string", token.ADD.String(), "string"}:         strConcat,
-			{"untyped string", token.ADD.String(), "untyped string"}: strConcat,
-
-			{"float32", token.ADD.String(), "float32"}: wrapWithCast("addf", "float64"),
-			{"float32", token.MUL.String(), "float32"}: wrapWithCast("mulf", "float64"),
-			{"float32", token.QUO.String(), "float32"}: wrapWithCast("divf", "float32"),
-			{"float32", token.SUB.String(), "float32"}: wrapWithCast("subf", "float64"),
-
-			{"float64", token.ADD.String(), "float64"}: wrapWithCast("addf", "float64"),
-			{"float64", token.MUL.String(), "float64"}: wrapWithCast("mulf", "float64"),
-			{"float64", token.QUO.String(), "float64"}: wrapWithCast("divf", "float64"),
-			{"float64", token.SUB.String(), "float64"}: wrapWithCast("subf", "float64"),
-
-			{"int", token.ADD.String(), "int"}: wrapWithCast("add", "int"),
-			{"int", token.MUL.String(), "int"}: wrapWithCast("mul", "int"),
-			{"int", token.QUO.String(), "int"}: wrapWithCast("div", "int"),
-			{"int", token.REM.String(), "int"}: wrapWithCast("mod", "int"),
-			{"int", token.SUB.String(), "int"}: wrapWithCast("sub", "int"),
-
-			{"int32", token.ADD.String(), "int32"}: wrapWithCast("add", "int"),
-			{"int32", token.MUL.String(), "int32"}: wrapWithCast("mul", "int"),
-			{"int32", token.QUO.String(), "int32"}: wrapWithCast("div", "int"),
-			{"int32", token.REM.String(), "int32"}: wrapWithCast("mod", "int"),
-			{"int32", token.SUB.String(), "int32"}: wrapWithCast("sub", "int"),
-
-			{"int64", token.ADD.String(), "int64"}: wrapWithCast("add", "int64"),
-			{"int64", token.MUL.String(), "int64"}: wrapWithCast("mul", "int64"),
-			{"int64", token.QUO.String(), "int64"}: wrapWithCast("div", "int64"),
-			{"int64", token.REM.String(), "int64"}: wrapWithCast("mod", "int64"),
-			{"int64", token.SUB.String(), "int64"}: wrapWithCast("sub", "int64"),
-
-			{"untyped int", token.ADD.String(), "untyped int"}: f("add"),
-			{"untyped int", token.MUL.String(), "untyped int"}: f("mul"),
-			{"untyped int", token.QUO.String(), "untyped int"}: f("div"),
-			{"untyped int", token.REM.String(), "untyped int"}: f("mod"),
-			{"untyped int", token.SUB.String(), "untyped int"}: f("sub"),
-
-			{"untyped float", token.ADD.String(), "untyped float"}: f("addf"),
-			{"untyped float", token.MUL.String(), "untyped float"}: f("mulf"),
-			{"untyped float", token.QUO.String(), "untyped float"}: f("divf"),
-			{"untyped float", token.SUB.String(), "untyped float"}: f("subf"),
-		}
-
-		// Iterate though "patterns" in order of priority.
-		patterns := [][3]string{
-			{t.typeOf(n.X).String(), n.Op.String(), t.typeOf(n.Y).String()},
-			{"_", n.Op.String(), t.typeOf(n.Y).String()},
-			{t.typeOf(n.X).String(), n.Op.String(), "_"},
-			{"_", n.Op.String(), "_"},
-		}
-
-		for _, pattern := range patterns {
-			if funcName, ok := mapping[pattern]; ok {
-				return funcName(t.transpileExpr(n.X), t.transpileExpr(n.Y))
-			}
-		}
-
-		panic(&Unsupported{
-			Node: n,
-			Fset: t.Fset,
-			Msg:  fmt.Sprintf(`No matching %T signature for %v`, n, patterns),
-		})
+		return t.transpileBinaryOp(n, n.Op, t.typeOf(n.X), t.typeOf(n.Y), t.transpileExpr(n.X), t.transpileExpr(n.Y))
 
 	case *ast.UnaryExpr:
 		switch n.Op {
@@ -1013,6 +1175,16 @@ func (t *Transpiler) transpileExpr(n ast.Expr) Node {
 	panic(fmt.Sprintf("unhandled Expr %T\n%s", n, b.String()))
 }
 
+// dependenciesOf returns the set of the paths of the packages whose functions
+// may be called by pkg: pkg itself and any dependent charts (subcharts).
+func dependenciesOf(pkg *packages.Package, opts Options) map[string]struct{} {
+	dependencies := map[string]struct{}{}
+	for _, path := range append(opts.Dependencies, pkg.PkgPath) {
+		dependencies[path] = struct{}{}
+	}
+	return dependencies
+}
+
 // mkPkgTree "flattens" a loaded [packages.Package] and its dependencies into a
 // map keyed by path.
 func mkPkgTree(root *packages.Package) map[string]*packages.Package {
@@ -1042,10 +1214,18 @@ func mkPkgTree(root *packages.Package) map[string]*packages.Package {
 func (t *Transpiler) transpileCallExpr(n *ast.CallExpr) Node {
 	callee := typeutil.Callee(t.TypesInfo, n)
 
-	// n.Fun is not a function, signature, var, or built in.
-	// Chances are it's a cast expression.
+	// n.Fun is not a function, signature, var, or built in. Either it's a
+	// cast expression or an expression that evaluates to a function value,
+	// such as a function literal.
 	if callee == nil {
-		return t.transpileCast(n.Args[0], t.typeOf(n.Fun))
+		if t.TypesInfo.Types[n.Fun].IsType() {
+			return t.transpileCast(n.Args[0], t.typeOf(n.Fun))
+		}
+		var args []Node
+		for _, arg := range n.Args {
+			args = append(args, t.transpileExpr(arg))
+		}
+		return t.transpileFuncValueCall(n, args)
 	}
 
 	var args []Node
@@ -1107,7 +1287,7 @@ func (t *Transpiler) transpileCallExpr(n *ast.CallExpr) Node {
 
 	id := callee.Pkg().Path() + "." + callee.Name()
 
-	signature := t.typeOf(n.Fun).(*types.Signature)
+	signature := t.typeOf(n.Fun).Underlying().(*types.Signature)
 
 	var receiver Node
 	if recv := callee.Type().(*types.Signature).Recv(); recv != nil {
@@ -1234,6 +1414,10 @@ func (t *Transpiler) transpileCallExpr(n *ast.CallExpr) Node {
 		return litCall("_shims.get", args...)
 	case "github.com/redpanda-data/redpanda-operator/gotohelm/helmette.FromYaml":
 		return litCall("_shims.fromYaml", args...)
+	case "github.com/redpanda-data/redpanda-operator/gotohelm/helmette.Map":
+		return litCall("_shims.map", args...)
+	case "github.com/redpanda-data/redpanda-operator/gotohelm/helmette.Filter":
+		return litCall("_shims.filter", args...)
 
 	case "github.com/redpanda-data/redpanda-operator/gotohelm/helmette.SortedMap":
 		// In go, map iteration is non-deterministic which can cause
@@ -1345,9 +1529,10 @@ func (t *Transpiler) transpileCallExpr(n *ast.CallExpr) Node {
 		switch callee := callee.(type) {
 		case *types.Func:
 			// Easy case: if there's no receiver, this is just a function call.
+			args = append(t.typeArgZeros(n.Fun, callee), args...)
 			call = litCall(fmt.Sprintf("%s.%s", t.namespaceFor(callee.Pkg()), t.funcNameFor(callee)), args...)
 		case *types.Var:
-			call = &Call{FuncName: &Ident{Name: callee.Name()}, Arguments: args}
+			return t.transpileFuncValueCall(n, args)
 		default:
 			panic(&Unsupported{
 				Node: n,
@@ -1384,7 +1569,7 @@ func (t *Transpiler) transpileCallExpr(n *ast.CallExpr) Node {
 			// selector up to that call. e.g. `Foo.Bar.Baz()` will be a `CallExpr`.
 			// It's `.Fun` is a `SelectorExpr` where `.X` is `Foo.Bar`, the receiver,
 			// and `.Sel` is `Baz`, the method name.
-			append([]Node{receiverArg}, args...)...,
+			append(append(t.typeArgZeros(n.Fun, callee.(*types.Func)), receiverArg), args...)...,
 		)
 	}
 
@@ -1487,6 +1672,182 @@ func (t *Transpiler) transpileTypeRepr(typ types.Type) Node {
 	panic(fmt.Sprintf("unsupported type: %v", typ))
 }
 
+// transpileAssignment transpiles the assignment of the already transpiled rhs
+// to lhs with either = or :=.
+func (t *Transpiler) transpileAssignment(lhs ast.Expr, tok token.Token, rhs Node) Node {
+	// TODO could simplify this by performing a type switch on the
+	// transpiled result of lhs.
+	if _, ok := lhs.(*ast.SelectorExpr); ok {
+		var selector *Selector
+		switch expr := t.transpileExpr(lhs).(type) {
+		case *Selector:
+			selector = expr
+		case *Cast:
+			selector = expr.X.(*Selector)
+		default:
+			panic(fmt.Sprintf("unhandled case %T: %v", expr, expr))
+		}
+
+		return &Statement{
+			Expr: &BuiltInCall{
+				Func: Literal("set"),
+				Arguments: []Node{
+					selector.Expr,
+					Quoted(selector.Field),
+					rhs,
+				},
+			},
+		}
+	}
+
+	// TODO could simplify this by implementing an IndexExpr node and then
+	// performing a type switch on the transpiled result of lhs.
+	if idx, ok := lhs.(*ast.IndexExpr); ok {
+		return &Statement{
+			Expr: &BuiltInCall{
+				Func: Literal("set"),
+				Arguments: []Node{
+					t.transpileExpr(idx.X),
+					t.transpileExpr(idx.Index),
+					rhs,
+				},
+			},
+		}
+	}
+
+	return &Assignment{RHS: rhs, LHS: t.transpileExpr(lhs), New: tok == token.DEFINE}
+}
+
+// transpileBinaryOp transpiles the already transpiled operands x and y, of
+// types xType and yType, into the builtin equivalent of op. n is used solely
+// for error reporting.
+func (t *Transpiler) transpileBinaryOp(n ast.Node, op token.Token, xType, yType types.Type, x, y Node) Node {
+	// Closure helpers to make the following logic a bit nicer.
+
+	builtin := func(name string, args ...Node) Node {
+		return &BuiltInCall{Func: Literal(name), Arguments: args}
+	}
+
+	f := func(op string) func(a, b Node) Node {
+		return func(a, b Node) Node {
+			return builtin(op, a, b)
+		}
+	}
+
+	wrapWithCast := func(op, cast string) func(a, b Node) Node {
+		return func(a, b Node) Node {
+			return &Cast{To: cast, X: &BuiltInCall{Func: Literal(op), Arguments: []Node{a, b}}}
+		}
+	}
+
+	// Nasty workaround to support versions of helm compiled with go < 1.19.
+	// text/template failed to handle $var ==/!= nil. To get this to work
+	// we marshal $var to json and compare to the string literal "null".
+	//
+	// See also:
+	// - https://github.com/redpanda-data/helm-charts/issues/1454
+	// - https://github.com/golang/go/commit/c58f1bb65f2187d79a5842bb19f4db4cafd22794#diff-eaf3618e0348f6d918eede2b03dd275ed9129dcdf10d7cf470137c1af7c755f4L474
+	// - TestTemplateHelm310 in charts/redpanda
+	go119eq := func(op string, side string) func(a, b Node) Node {
+		return func(a, b Node) Node {
+			switch side {
+			case "lhs":
+				return builtin(op, builtin("toJson", a), Quoted("null"))
+			case "rhs":
+				return builtin(op, builtin("toJson", b), Quoted("null"))
+			default:
+				panic("side should only be lhs or rhs")
+			}
+		}
+	}
+
+	strConcat := func(a, b Node) Node {
+		return builtin("printf", Quoted("%s%s"), a, b)
+	}
+
+	// Poor man's pattern matching :[
+	mapping := map[[3]string]func(a, b Node) Node{
+		{"_", token.EQL.String(), "_"}:  f("eq"),
+		{"_", token.NEQ.String(), "_"}:  f("ne"),
+		{"_", token.LAND.String(), "_"}: f("and"),
+		{"_", token.LOR.String(), "_"}:  f("or"),
+		{"_", token.GTR.String(), "_"}:  f("gt"),
+		{"_", token.LSS.String(), "_"}:  f("lt"),
+		{"_", token.GEQ.String(), "_"}:  f("ge"),
+		{"_", token.LEQ.String(), "_"}:  f("le"),
+
+		{"_", token.EQL.String(), "untyped nil"}: go119eq("eq", "lhs"),
+		{"_", token.NEQ.String(), "untyped nil"}: go119eq("ne", "lhs"),
+		{"untyped nil", token.EQL.String(), "_"}: go119eq("eq", "rhs"),
+		{"untyped nil", token.NEQ.String(), "_"}: go119eq("ne", "rhs"),
+
+		// Support for string + string!
+		{"string", token.ADD.String(), "string"}:                 strConcat,
+		{"string", token.ADD.String(), "untyped string"}:         strConcat,
+		{"untyped string", token.ADD.String(), "string"}:         strConcat,
+		{"untyped string", token.ADD.String(), "untyped string"}: strConcat,
+
+		{"float32", token.ADD.String(), "float32"}: wrapWithCast("addf", "float64"),
+		{"float32", token.MUL.String(), "float32"}: wrapWithCast("mulf", "float64"),
+		{"float32", token.QUO.String(), "float32"}: wrapWithCast("divf", "float32"),
+		{"float32", token.SUB.String(), "float32"}: wrapWithCast("subf", "float64"),
+
+		{"float64", token.ADD.String(), "float64"}: wrapWithCast("addf", "float64"),
+		{"float64", token.MUL.String(), "float64"}: wrapWithCast("mulf", "float64"),
+		{"float64", token.QUO.String(), "float64"}: wrapWithCast("divf", "float64"),
+		{"float64", token.SUB.String(), "float64"}: wrapWithCast("subf", "float64"),
+
+		{"int", token.ADD.String(), "int"}: wrapWithCast("add", "int"),
+		{"int", token.MUL.String(), "int"}: wrapWithCast("mul", "int"),
+		{"int", token.QUO.String(), "int"}: wrapWithCast("div", "int"),
+		{"int", token.REM.String(), "int"}: wrapWithCast("mod", "int"),
+		{"int", token.SUB.String(), "int"}: wrapWithCast("sub", "int"),
+
+		{"int32", token.ADD.String(), "int32"}: wrapWithCast("add", "int"),
+		{"int32", token.MUL.String(), "int32"}: wrapWithCast("mul", "int"),
+		{"int32", token.QUO.String(), "int32"}: wrapWithCast("div", "int"),
+		{"int32", token.REM.String(), "int32"}: wrapWithCast("mod", "int"),
+		{"int32", token.SUB.String(), "int32"}: wrapWithCast("sub", "int"),
+
+		{"int64", token.ADD.String(), "int64"}: wrapWithCast("add", "int64"),
+		{"int64", token.MUL.String(), "int64"}: wrapWithCast("mul", "int64"),
+		{"int64", token.QUO.String(), "int64"}: wrapWithCast("div", "int64"),
+		{"int64", token.REM.String(), "int64"}: wrapWithCast("mod", "int64"),
+		{"int64", token.SUB.String(), "int64"}: wrapWithCast("sub", "int64"),
+
+		{"untyped int", token.ADD.String(), "untyped int"}: f("add"),
+		{"untyped int", token.MUL.String(), "untyped int"}: f("mul"),
+		{"untyped int", token.QUO.String(), "untyped int"}: f("div"),
+		{"untyped int", token.REM.String(), "untyped int"}: f("mod"),
+		{"untyped int", token.SUB.String(), "untyped int"}: f("sub"),
+
+		{"untyped float", token.ADD.String(), "untyped float"}: f("addf"),
+		{"untyped float", token.MUL.String(), "untyped float"}: f("mulf"),
+		{"untyped float", token.QUO.String(), "untyped float"}: f("divf"),
+		{"untyped float", token.SUB.String(), "untyped float"}: f("subf"),
+	}
+
+	// Iterate though "patterns" in order of priority.
+	patterns := [][3]string{
+		{xType.String(), op.String(), yType.String()},
+		{"_", op.String(), yType.String()},
+		{xType.String(), op.String(), "_"},
+		{"_", op.String(), "_"},
+	}
+
+	for _, pattern := range patterns {
+		if funcName, ok := mapping[pattern]; ok {
+			return funcName(x, y)
+		}
+	}
+
+	panic(&Unsupported{
+		Node: n,
+		Fset: t.Fset,
+		Msg:  fmt.Sprintf(`No matching %T signature for %v`, n, patterns),
+	})
+}
+
 func (t *Transpiler) transpileConst(c *types.Const) Node {
 	// We could include definitions to constants and then reference
 	// them. For now, it's easier to turn constants into their
@@ -1514,10 +1875,17 @@ func (t *Transpiler) isString(e ast.Expr) bool {
 }
 
 func (t *Transpiler) typeOf(expr ast.Expr) types.Type {
-	return t.TypesInfo.TypeOf(expr)
+	return erase(t.TypesInfo.TypeOf(expr))
 }
 
 func (t *Transpiler) zeroOf(typ types.Type) Node {
+	// The zero values of type parameters are passed to generic functions
+	// unless they're known ahead of time. See [erase].
+	typ = erase(typ)
+	if tp, ok := typ.(*types.TypeParam); ok {
+		return typeParamZero(tp)
+	}
+
 	// Special cases.
 	switch typ.String() {
 	case "k8s.io/apimachinery/pkg/apis/meta/v1.Time":
@@ -1650,6 +2018,8 @@ func (t *Transpiler) maybeCast(n Node, to types.Type) Node {
 		return n
 	}
 
+	to = erase(to)
+
 	// TODO: This can probably be optimized to not cast as frequently but
 	// should otherwise perform just fine.
 	if basic, ok := to.Underlying().(*types.Basic); ok {
@@ -1729,6 +2099,10 @@ func (t *Transpiler) namespaceFor(pkg *types.Package) string {
 // funcNameFor returns the transpiled "function" name for a given function
 // taking into account directives and receivers, if any.
 func (t *Transpiler) funcNameFor(fn *types.Func) string {
+	// Methods of instantiated generic types are transpiled once, as their
+	// generic origin.
+	fn = fn.Origin()
+
 	if name, ok := t.names[fn]; ok {
 		return name
 	}
@@ -215,6 +215,21 @@ func TestTranspile(t *testing.T) {
 			)
 			require.NoError(t, err)
 
+			// Functions with a +gotohelm:template directive are additionally
+			// transpiled from their go bodies to assert that both
+			// implementations are equivalent.
+			var transpiledRunner *HelmRunner
+			if dir := transpileIgnoringTemplates(t, pkg, commonPkg, chart); dir != "" {
+				transpiledRunner, err = NewHelmRunner(
+					namespace,
+					ctl.RestConfig(),
+					t.Logf,
+					dir,
+					filepath.Join(td, "src", "example", commonName),
+				)
+				require.NoError(t, err)
+			}
+
 			// If .Values isn't explicitly specified, default to an empty object.
 			if spec.Values == nil {
 				spec.Values = append(spec.Values, map[string]any{})
@@ -265,12 +280,46 @@ func TestTranspile(t *testing.T) {
 					t.Logf("template output:\n%s", tplPretty)
 
 					require.Equal(t, gocodeJSON, actualJSON, "Divergence between Go code and generated template\nHint: Go (Expected) is prefixed with -\n      Helm (Actual) is prefixed with +")
+
+					if transpiledRunner != nil {
+						transpiledJSON, err := transpiledRunner.Render(ctx, &dot)
+						require.NoError(t, err, "error from helm runner ignoring templates")
+
+						require.Equal(t, gocodeJSON, transpiledJSON, "Divergence between Go code and generated template ignoring +gotohelm:template directives\nHint: Go (Expected) is prefixed with -\n      Helm (Actual) is prefixed with +")
+					}
 				})
 			}
 		})
 	}
 }
 
+// transpileIgnoringTemplates transpiles pkg with [Options.IgnoreTemplates] and,
+// if the result differs from chart, writes it to a temporary directory which is
+// returned.
+func transpileIgnoringTemplates(t *testing.T, pkg *packages.Package, commonPkg string, chart *Chart) string {
+	ignored, err := TranspileWithOptions(pkg, Options{Dependencies: []string{commonPkg}, IgnoreTemplates: true})
+	require.NoError(t, err)
+	require.Len(t, ignored.Files, len(chart.Files))
+
+	dir := t.TempDir()
+	changed := false
+
+	for i, f := range ignored.Files {
+		var original, transpiled bytes.Buffer
+		chart.Files[i].Write(&original)
+		f.Write(&transpiled)
+
+		changed = changed || !bytes.Equal(original.Bytes(), transpiled.Bytes())
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f.Name), transpiled.Bytes(), 0o644))
+	}
+
+	if !changed {
+		return ""
+	}
+	return dir
+}
+
 type HelmRunner struct {
 	namespace string
 	tpl       *template.Template
@@ -534,3 +583,96 @@ func (g *GoRunner) run() error {
 		}
 	}
 }
+
+func TestTranspileDoesNotMutatePackage(t *testing.T) {
+	td, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	pkgs, err := LoadPackages(&packages.Config{
+		Dir: td + "/src/example",
+	}, "./aaacommon", "./syntax")
+	require.NoError(t, err)
+
+	common, pkg := pkgs[0], pkgs[1]
+	require.Equal(t, "syntax", pkg.Name)
+	types := len(pkg.TypesInfo.Types)
+
+	first, err := Transpile(pkg, common.PkgPath)
+	require.NoError(t, err)
+
+	// Repeated transpilations of the same package, as performed by the
+	// [Builder], must neither modify it nor differ in their output.
+	second, err := Transpile(pkg, common.PkgPath)
+	require.NoError(t, err)
+
+	require.Equal(t, types, len(pkg.TypesInfo.Types))
+	require.Equal(t, len(first.Files), len(second.Files))
+	for i := range first.Files {
+		var a, b bytes.Buffer
+		first.Files[i].Write(&a)
+		second.Files[i].Write(&b)
+		require.Equal(t, a.String(), b.String())
+	}
+}
+
+func TestTranspileCapturesReassignedInLoops(t *testing.T) {
+	td, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		body string
+		err  string
+	}{
+		"declared-outside-loop": {
+			body: `
+	x := 0
+	for _, i := range ints {
+		x = i
+		out = append(out, func() int { return x }())
+	}`,
+			err: `"x" is reassigned after being captured`,
+		},
+		"declared-outside-nested-loop": {
+			body: `
+	x := 0
+	for range ints {
+		x++
+		for _, i := range ints {
+			out = append(out, func() int { return x + i }())
+		}
+	}`,
+			err: `"x" is reassigned after being captured`,
+		},
+		"declared-inside-loop": {
+			body: `
+	for _, i := range ints {
+		x := 0
+		x = i
+		out = append(out, func() int { return x }())
+	}`,
+		},
+		"loop-variable": {
+			body: `
+	for i := 0; i < len(ints); i++ {
+		out = append(out, func() int { return ints[i] }())
+	}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			src := fmt.Sprintf("package captures\n\nfunc Captures(ints []int) []int {\n\tvar out []int%s\n\treturn out\n}\n", tc.body)
+
+			pkgs, err := LoadPackages(&packages.Config{
+				Dir:     td + "/src/example",
+				Overlay: map[string][]byte{td + "/src/example/captures/captures.go": []byte(src)},
+			}, "./captures")
+			require.NoError(t, err)
+
+			_, err = Transpile(pkgs[0])
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
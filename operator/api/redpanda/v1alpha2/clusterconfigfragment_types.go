@@ -0,0 +1,122 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/functional"
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterConfigFragment{}, &ClusterConfigFragmentList{})
+}
+
+const (
+	// ClusterConfigFragmentConditionApplied reports whether all keys of a
+	// ClusterConfigFragment have been applied to its cluster.
+	ClusterConfigFragmentConditionApplied = "Applied"
+
+	ClusterConfigFragmentReasonApplied  = "Applied"
+	ClusterConfigFragmentReasonConflict = "Conflict"
+	ClusterConfigFragmentReasonInvalid  = "Invalid"
+	ClusterConfigFragmentReasonError    = "Error"
+)
+
+// ClusterConfigFragment defines the CRD for a fragment of the cluster
+// configuration of a Redpanda cluster. The keys of all fragments referencing
+// a cluster are merged into the configuration specified by the Redpanda
+// resource itself, allowing separate teams to own separate parts of it.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=clusterconfigfragments
+// +kubebuilder:resource:shortName=ccf
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=`.spec.clusterRef.name`
+// +kubebuilder:printcolumn:name="Applied",type="string",JSONPath=`.status.conditions[?(@.type=="Applied")].status`
+type ClusterConfigFragment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Defines the desired state of the cluster configuration fragment.
+	Spec ClusterConfigFragmentSpec `json:"spec"`
+	// Represents the current status of the cluster configuration fragment.
+	Status ClusterConfigFragmentStatus `json:"status,omitempty"`
+}
+
+// ClusterConfigFragmentSpec defines the configuration of a cluster
+// configuration fragment.
+type ClusterConfigFragmentSpec struct {
+	// ClusterRef is a reference to the Redpanda cluster, in the same
+	// namespace, whose configuration this fragment contributes to.
+	// +required
+	ClusterRef ClusterRef `json:"clusterRef"`
+	// Config holds the cluster configuration properties (or references to
+	// them) contributed by this fragment. See
+	// https://docs.redpanda.com/current/reference/cluster-properties/.
+	//
+	// A key may only be set by one source. Keys set by the Redpanda resource,
+	// explicitly or derived from its other settings, take precedence over
+	// fragments and, amongst fragments, the oldest fragment setting a key
+	// owns it. With the declarative config sync mode, the keys of a deleted
+	// fragment are removed from the cluster.
+	// +required
+	Config ClusterConfiguration `json:"config"`
+}
+
+// ClusterConfigFragmentStatus defines the observed state of a cluster
+// configuration fragment.
+type ClusterConfigFragmentStatus struct {
+	// Specifies the last observed generation.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the conditions for the cluster configuration fragment.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// AppliedKeys lists the keys of this fragment that are part of the
+	// desired configuration of the cluster.
+	AppliedKeys []string `json:"appliedKeys,omitempty"`
+	// Conflicts lists the keys of this fragment that are owned by another
+	// source and therefore not applied.
+	Conflicts []ClusterConfigConflict `json:"conflicts,omitempty"`
+	// InvalidKeys lists the keys of this fragment that are rejected by the
+	// configuration schema of the cluster and therefore not applied.
+	InvalidKeys []ClusterConfigInvalidKey `json:"invalidKeys,omitempty"`
+}
+
+// ClusterConfigConflict describes a key of a cluster configuration fragment
+// that is owned by another source.
+type ClusterConfigConflict struct {
+	// Key is the conflicting cluster configuration property.
+	Key string `json:"key"`
+	// Owner is the source that owns the key, formatted as <Kind>/<name>.
+	Owner string `json:"owner"`
+}
+
+// ClusterConfigInvalidKey describes a key of a cluster configuration fragment
+// that is rejected by the configuration schema of the cluster.
+type ClusterConfigInvalidKey struct {
+	// Key is the invalid cluster configuration property.
+	Key string `json:"key"`
+	// Message describes why the key is invalid.
+	Message string `json:"message"`
+}
+
+// ClusterConfigFragmentList contains a list of cluster configuration
+// fragments.
+// +kubebuilder:object:root=true
+type ClusterConfigFragmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Specifies a list of cluster configuration fragment resources.
+	Items []ClusterConfigFragment `json:"items"`
+}
+
+func (l *ClusterConfigFragmentList) GetItems() []*ClusterConfigFragment {
+	return functional.MapFn(ptr.To, l.Items)
+}
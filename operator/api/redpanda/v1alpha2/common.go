@@ -315,6 +315,7 @@ const (
 	ResourceConditionReasonPending              = "Pending"
 	ResourceConditionReasonSynced               = "Synced"
 	ResourceConditionReasonClusterRefInvalid    = "ClusterRefInvalid"
+	ResourceConditionReasonClusterRecovering    = "ClusterRecovering"
 	ResourceConditionReasonConfigurationInvalid = "ConfigurationInvalid"
 	ResourceConditionReasonTerminalClientError  = "TerminalClientError"
 	ResourceConditionReasonUnexpectedError      = "UnexpectedError"
@@ -155,6 +155,11 @@ type ConfigWatcher struct {
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 	// Specifies the container's security context, including privileges and access levels of the container and its processes.
 	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// Specifies whether the sidecar watches the certificates served by the broker's listeners and exports their expiration.
+	WatchCertificates *bool `json:"watchCertificates,omitempty"`
+	// Requests the Redpanda Operator to restart each broker, one at a time, once its listener certificates are rotated.
+	// Requires `watchCertificates`.
+	RestartOnCertificateRotation *bool `json:"restartOnCertificateRotation,omitempty"`
 }
 
 // RedpandaImage configures the Redpanda container image settings in the Helm values.
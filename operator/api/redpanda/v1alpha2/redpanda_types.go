@@ -18,6 +18,7 @@ import (
 	"github.com/cockroachdb/errors"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -82,6 +83,84 @@ type RedpandaSpec struct {
 	ClusterSpec *RedpandaClusterSpec `json:"clusterSpec,omitempty"`
 	// Deprecated and Removed in v2.2.3-24.2.X. Downgrade to v2.2.2-24.2.4 perform the migration
 	Migration *Migration `json:"migration,omitempty"`
+	// Defines how the operator resizes the PersistentVolumeClaims of existing brokers.
+	// +optional
+	VolumeExpansion *VolumeExpansion `json:"volumeExpansion,omitempty"`
+	// Defines whether a freshly bootstrapped cluster restores its topics and
+	// metadata from the bucket configured in `clusterSpec.storage.tiered`.
+	// +optional
+	ClusterRecovery *ClusterRecovery `json:"clusterRecovery,omitempty"`
+	// Defines when the operator triggers a rebalance of partitions across
+	// brokers. On-demand rebalances are triggered by setting the
+	// `operator.redpanda.com/rebalance-partitions` annotation to a new value.
+	// +optional
+	PartitionRebalancing *PartitionRebalancing `json:"partitionRebalancing,omitempty"`
+}
+
+// ClusterRecovery configures the recovery of a lost cluster from Tiered Storage.
+// Once the cluster has bootstrapped, the operator starts a whole cluster
+// recovery through the Admin API and waits for it to finish before it applies
+// the cluster configuration. Until then, Topics, Users and Schemas that
+// reference the cluster are not synced so that they can't conflict with the
+// recovered ones. Recovery is only ever started on a cluster that the operator
+// hasn't yet applied any cluster configuration to.
+type ClusterRecovery struct {
+	// Specifies whether to recover the cluster from Tiered Storage.
+	Enabled bool `json:"enabled"`
+}
+
+// VolumeExpansion configures how the operator resizes the PersistentVolumeClaims
+// of existing brokers. StatefulSet volumeClaimTemplates are immutable, so claims
+// are patched directly and only if their StorageClass allows volume expansion.
+type VolumeExpansion struct {
+	// Specifies whether existing PersistentVolumeClaims are resized when
+	// `clusterSpec.storage.persistentVolume.size` grows. Defaults to `true`.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Configures the expansion of a broker's data directory based on the
+	// disk usage it reports through the Admin API.
+	// +optional
+	Autoscaling *VolumeAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// VolumeAutoscaling configures the usage based expansion of broker data directories.
+type VolumeAutoscaling struct {
+	// Specifies whether to expand data directories based on disk usage.
+	Enabled bool `json:"enabled"`
+	// The percentage of used disk space above which a broker's data directory is expanded.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default=80
+	// +optional
+	UsageThresholdPercent int32 `json:"usageThresholdPercent,omitempty"`
+	// The percentage by which the current size of a data directory is increased.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=25
+	// +optional
+	IncreasePercent int32 `json:"increasePercent,omitempty"`
+	// The size past which a data directory is never expanded.
+	MaxSize resource.Quantity `json:"maxSize"`
+	// The minimum time to wait between two expansions of the same data directory.
+	// Defaults to `1h`.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// PartitionRebalancing configures the rebalances of partitions that the operator
+// triggers through the Admin API in addition to on-demand ones.
+type PartitionRebalancing struct {
+	// The interval at which partitions are rebalanced. Rebalances are not
+	// scheduled if unset.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Specifies whether to rebalance partitions once brokers added by
+	// scaling up the cluster have joined it.
+	// +optional
+	AfterScaleUp bool `json:"afterScaleUp,omitempty"`
 }
 
 // Migration can configure old Cluster and Console custom resource that will be disabled.
@@ -128,6 +207,113 @@ type NodePoolStatus struct {
 	RunningReplicas int32 `json:"runningReplicas"`
 }
 
+// VolumeStatus defines the observed state of a broker's PersistentVolumeClaim
+type VolumeStatus struct {
+	// Name is the name of the PersistentVolumeClaim
+	Name string `json:"name"`
+	// Pod is the name of the broker Pod the claim is mounted in.
+	Pod string `json:"pod"`
+	// RequestedSize is the storage size currently requested by the claim.
+	RequestedSize resource.Quantity `json:"requestedSize"`
+	// Capacity is the storage capacity of the volume bound to the claim.
+	// It lags behind RequestedSize while a resize is in progress.
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+	// UsagePercent is the percentage of disk space used by the broker
+	// as last reported through the Admin API.
+	// +optional
+	UsagePercent *int32 `json:"usagePercent,omitempty"`
+	// LastExpansionTime is the time at which the operator last resized the claim.
+	// +optional
+	LastExpansionTime *metav1.Time `json:"lastExpansionTime,omitempty"`
+	// ExpansionBlockedReason is set when the claim should be expanded but
+	// can't be, either because its StorageClass does not allow volume
+	// expansion or because it has reached the maximum autoscaling size.
+	// +kubebuilder:validation:Enum=StorageClassNotExpandable;MaximumSizeReached
+	// +optional
+	ExpansionBlockedReason string `json:"expansionBlockedReason,omitempty"`
+}
+
+// ClusterRecoveryStatus defines the observed state of a cluster recovery
+type ClusterRecoveryStatus struct {
+	// State is the state of the recovery as reported through the Admin API.
+	State string `json:"state"`
+	// StartTime is the time at which the operator started the recovery.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time at which the recovery was observed to finish.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// PendingDownloads is the number of topic manifests yet to be downloaded.
+	PendingDownloads int32 `json:"pendingDownloads"`
+	// SuccessfulDownloads is the number of topic manifests successfully downloaded.
+	SuccessfulDownloads int32 `json:"successfulDownloads"`
+	// FailedDownloads is the number of topic manifests that failed to download.
+	FailedDownloads int32 `json:"failedDownloads"`
+}
+
+// PartitionRebalanceStatus defines the observed state of the last partition rebalance
+type PartitionRebalanceStatus struct {
+	// Trigger is what triggered the last rebalance, one of `OnDemand`,
+	// `Scheduled` or `ScaleUp`.
+	// +optional
+	Trigger string `json:"trigger,omitempty"`
+	// Request is the value of the `operator.redpanda.com/rebalance-partitions`
+	// annotation that was last acted upon.
+	// +optional
+	Request string `json:"request,omitempty"`
+	// Brokers is the number of brokers in the cluster as of the last
+	// reconciliation. It is used to detect completed scale ups.
+	Brokers int32 `json:"brokers"`
+	// StartTime is the time at which the last rebalance was triggered.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time at which the last rebalance was observed to finish.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// BalancerStatus is the status of the partition balancer as last reported
+	// through the Admin API.
+	// +optional
+	BalancerStatus string `json:"balancerStatus,omitempty"`
+	// MovingPartitions is the number of partitions being moved between
+	// brokers as last reported through the Admin API.
+	MovingPartitions int32 `json:"movingPartitions"`
+	// PeakMovingPartitions is the highest number of partitions observed
+	// being moved between brokers during the last rebalance.
+	// +optional
+	PeakMovingPartitions int32 `json:"peakMovingPartitions,omitempty"`
+	// UnavailableBrokers are the IDs of the brokers that the partition
+	// balancer considers to be unavailable.
+	// +optional
+	UnavailableBrokers []int32 `json:"unavailableBrokers,omitempty"`
+	// OverDiskLimitBrokers are the IDs of the brokers whose disk usage is
+	// above the partition balancer's limit.
+	// +optional
+	OverDiskLimitBrokers []int32 `json:"overDiskLimitBrokers,omitempty"`
+}
+
+// ClusterConfigChange describes a change to a cluster configuration property
+// that the operator has not applied yet.
+type ClusterConfigChange struct {
+	// Property is the name of the cluster configuration property.
+	Property string `json:"property"`
+	// Operation is the way in which the property changes.
+	// +kubebuilder:validation:Enum=added;changed;removed
+	Operation string `json:"operation"`
+	// CurrentValue is the JSON encoded current value of the property. The
+	// values of secret properties are redacted.
+	// +optional
+	CurrentValue string `json:"currentValue,omitempty"`
+	// DesiredValue is the JSON encoded desired value of the property. The
+	// values of secret properties are redacted.
+	// +optional
+	DesiredValue string `json:"desiredValue,omitempty"`
+	// NeedsRestart is true if the change only takes effect once the brokers
+	// have been restarted.
+	// +optional
+	NeedsRestart bool `json:"needsRestart,omitempty"`
+}
+
 // RedpandaStatus defines the observed state of Redpanda
 type RedpandaStatus struct {
 	// Conditions holds the conditions for the Redpanda.
@@ -149,6 +335,27 @@ type RedpandaStatus struct {
 	// +optional
 	ConfigVersion string `json:"configVersion,omitempty"`
 
+	// Volumes contains information about the PersistentVolumeClaims
+	// of the brokers that the operator may resize.
+	// +optional
+	Volumes []VolumeStatus `json:"volumes,omitempty"`
+
+	// Recovery contains information about the recovery of the cluster
+	// from Tiered Storage.
+	// +optional
+	Recovery *ClusterRecoveryStatus `json:"recovery,omitempty"`
+
+	// Rebalance contains information about the last partition rebalance
+	// triggered by the operator.
+	// +optional
+	Rebalance *PartitionRebalanceStatus `json:"rebalance,omitempty"`
+
+	// PendingConfigChanges contains the changes to the cluster configuration
+	// that have not been applied because the
+	// `operator.redpanda.com/config-sync-dry-run` annotation is set.
+	// +optional
+	PendingConfigChanges []ClusterConfigChange `json:"pendingConfigChanges,omitempty"`
+
 	// everything below here is deprecated and should be removed
 
 	// Specifies the last observed generation.
@@ -392,6 +392,170 @@ func (in *ChartRef) DeepCopy() *ChartRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigChange) DeepCopyInto(out *ClusterConfigChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigChange.
+func (in *ClusterConfigChange) DeepCopy() *ClusterConfigChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigConflict) DeepCopyInto(out *ClusterConfigConflict) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigConflict.
+func (in *ClusterConfigConflict) DeepCopy() *ClusterConfigConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigFragment) DeepCopyInto(out *ClusterConfigFragment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigFragment.
+func (in *ClusterConfigFragment) DeepCopy() *ClusterConfigFragment {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigFragment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterConfigFragment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigFragmentList) DeepCopyInto(out *ClusterConfigFragmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterConfigFragment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigFragmentList.
+func (in *ClusterConfigFragmentList) DeepCopy() *ClusterConfigFragmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigFragmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterConfigFragmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigFragmentSpec) DeepCopyInto(out *ClusterConfigFragmentSpec) {
+	*out = *in
+	out.ClusterRef = in.ClusterRef
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(ClusterConfiguration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigFragmentSpec.
+func (in *ClusterConfigFragmentSpec) DeepCopy() *ClusterConfigFragmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigFragmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigFragmentStatus) DeepCopyInto(out *ClusterConfigFragmentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedKeys != nil {
+		in, out := &in.AppliedKeys, &out.AppliedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]ClusterConfigConflict, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvalidKeys != nil {
+		in, out := &in.InvalidKeys, &out.InvalidKeys
+		*out = make([]ClusterConfigInvalidKey, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigFragmentStatus.
+func (in *ClusterConfigFragmentStatus) DeepCopy() *ClusterConfigFragmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigFragmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfigInvalidKey) DeepCopyInto(out *ClusterConfigInvalidKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfigInvalidKey.
+func (in *ClusterConfigInvalidKey) DeepCopy() *ClusterConfigInvalidKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfigInvalidKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ClusterConfiguration) DeepCopyInto(out *ClusterConfiguration) {
 	{
@@ -413,6 +577,44 @@ func (in ClusterConfiguration) DeepCopy() ClusterConfiguration {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRecovery) DeepCopyInto(out *ClusterRecovery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRecovery.
+func (in *ClusterRecovery) DeepCopy() *ClusterRecovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRecovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRecoveryStatus) DeepCopyInto(out *ClusterRecoveryStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRecoveryStatus.
+func (in *ClusterRecoveryStatus) DeepCopy() *ClusterRecoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRecoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterRef) DeepCopyInto(out *ClusterRef) {
 	*out = *in
@@ -585,6 +787,16 @@ func (in *ConfigWatcher) DeepCopyInto(out *ConfigWatcher) {
 		*out = new(v1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WatchCertificates != nil {
+		in, out := &in.WatchCertificates, &out.WatchCertificates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestartOnCertificateRotation != nil {
+		in, out := &in.RestartOnCertificateRotation, &out.RestartOnCertificateRotation
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigWatcher.
@@ -1648,6 +1860,59 @@ func (in *NodePoolStatus) DeepCopy() *NodePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionRebalanceStatus) DeepCopyInto(out *PartitionRebalanceStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UnavailableBrokers != nil {
+		in, out := &in.UnavailableBrokers, &out.UnavailableBrokers
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.OverDiskLimitBrokers != nil {
+		in, out := &in.OverDiskLimitBrokers, &out.OverDiskLimitBrokers
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionRebalanceStatus.
+func (in *PartitionRebalanceStatus) DeepCopy() *PartitionRebalanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionRebalanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionRebalancing) DeepCopyInto(out *PartitionRebalancing) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionRebalancing.
+func (in *PartitionRebalancing) DeepCopy() *PartitionRebalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionRebalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Password) DeepCopyInto(out *Password) {
 	*out = *in
@@ -2857,6 +3122,21 @@ func (in *RedpandaSpec) DeepCopyInto(out *RedpandaSpec) {
 		*out = new(Migration)
 		**out = **in
 	}
+	if in.VolumeExpansion != nil {
+		in, out := &in.VolumeExpansion, &out.VolumeExpansion
+		*out = new(VolumeExpansion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterRecovery != nil {
+		in, out := &in.ClusterRecovery, &out.ClusterRecovery
+		*out = new(ClusterRecovery)
+		**out = **in
+	}
+	if in.PartitionRebalancing != nil {
+		in, out := &in.PartitionRebalancing, &out.PartitionRebalancing
+		*out = new(PartitionRebalancing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedpandaSpec.
@@ -2889,6 +3169,28 @@ func (in *RedpandaStatus) DeepCopyInto(out *RedpandaStatus) {
 		*out = make([]NodePoolStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Recovery != nil {
+		in, out := &in.Recovery, &out.Recovery
+		*out = new(ClusterRecoveryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rebalance != nil {
+		in, out := &in.Rebalance, &out.Rebalance
+		*out = new(PartitionRebalanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingConfigChanges != nil {
+		in, out := &in.PendingConfigChanges, &out.PendingConfigChanges
+		*out = make([]ClusterConfigChange, len(*in))
+		copy(*out, *in)
+	}
 	if in.HelmReleaseReady != nil {
 		in, out := &in.HelmReleaseReady, &out.HelmReleaseReady
 		*out = new(bool)
@@ -4527,3 +4829,79 @@ func (in *UsersItems) DeepCopy() *UsersItems {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAutoscaling) DeepCopyInto(out *VolumeAutoscaling) {
+	*out = *in
+	out.MaxSize = in.MaxSize.DeepCopy()
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAutoscaling.
+func (in *VolumeAutoscaling) DeepCopy() *VolumeAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeExpansion) DeepCopyInto(out *VolumeExpansion) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(VolumeAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeExpansion.
+func (in *VolumeExpansion) DeepCopy() *VolumeExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+	*out = *in
+	out.RequestedSize = in.RequestedSize.DeepCopy()
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.UsagePercent != nil {
+		in, out := &in.UsagePercent, &out.UsagePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastExpansionTime != nil {
+		in, out := &in.LastExpansionTime, &out.LastExpansionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
+func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
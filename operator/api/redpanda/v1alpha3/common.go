@@ -37,10 +37,14 @@ type ValueSource struct {
 	Expr            Expr                         `json:"expr,omitempty"`
 }
 
-// CEL Expr for more complex values
+// CEL Expr for more complex values, evaluated for each broker.
+// Besides the standard CEL functions, expressions have access to the pod
+// they're evaluated for through the `ordinal`, `podName`, `nodeName`,
+// `nodeLabels` and `poolName` variables.
 // Examples:
-// - rack: Expr(node_annotation('k8s.io/failure-domain')),
-// - addresses: Expr(srv_address('tcp', 'admin', 'redpanda.redpanda.cluster.svc.cluster.local'))
+// - rack: Expr(nodeLabels['topology.kubernetes.io/zone']),
+// - advertisedHost: Expr(podName + '.' + envString('DOMAIN')),
+// - advertisedPort: Expr(30092 + ordinal)
 type Expr string
 
 type PodTemplate struct {
@@ -83,8 +83,12 @@ type BrokerTemplate struct {
 	Resources corev1.ResourceRequirements `json:"resources"`
 	// Arguments to be passed to rpk tune
 	// https://docs.redpanda.com/current/reference/rpk/rpk-redpanda/rpk-redpanda-tune/
-	Tuning                    []string               `json:"tuning"`
-	NodeConfig                map[string]ValueSource `json:"nodeConfig"`
+	Tuning []string `json:"tuning"`
+	// NodeConfig sets properties of the redpanda section of redpanda.yaml, keyed
+	// by their path relative to it, e.g. rack.
+	NodeConfig map[string]ValueSource `json:"nodeConfig"`
+	// RPKConfig sets properties of the rpk section of redpanda.yaml, keyed by
+	// their path relative to it, e.g. tune_aio_events.
 	RPKConfig                 map[string]ValueSource `json:"rpkConfig"`
 	SetDataDirectoryOwnership bool                   `json:"setDataDirectoryOwnership"`
 	ValidateFilesystem        bool                   `json:"validateFilesystem"`
@@ -96,7 +100,4 @@ type BrokerTemplate struct {
 	PodTemplate          *PodTemplate                   `json:"podTemplate"`
 
 	// TODO flags??
-	// Likely to be merged into NodeConfig w/ CEL functions.
-	// rack: Expr(node_annotation('k8s.io/failure-domain')),
-	// TODO deprecate and move me into CEL functions.
 }
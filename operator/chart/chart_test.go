@@ -98,6 +98,22 @@ func TestRBACBindings(t *testing.T) {
 				Scope: ptr.To(Cluster),
 			},
 		},
+		{
+			name: "secret-namespaces",
+			values: PartialValues{
+				RBAC: &PartialRBAC{
+					SecretNamespaces: []string{"vault", "secrets"},
+				},
+			},
+		},
+		{
+			name: "client-config-namespaces",
+			values: PartialValues{
+				RBAC: &PartialRBAC{
+					ClientConfigNamespaces: []string{"apps", "tools"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -142,6 +142,7 @@ func Roles(dot *helmette.Dot) []rbacv1.Role {
 			Enabled: values.Scope == Namespace,
 			RuleFiles: []string{
 				"files/rbac/sidecar.Role.yaml", // Sidecar is a toggle on the CR, so we always need RBAC for it.
+				"files/rbac/certificate-rotation.Role.yaml",
 				"files/rbac/v2-manager.Role.yaml",
 			},
 		},
@@ -191,6 +192,55 @@ func Roles(dot *helmette.Dot) []rbacv1.Role {
 		})
 	}
 
+	// The Secrets of these namespaces may be read through
+	// --cloud-secrets-kubernetes-namespace, which is outside of the
+	// namespaces that the operator otherwise has access to.
+	for _, namespace := range values.RBAC.SecretNamespaces {
+		roles = append(roles, rbacv1.Role{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "Role",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        cleanForK8sWithSuffix(Fullname(dot), "secrets"),
+				Namespace:   namespace,
+				Labels:      Labels(dot),
+				Annotations: values.Annotations,
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets"},
+					Verbs:     []string{"get"},
+				},
+			},
+		})
+	}
+
+	// Client configuration is published to these namespaces by the
+	// operator.redpanda.com/client-config-namespaces annotation of clusters.
+	for _, namespace := range values.RBAC.ClientConfigNamespaces {
+		roles = append(roles, rbacv1.Role{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "Role",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        cleanForK8sWithSuffix(Fullname(dot), "client-config"),
+				Namespace:   namespace,
+				Labels:      Labels(dot),
+				Annotations: values.Annotations,
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"configmaps", "secrets"},
+					Verbs:     []string{"get", "create", "update", "delete"},
+				},
+			},
+		})
+	}
+
 	return roles
 }
 
@@ -248,7 +298,7 @@ func RoleBindings(dot *helmette.Dot) []rbacv1.RoleBinding {
 			},
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        role.ObjectMeta.Name,
-				Namespace:   dot.Release.Namespace,
+				Namespace:   role.ObjectMeta.Namespace,
 				Labels:      Labels(dot),
 				Annotations: values.Annotations,
 			},
@@ -7,7 +7,7 @@
 {{- $values := $dot.Values.AsMap -}}
 {{- $name := (default $dot.Chart.Name $values.nameOverride) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "operator.cleanForK8s" (dict "a" (list $name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -19,17 +19,17 @@
 {{- $values := $dot.Values.AsMap -}}
 {{- if (ne $values.fullnameOverride "") -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "operator.cleanForK8s" (dict "a" (list $values.fullnameOverride)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $values.fullnameOverride))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $name := (default $dot.Chart.Name $values.nameOverride) -}}
 {{- if (contains $name $dot.Release.Name) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "operator.cleanForK8s" (dict "a" (list $dot.Release.Name)))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $dot.Release.Name))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "operator.cleanForK8s" (dict "a" (list (printf "%s-%s" $dot.Release.Name $name))))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 (printf "%s-%s" $dot.Release.Name $name)))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -40,7 +40,7 @@
 {{- $_is_returning := false -}}
 {{- $chart := (printf "%s-%s" $dot.Chart.Name $dot.Chart.Version) -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "operator.cleanForK8s" (dict "a" (list (replace "+" "_" $chart))))) "r")) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 (replace "+" "_" $chart)))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -75,7 +75,7 @@
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (trimSuffix "-" (trunc (63 | int) $s))) | toJson -}}
+{{- (dict "r" (trimSuffix "-" (trunc 63 $s))) | toJson -}}
 {{- break -}}
 {{- end -}}
 {{- end -}}
@@ -85,8 +85,8 @@
 {{- $suffix := (index .a 1) -}}
 {{- range $_ := (list 1) -}}
 {{- $_is_returning := false -}}
-{{- $lengthToTruncate := ((sub (((add ((get (fromJson (include "_shims.len" (dict "a" (list $s)))) "r") | int) ((get (fromJson (include "_shims.len" (dict "a" (list $suffix)))) "r") | int)) | int)) (63 | int)) | int) -}}
-{{- if (gt $lengthToTruncate (0 | int)) -}}
+{{- $lengthToTruncate := ((sub ((add ((get (fromJson (include "_shims.len" (dict "a" (list $s)))) "r") | int) ((get (fromJson (include "_shims.len" (dict "a" (list $suffix)))) "r") | int)) | int) 63) | int) -}}
+{{- if (gt $lengthToTruncate 0) -}}
 {{- $s = (trunc $lengthToTruncate $s) -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -112,7 +112,7 @@
 {{- if (not (empty $overrides.spec.automountServiceAccountToken)) -}}
 {{- $_ := (set $original.spec "automountServiceAccountToken" $overrides.spec.automountServiceAccountToken) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.imagePullSecrets) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.imagePullSecrets)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.imagePullSecrets) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.imagePullSecrets)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "imagePullSecrets" $overrides.spec.imagePullSecrets) -}}
 {{- end -}}
 {{- if (not (empty $overrides.spec.serviceAccountName)) -}}
@@ -124,10 +124,10 @@
 {{- if (ne (toJson $overrides.spec.affinity) "null") -}}
 {{- $_ := (set $original.spec "affinity" (merge (dict) $overrides.spec.affinity (default (mustMergeOverwrite (dict) (dict)) $original.spec.affinity))) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.topologySpreadConstraints) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.topologySpreadConstraints)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.topologySpreadConstraints) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.topologySpreadConstraints)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "topologySpreadConstraints" $overrides.spec.topologySpreadConstraints) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.volumes) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.volumes)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.volumes) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.volumes)))) "r") | int) 0)) -}}
 {{- $newVolumes := (list) -}}
 {{- $overrideVolumes := (dict) -}}
 {{- range $i, $_ := $overrides.spec.volumes -}}
@@ -203,10 +203,10 @@
 {{- if (not (empty $overrides.spec.schedulerName)) -}}
 {{- $_ := (set $original.spec "schedulerName" $overrides.spec.schedulerName) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.tolerations) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.tolerations)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.tolerations) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.tolerations)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "tolerations" $overrides.spec.tolerations) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.hostAliases) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.hostAliases)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.hostAliases) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.hostAliases)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "hostAliases" $overrides.spec.hostAliases) -}}
 {{- end -}}
 {{- if (not (empty $overrides.spec.priorityClassName)) -}}
@@ -218,7 +218,7 @@
 {{- if (ne (toJson $overrides.spec.dnsConfig) "null") -}}
 {{- $_ := (set $original.spec "dnsConfig" (merge (dict) $overrides.spec.dnsConfig (default (mustMergeOverwrite (dict) (dict)) $original.spec.dnsConfig))) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.readinessGates) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.readinessGates)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.readinessGates) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.readinessGates)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "readinessGates" $overrides.spec.readinessGates) -}}
 {{- end -}}
 {{- if (not (empty $overrides.spec.runtimeClassName)) -}}
@@ -236,10 +236,10 @@
 {{- if (ne (toJson $overrides.spec.hostUsers) "null") -}}
 {{- $_ := (set $original.spec "hostUsers" $overrides.spec.hostUsers) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.schedulingGates) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.schedulingGates)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.schedulingGates) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.schedulingGates)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "schedulingGates" $overrides.spec.schedulingGates) -}}
 {{- end -}}
-{{- if (and (ne (toJson $overrides.spec.resourceClaims) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.resourceClaims)))) "r") | int) (0 | int))) -}}
+{{- if (and (ne (toJson $overrides.spec.resourceClaims) "null") (gt ((get (fromJson (include "_shims.len" (dict "a" (list $overrides.spec.resourceClaims)))) "r") | int) 0)) -}}
 {{- $_ := (set $original.spec "resourceClaims" $overrides.spec.resourceClaims) -}}
 {{- end -}}
 {{- $merged := (coalesce nil) -}}
@@ -12,7 +12,7 @@
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
-{{- (dict "r" (get (fromJson (include "operator.Fullname" (dict "a" (list $dot)))) "r")) | toJson -}}
+{{- (include "operator.Fullname" (dict "a" (list $dot))) -}}
 {{- break -}}
 {{- end -}}
 {{- $_is_returning = true -}}
@@ -57,7 +57,7 @@ type Values struct {
 	PodTemplate        *PodTemplateSpec              `json:"podTemplate,omitempty"`
 	LivenessProbe      *corev1.Probe                 `json:"livenessProbe,omitempty"`
 	ReadinessProbe     *corev1.Probe                 `json:"readinessProbe,omitempty"`
-	Scope              OperatorScope                 `json:"scope" jsonschema:"required,pattern=^(Namespace|Cluster)$,description=Sets the scope of the Redpanda Operator."`
+	Scope              OperatorScope                 `json:"scope" jsonschema:"required,description=Sets the scope of the Redpanda Operator."`
 	CRDs               CRDs                          `json:"crds"`
 }
 
@@ -76,11 +76,13 @@ type Metadata struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Namespace;Cluster
 type OperatorScope string
 
 type Image struct {
-	Repository string            `json:"repository"`
-	PullPolicy corev1.PullPolicy `json:"pullPolicy" jsonschema:"required,pattern=^(Always|Never|IfNotPresent)$,description=The Kubernetes Pod image pull policy."`
+	Repository string `json:"repository"`
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	PullPolicy corev1.PullPolicy `json:"pullPolicy" jsonschema:"required,description=The Kubernetes Pod image pull policy."`
 	Tag        *string           `json:"tag,omitempty"`
 }
 
@@ -117,9 +119,11 @@ type LeaderElectionConfig struct {
 }
 
 type RBAC struct {
-	Create                        bool `json:"create"`
-	CreateAdditionalControllerCRs bool `json:"createAdditionalControllerCRs"`
-	CreateRPKBundleCRs            bool `json:"createRPKBundleCRs"`
+	Create                        bool     `json:"create"`
+	CreateAdditionalControllerCRs bool     `json:"createAdditionalControllerCRs"`
+	CreateRPKBundleCRs            bool     `json:"createRPKBundleCRs"`
+	SecretNamespaces              []string `json:"secretNamespaces"`
+	ClientConfigNamespaces        []string `json:"clientConfigNamespaces"`
 }
 
 type Webhook struct {
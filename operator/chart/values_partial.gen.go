@@ -45,13 +45,13 @@ type PartialValues struct {
 	PodTemplate        *PartialPodTemplateSpec       "json:\"podTemplate,omitempty\""
 	LivenessProbe      *corev1.Probe                 "json:\"livenessProbe,omitempty\""
 	ReadinessProbe     *corev1.Probe                 "json:\"readinessProbe,omitempty\""
-	Scope              *OperatorScope                "json:\"scope,omitempty\" jsonschema:\"required,pattern=^(Namespace|Cluster)$,description=Sets the scope of the Redpanda Operator.\""
+	Scope              *OperatorScope                "json:\"scope,omitempty\" jsonschema:\"required,description=Sets the scope of the Redpanda Operator.\""
 	CRDs               *PartialCRDs                  "json:\"crds,omitempty\""
 }
 
 type PartialImage struct {
 	Repository *string            "json:\"repository,omitempty\""
-	PullPolicy *corev1.PullPolicy "json:\"pullPolicy,omitempty\" jsonschema:\"required,pattern=^(Always|Never|IfNotPresent)$,description=The Kubernetes Pod image pull policy.\""
+	PullPolicy *corev1.PullPolicy "json:\"pullPolicy,omitempty\" jsonschema:\"required,description=The Kubernetes Pod image pull policy.\""
 	Tag        *string            "json:\"tag,omitempty\""
 }
 
@@ -65,9 +65,11 @@ type PartialConfig struct {
 }
 
 type PartialRBAC struct {
-	Create                        *bool "json:\"create,omitempty\""
-	CreateAdditionalControllerCRs *bool "json:\"createAdditionalControllerCRs,omitempty\""
-	CreateRPKBundleCRs            *bool "json:\"createRPKBundleCRs,omitempty\""
+	Create                        *bool    "json:\"create,omitempty\""
+	CreateAdditionalControllerCRs *bool    "json:\"createAdditionalControllerCRs,omitempty\""
+	CreateRPKBundleCRs            *bool    "json:\"createRPKBundleCRs,omitempty\""
+	SecretNamespaces              []string "json:\"secretNamespaces,omitempty\""
+	ClientConfigNamespaces        []string "json:\"clientConfigNamespaces,omitempty\""
 }
 
 type PartialWebhook struct {
@@ -0,0 +1,342 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package backup contains commands for exporting the logical metadata of a
+// cluster (topics, users, ACLs, schemas and cluster configuration) and for
+// replaying it into another cluster.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	rpkconfig "github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	internalclient "github.com/redpanda-data/redpanda-operator/operator/pkg/client"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/client/backup"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/log"
+)
+
+const defaultKey = "backup.yaml"
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Export or restore the metadata of a Redpanda cluster",
+		Long: `backup exports the logical metadata of a cluster (topics and their configuration,
+SCRAM users, ACLs, schema registry subjects and cluster configuration) into a
+versioned document and replays it into a cluster. Backups don't contain any
+record data or user passwords and are independent of tiered storage.`,
+	}
+
+	cmd.AddCommand(createCommand(), restoreCommand())
+
+	return cmd
+}
+
+func createCommand() *cobra.Command {
+	var redpandaYAMLPath string
+	var location store
+
+	cmd := &cobra.Command{
+		Use:          "create",
+		Short:        "Export the metadata of a Redpanda cluster",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := location.validate(); err != nil {
+				return err
+			}
+
+			client, err := backupClient(ctx, redpandaYAMLPath)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			document, err := client.Export(ctx)
+			if err != nil {
+				return err
+			}
+
+			data, err := document.Marshal()
+			if err != nil {
+				return err
+			}
+
+			if err := location.write(ctx, data); err != nil {
+				return err
+			}
+
+			log.FromContext(ctx).Info("created backup", "location", location.String(), "topics", len(document.Topics), "users", len(document.Users), "acls", len(document.ACLs), "subjects", len(document.Subjects))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&redpandaYAMLPath, "redpanda-yaml", "/etc/redpanda/redpanda.yaml", "Path to redpanda.yaml whose rpk stanza will be used for connecting to the Redpanda cluster to back up.")
+	location.bindFlags(cmd.Flags())
+
+	return cmd
+}
+
+func restoreCommand() *cobra.Command {
+	var redpandaYAMLPath string
+	var passwordsPath string
+	var location store
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Replay a backup into a Redpanda cluster",
+		Long: `restore replays a backup into a Redpanda cluster. Restoring is idempotent and may
+safely be retried. As passwords are never backed up, users are only restored if
+their password is provided through --passwords-file.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := location.validate(); err != nil {
+				return err
+			}
+
+			passwords, err := loadPasswords(passwordsPath)
+			if err != nil {
+				return err
+			}
+
+			data, err := location.read(ctx)
+			if err != nil {
+				return err
+			}
+
+			document, err := backup.Unmarshal(data)
+			if err != nil {
+				return err
+			}
+
+			client, err := backupClient(ctx, redpandaYAMLPath)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if err := client.Restore(ctx, document, passwords); err != nil {
+				return err
+			}
+
+			log.FromContext(ctx).Info("restored backup", "location", location.String(), "created_at", document.CreatedAt)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&redpandaYAMLPath, "redpanda-yaml", "/etc/redpanda/redpanda.yaml", "Path to redpanda.yaml whose rpk stanza will be used for connecting to the Redpanda cluster to restore into.")
+	cmd.Flags().StringVar(&passwordsPath, "passwords-file", "", "Path to a file of user:password[:mechanism] lines containing the passwords of the users to restore.")
+	location.bindFlags(cmd.Flags())
+
+	return cmd
+}
+
+func backupClient(ctx context.Context, redpandaYAMLPath string) (*backup.Client, error) {
+	params := rpkconfig.Params{ConfigFlag: redpandaYAMLPath}
+
+	config, err := params.Load(afero.NewOsFs())
+	if err != nil {
+		return nil, errors.Wrap(err, "loading profile")
+	}
+
+	return internalclient.NewRPKOnlyFactory().Backup(ctx, config.VirtualProfile())
+}
+
+// loadPasswords reads a file in the same user:password[:mechanism] format as
+// the superusers secret of the redpanda chart.
+func loadPasswords(path string) (map[string]backup.Credentials, error) {
+	credentials := map[string]backup.Credentials{}
+	if path == "" {
+		return credentials, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	i := 0
+	for scanner.Scan() {
+		i++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		tokens := strings.Split(line, ":")
+		if len(tokens) != 2 && len(tokens) != 3 {
+			return nil, errors.Newf("malformatted line number %d in file %q", i, path)
+		}
+
+		creds := backup.Credentials{Password: tokens[1]}
+		if len(tokens) == 3 {
+			creds.Mechanism = tokens[2]
+			if creds.Mechanism != rpadmin.ScramSha256 && creds.Mechanism != rpadmin.ScramSha512 {
+				return nil, errors.Newf("unsupported mechanism %q on line number %d in file %q", creds.Mechanism, i, path)
+			}
+		}
+
+		credentials[tokens[0]] = creds
+	}
+
+	return credentials, nil
+}
+
+// store is the location that a backup is written to or read from: either a
+// local file (such as one on a mounted volume), a Secret or a ConfigMap.
+type store struct {
+	file      string
+	secret    string
+	configMap string
+	namespace string
+	key       string
+}
+
+func (s *store) bindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&s.file, "file", "", "Path of the file holding the backup.")
+	flags.StringVar(&s.secret, "secret", "", "Name of the Secret holding the backup.")
+	flags.StringVar(&s.configMap, "configmap", "", "Name of the ConfigMap holding the backup.")
+	flags.StringVar(&s.namespace, "namespace", "default", "Namespace of the Secret or ConfigMap holding the backup.")
+	flags.StringVar(&s.key, "key", defaultKey, "Key of the Secret or ConfigMap holding the backup.")
+}
+
+func (s *store) validate() error {
+	set := 0
+	for _, value := range []string{s.file, s.secret, s.configMap} {
+		if value != "" {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return errors.New("exactly one of --file, --secret or --configmap must be specified")
+	}
+
+	return nil
+}
+
+func (s *store) String() string {
+	switch {
+	case s.secret != "":
+		return fmt.Sprintf("secret/%s/%s", s.namespace, s.secret)
+	case s.configMap != "":
+		return fmt.Sprintf("configmap/%s/%s", s.namespace, s.configMap)
+	default:
+		return s.file
+	}
+}
+
+func (s *store) write(ctx context.Context, data []byte) error {
+	if s.file != "" {
+		return errors.WithStack(os.WriteFile(s.file, data, 0o600))
+	}
+
+	k8sClient, err := s.kubeClient()
+	if err != nil {
+		return err
+	}
+
+	if s.secret != "" {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.secret}}
+		_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, secret, func() error {
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			secret.Data[s.key] = data
+			return nil
+		})
+		return errors.WithStack(err)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.configMap}}
+	_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, configMap, func() error {
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[s.key] = string(data)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (s *store) read(ctx context.Context) ([]byte, error) {
+	if s.file != "" {
+		data, err := os.ReadFile(s.file)
+		return data, errors.WithStack(err)
+	}
+
+	k8sClient, err := s.kubeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.secret != "" {
+		var secret corev1.Secret
+		if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: s.namespace, Name: s.secret}, &secret); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		data, ok := secret.Data[s.key]
+		if !ok {
+			return nil, errors.Newf("key %q not found in %s", s.key, s.String())
+		}
+		return data, nil
+	}
+
+	var configMap corev1.ConfigMap
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: s.namespace, Name: s.configMap}, &configMap); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	data, ok := configMap.Data[s.key]
+	if !ok {
+		return nil, errors.Newf("key %q not found in %s", s.key, s.String())
+	}
+	return []byte(data), nil
+}
+
+func (s *store) kubeClient() (client.Client, error) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return client.New(config, client.Options{Scheme: scheme})
+}
@@ -16,6 +16,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"path"
 
 	"github.com/spf13/cobra"
@@ -25,10 +26,19 @@ import (
 	pkgsecrets "github.com/redpanda-data/redpanda-operator/operator/pkg/secrets"
 )
 
+const (
+	// podNameEnvVar and nodeNameEnvVar hold the names of the broker's pod
+	// and of the node it's scheduled on, which value sources are evaluated
+	// for.
+	podNameEnvVar  = "HOSTNAME"
+	nodeNameEnvVar = "KUBERNETES_NODE_NAME"
+)
+
 func Command() *cobra.Command {
 	var (
 		configSrcDir  string
 		configDestDir string
+		nodeConfig    string
 
 		cloudSecretsEnabled          bool
 		cloudSecretsPrefix           string
@@ -36,6 +46,8 @@ func Command() *cobra.Command {
 		cloudSecretsAWSRoleARN       string
 		cloudSecretsGCPProjectID     string
 		cloudSecretsAzureKeyVaultURI string
+		cloudSecretsVault            pkgsecrets.VaultConfiguration
+		cloudSecretsK8sNamespace     string
 	)
 	cmd := &cobra.Command{
 		Use:   "bootstrap",
@@ -52,6 +64,10 @@ func Command() *cobra.Command {
 					cloudConfig.GCPProjectID = cloudSecretsGCPProjectID
 				} else if cloudSecretsAzureKeyVaultURI != "" {
 					cloudConfig.AzureKeyVaultURI = cloudSecretsAzureKeyVaultURI
+				} else if cloudSecretsVault.Address != "" {
+					cloudConfig.Vault = cloudSecretsVault
+				} else if cloudSecretsK8sNamespace != "" {
+					cloudConfig.KubernetesNamespace = cloudSecretsK8sNamespace
 				} else {
 					log.Fatal("Cloud secrets are enabled but configuration for cloud provider is missing or invalid")
 				}
@@ -67,6 +83,7 @@ func Command() *cobra.Command {
 				cloudExpander,
 				configSrcDir,
 				configDestDir,
+				nodeConfig,
 			)
 		},
 	}
@@ -74,6 +91,7 @@ func Command() *cobra.Command {
 	// Filesystem path-related flags
 	cmd.Flags().StringVar(&configSrcDir, "in-dir", "", "Location of bootstrap template and fixups")
 	cmd.Flags().StringVar(&configDestDir, "out-dir", "", "Target directory for .bootstrap.yaml")
+	cmd.Flags().StringVar(&nodeConfig, "node-config", "", "Location of a redpanda.yaml to apply the value sources of the template directory to, if any")
 
 	// secret store related flags
 	cmd.Flags().BoolVar(&cloudSecretsEnabled, "enable-cloud-secrets", false, "Set to true if config values can reference secrets from cloud secret store")
@@ -82,6 +100,12 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&cloudSecretsAWSRoleARN, "cloud-secrets-aws-role-arn", "", "AWS role ARN to assume when fetching secrets")
 	cmd.Flags().StringVar(&cloudSecretsGCPProjectID, "cloud-secrets-gcp-project-id", "", "GCP project ID in which the secrets are stored")
 	cmd.Flags().StringVar(&cloudSecretsAzureKeyVaultURI, "cloud-secrets-azure-key-vault-uri", "", "Azure Key Vault URI in which the secrets are stored")
+	cmd.Flags().StringVar(&cloudSecretsVault.Address, "cloud-secrets-vault-address", "", "Address of the HashiCorp Vault server in which the secrets are stored")
+	cmd.Flags().StringVar(&cloudSecretsVault.Role, "cloud-secrets-vault-role", "", "Vault role to log in as through the Kubernetes auth method")
+	cmd.Flags().StringVar(&cloudSecretsVault.AuthMount, "cloud-secrets-vault-auth-mount", pkgsecrets.DefaultVaultAuthMount, "Path at which the Kubernetes auth method is mounted in Vault")
+	cmd.Flags().StringVar(&cloudSecretsVault.KVMount, "cloud-secrets-vault-kv-mount", pkgsecrets.DefaultVaultKVMount, "Path at which the KV v2 secrets engine is mounted in Vault")
+	cmd.Flags().StringVar(&cloudSecretsVault.TokenPath, "cloud-secrets-vault-token-path", pkgsecrets.DefaultVaultTokenPath, "Path of the service account token to log in to Vault with")
+	cmd.Flags().StringVar(&cloudSecretsK8sNamespace, "cloud-secrets-kubernetes-namespace", "", "Namespace of the Kubernetes Secrets in which the secrets are stored, in which the service account must be allowed to get Secrets")
 
 	return cmd
 }
@@ -91,6 +115,7 @@ func run(
 	cloudExpander *pkgsecrets.CloudExpander,
 	configSrcDir string,
 	configDestDir string,
+	nodeConfig string,
 ) {
 	log.Print("Expanding bootstrap template file")
 
@@ -104,4 +129,17 @@ func run(
 	}
 
 	log.Printf("Bootstrap saved to: %s", configDestDir)
+
+	if nodeConfig == "" {
+		return
+	}
+
+	// Evaluate the properties of redpanda.yaml, as written by the
+	// configurator, that depend on this broker
+	err = configurator.ApplyValueSourcesFile(ctx, cloudExpander, configSrcDir, nodeConfig, os.Getenv(podNameEnvVar), os.Getenv(nodeNameEnvVar))
+	if err != nil {
+		log.Fatalf("%s", fmt.Errorf("unable to apply value sources to redpanda.yaml: %w", err))
+	}
+
+	log.Printf("Value sources applied to: %s", nodeConfig)
 }
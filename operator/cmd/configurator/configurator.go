@@ -141,6 +141,8 @@ func Command() *cobra.Command {
 		cloudSecretsAWSRoleARN       string
 		cloudSecretsGCPProjectID     string
 		cloudSecretsAzureKeyVaultURI string
+		cloudSecretsVault            pkgsecrets.VaultConfiguration
+		cloudSecretsK8sNamespace     string
 	)
 	cmd := &cobra.Command{
 		Use:     "configurator",
@@ -158,6 +160,10 @@ func Command() *cobra.Command {
 					cloudConfig.GCPProjectID = cloudSecretsGCPProjectID
 				} else if cloudSecretsAzureKeyVaultURI != "" {
 					cloudConfig.AzureKeyVaultURI = cloudSecretsAzureKeyVaultURI
+				} else if cloudSecretsVault.Address != "" {
+					cloudConfig.Vault = cloudSecretsVault
+				} else if cloudSecretsK8sNamespace != "" {
+					cloudConfig.KubernetesNamespace = cloudSecretsK8sNamespace
 				} else {
 					log.Fatal("Cloud secrets are enabled but configuration for cloud provider is missing or invalid")
 				}
@@ -182,6 +188,12 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&cloudSecretsAWSRoleARN, "cloud-secrets-aws-role-arn", "", "AWS role ARN to assume when fetching secrets")
 	cmd.Flags().StringVar(&cloudSecretsGCPProjectID, "cloud-secrets-gcp-project-id", "", "GCP project ID in which the secrets are stored")
 	cmd.Flags().StringVar(&cloudSecretsAzureKeyVaultURI, "cloud-secrets-azure-key-vault-uri", "", "Azure Key Vault URI in which the secrets are stored")
+	cmd.Flags().StringVar(&cloudSecretsVault.Address, "cloud-secrets-vault-address", "", "Address of the HashiCorp Vault server in which the secrets are stored")
+	cmd.Flags().StringVar(&cloudSecretsVault.Role, "cloud-secrets-vault-role", "", "Vault role to log in as through the Kubernetes auth method")
+	cmd.Flags().StringVar(&cloudSecretsVault.AuthMount, "cloud-secrets-vault-auth-mount", pkgsecrets.DefaultVaultAuthMount, "Path at which the Kubernetes auth method is mounted in Vault")
+	cmd.Flags().StringVar(&cloudSecretsVault.KVMount, "cloud-secrets-vault-kv-mount", pkgsecrets.DefaultVaultKVMount, "Path at which the KV v2 secrets engine is mounted in Vault")
+	cmd.Flags().StringVar(&cloudSecretsVault.TokenPath, "cloud-secrets-vault-token-path", pkgsecrets.DefaultVaultTokenPath, "Path of the service account token to log in to Vault with")
+	cmd.Flags().StringVar(&cloudSecretsK8sNamespace, "cloud-secrets-kubernetes-namespace", "", "Namespace of the Kubernetes Secrets in which the secrets are stored, in which the service account must be allowed to get Secrets")
 
 	return cmd
 }
@@ -278,6 +290,12 @@ func run(
 		log.Fatalf("%s", fmt.Errorf("unable to apply fixups to redpanda.yaml: %w", err))
 	}
 
+	// Evaluate properties that depend on this broker
+	err = applyValueSources(ctx, cfg, c.configSourceDir, c.hostName, c.nodeName, cloudExpander)
+	if err != nil {
+		log.Fatalf("%s", fmt.Errorf("unable to apply value sources to redpanda.yaml: %w", err))
+	}
+
 	cfgBytes, err := yaml.Marshal(cfg)
 	if err != nil {
 		log.Fatalf("%s", fmt.Errorf("unable to marshal the configuration: %w", err))
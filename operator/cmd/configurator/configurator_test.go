@@ -10,12 +10,21 @@
 package configurator
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/resources"
 )
 
@@ -37,6 +46,51 @@ func TestPopulateRack(t *testing.T) {
 	}
 }
 
+// TestValueSources asserts that value sources set on the node configuration
+// are rendered into its templates and evaluated for each broker.
+func TestValueSources(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := clusterconfiguration.NewConfig("ns", nil, nil)
+	for key, src := range map[string]redpandav1alpha3.ValueSource{
+		"redpanda.developer_mode":     {Value: "true"},
+		"redpanda.rack":               {Expr: `nodeLabels["topology.kubernetes.io/zone"]`},
+		"redpanda.advertised_rpc_api": {Expr: `{"address": podName + "." + poolName + ".redpanda.ns.svc", "port": 33145 + ordinal}`},
+	} {
+		require.NoError(t, cfg.Node.SetValueSource(key, src))
+	}
+	require.Error(t, cfg.Node.SetValueSource("redpanda.rack", redpandav1alpha3.ValueSource{
+		SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "rack"},
+	}))
+
+	templates, err := cfg.Templates()
+	require.NoError(t, err)
+
+	node := config.ProdDefault()
+	require.NoError(t, yaml.Unmarshal([]byte(templates[clusterconfiguration.RedpandaYamlTemplateFile]), node))
+	require.True(t, node.Redpanda.DeveloperMode)
+
+	var sources map[string]redpandav1alpha3.ValueSource
+	require.NoError(t, json.Unmarshal([]byte(templates[clusterconfiguration.RedpandaYamlValueSourcesFile]), &sources))
+	require.Len(t, sources, 2)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "redpanda-blue-2", Labels: map[string]string{labels.NodePoolKey: "blue"}},
+		Spec:       corev1.PodSpec{NodeName: "node-a"},
+	}
+	k8sNode := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a", Labels: map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}}}
+
+	factory := clusterconfiguration.StdLibFactory(ctx, nil, nil)
+	require.NoError(t, resolveValueSources(ctx, fake.NewClientBuilder().Build(), pod, k8sNode, node, sources, factory))
+	require.Equal(t, "us-east-1a", node.Redpanda.Rack)
+	require.Equal(t, &config.SocketAddress{Address: "redpanda-blue-2.blue.redpanda.ns.svc", Port: 33147}, node.Redpanda.AdvertisedRPCAPI)
+
+	// configurations without value sources don't render the file
+	templates, err = clusterconfiguration.NewConfig("ns", nil, nil).Templates()
+	require.NoError(t, err)
+	require.NotContains(t, templates, clusterconfiguration.RedpandaYamlValueSourcesFile)
+}
+
 func TestAdditionalListeners(t *testing.T) { //nolint
 	sasl := "sasl"
 	mtls := "mtls_identity"
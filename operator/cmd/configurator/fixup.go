@@ -32,6 +32,15 @@ func applyFixups[T any](ctx context.Context, config T, fn string, cloudExpander
 		Content: config,
 		Fixups:  fs,
 	}
+	if err = tpl.Fixup(celFactory(ctx, cloudExpander)); err != nil {
+		return zero, err
+	}
+	return tpl.Content, nil
+}
+
+// celFactory returns the CEL environment that fixups and value sources are
+// evaluated with.
+func celFactory(ctx context.Context, cloudExpander *secrets.CloudExpander) clusterconfiguration.CelFactory {
 	// Load the environment
 	env := make(map[string]string)
 	for _, ev := range os.Environ() {
@@ -40,9 +49,7 @@ func applyFixups[T any](ctx context.Context, config T, fn string, cloudExpander
 			env[name] = strings.Trim(val, "\n")
 		}
 	}
-	factory := clusterconfiguration.StdLibFactory(ctx, env, cloudExpander)
-	if err = tpl.Fixup(factory); err != nil {
-		return zero, err
-	}
-	return tpl.Content, nil
+	// Fixups are evaluated within the broker pods, where reading mounted
+	// files and the downward API volume is safe.
+	return clusterconfiguration.StdLibFactory(ctx, env, cloudExpander, clusterconfiguration.WithFS(os.DirFS("/")))
 }
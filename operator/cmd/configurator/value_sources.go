@@ -0,0 +1,169 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package configurator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
+	pkgsecrets "github.com/redpanda-data/redpanda-operator/operator/pkg/secrets"
+)
+
+// namespaceFile holds the namespace of the pod, as mounted along with its
+// service account token.
+const namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// ApplyValueSourcesFile evaluates the value sources in dir for the broker pod
+// podName, scheduled on nodeName, and sets the resulting properties of the
+// redpanda.yaml at fn. It is a no-op if dir holds no value sources.
+func ApplyValueSourcesFile(ctx context.Context, cloudExpander *pkgsecrets.CloudExpander, dir, fn, podName, nodeName string) error {
+	if ok, err := hasValueSources(dir); err != nil || !ok {
+		return err
+	}
+
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		return fmt.Errorf("cannot load redpanda.yaml %q: %w", fn, err)
+	}
+	cfg := config.ProdDefault()
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return fmt.Errorf("cannot unmarshal redpanda.yaml %q: %w", fn, err)
+	}
+
+	if err := applyValueSources(ctx, cfg, dir, podName, nodeName, cloudExpander); err != nil {
+		return err
+	}
+
+	content, err = yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to marshal redpanda.yaml: %w", err)
+	}
+	return os.WriteFile(fn, content, 0o600)
+}
+
+// applyValueSources evaluates the value sources of redpanda.yaml in dir for
+// the pod that runs this process and sets the resulting properties of cfg.
+// The sources of the pod's node pool take precedence over the common ones.
+func applyValueSources(ctx context.Context, cfg *config.RedpandaYaml, dir, podName, nodeName string, cloudExpander *pkgsecrets.CloudExpander) error {
+	// Pods are only retrieved if there is anything to evaluate.
+	if ok, err := hasValueSources(dir); err != nil || !ok {
+		return err
+	}
+
+	sources, err := readValueSources(path.Join(dir, clusterconfiguration.RedpandaYamlValueSourcesFile))
+	if err != nil {
+		return err
+	}
+
+	namespace, err := os.ReadFile(namespaceFile)
+	if err != nil {
+		return fmt.Errorf("unable to determine the namespace: %w", err)
+	}
+
+	k8sconfig, err := rest.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("unable to create in cluster config: %w", err)
+	}
+	reader, err := client.New(k8sconfig, client.Options{})
+	if err != nil {
+		return fmt.Errorf("unable to create client: %w", err)
+	}
+
+	var pod corev1.Pod
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: strings.TrimSpace(string(namespace)), Name: podName}, &pod); err != nil {
+		return fmt.Errorf("unable to retrieve pod: %w", err)
+	}
+
+	if pool := pod.Labels[labels.NodePoolKey]; pool != "" {
+		poolSources, err := readValueSources(path.Join(dir, clusterconfiguration.PoolValueSourcesFile(pool)))
+		if err != nil {
+			return err
+		}
+		if sources == nil {
+			sources = map[string]redpandav1alpha3.ValueSource{}
+		}
+		for key, src := range poolSources {
+			sources[key] = src
+		}
+	}
+
+	// Reading nodes requires a ClusterRole which is only granted along with
+	// rack awareness, without which nodeLabels is empty.
+	node, err := getNode(nodeName)
+	if err != nil {
+		if !apierrors.IsForbidden(err) {
+			return err
+		}
+		log.Printf("Not permitted to read node %q, evaluating value sources without its labels", nodeName)
+	}
+
+	return resolveValueSources(ctx, reader, &pod, node, cfg, sources, celFactory(ctx, cloudExpander))
+}
+
+// hasValueSources returns whether dir holds any value sources of
+// redpanda.yaml, either common or specific to a node pool.
+func hasValueSources(dir string) (bool, error) {
+	files, err := filepath.Glob(path.Join(dir, "*"+clusterconfiguration.RedpandaYamlValueSourcesFile))
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return len(files) > 0, nil
+}
+
+// readValueSources reads a file of value sources, which is harmless if it
+// doesn't exist.
+func readValueSources(fn string) (map[string]redpandav1alpha3.ValueSource, error) {
+	content, err := os.ReadFile(fn)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot load value sources file %q: %w", fn, err)
+	}
+	var sources map[string]redpandav1alpha3.ValueSource
+	if err := json.Unmarshal(content, &sources); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal value sources file %q: %w", fn, err)
+	}
+	return sources, nil
+}
+
+// resolveValueSources sets the properties of cfg that sources are keyed by to
+// their values for the given pod.
+func resolveValueSources(ctx context.Context, reader client.Reader, pod *corev1.Pod, node *corev1.Node, cfg *config.RedpandaYaml, sources map[string]redpandav1alpha3.ValueSource, factory clusterconfiguration.CelFactory) error {
+	scope, err := clusterconfiguration.PodScopeFor(pod, node)
+	if err != nil {
+		return err
+	}
+
+	resolver := clusterconfiguration.ValueSourceResolver{
+		Reader:    reader,
+		Namespace: pod.Namespace,
+		Factory:   factory,
+		Pod:       scope,
+	}
+	return resolver.ApplyNodeConfig(ctx, cfg, sources)
+}
@@ -35,6 +35,7 @@ var (
 		crds.Topic(),
 		crds.User(),
 		crds.Schema(),
+		crds.ClusterConfigFragment(),
 	}
 	experimentalCRDs = []*apiextensionsv1.CustomResourceDefinition{
 		crds.NodePool(),
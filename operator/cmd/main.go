@@ -16,6 +16,7 @@ import (
 	"github.com/fluxcd/pkg/runtime/logger"
 	"github.com/spf13/cobra"
 
+	"github.com/redpanda-data/redpanda-operator/operator/cmd/backup"
 	"github.com/redpanda-data/redpanda-operator/operator/cmd/bootstrap"
 	"github.com/redpanda-data/redpanda-operator/operator/cmd/configurator"
 	"github.com/redpanda-data/redpanda-operator/operator/cmd/crd"
@@ -44,6 +45,7 @@ var (
 
 func init() {
 	rootCmd.AddCommand(
+		backup.Command(),
 		bootstrap.Command(),
 		configurator.Command(),
 		crd.Command(),
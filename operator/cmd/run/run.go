@@ -165,6 +165,9 @@ func Command() *cobra.Command {
 		cloudSecretsAWSRoleARN              string
 		cloudSecretsGCPProjectID            string
 		cloudSecretsAzureKeyVaultURI        string
+		cloudSecretsVault                   pkgsecrets.VaultConfiguration
+		cloudSecretsK8sNamespace            string
+		licenseExpiryWarningThresholds      []time.Duration
 	)
 
 	cmd := &cobra.Command{
@@ -183,6 +186,10 @@ func Command() *cobra.Command {
 					cloudConfig.GCPProjectID = cloudSecretsGCPProjectID
 				} else if cloudSecretsAzureKeyVaultURI != "" {
 					cloudConfig.AzureKeyVaultURI = cloudSecretsAzureKeyVaultURI
+				} else if cloudSecretsVault.Address != "" {
+					cloudConfig.Vault = cloudSecretsVault
+				} else if cloudSecretsK8sNamespace != "" {
+					cloudConfig.KubernetesNamespace = cloudSecretsK8sNamespace
 				} else {
 					return errors.New("Cloud secrets are enabled but configuration for cloud provider is missing or invalid")
 				}
@@ -232,7 +239,10 @@ func Command() *cobra.Command {
 				cloudSecretsAWSRoleARN,
 				cloudSecretsGCPProjectID,
 				cloudSecretsAzureKeyVaultURI,
+				cloudSecretsVault,
+				cloudSecretsK8sNamespace,
 				rpClientTimeout,
+				licenseExpiryWarningThresholds,
 			)
 		},
 	}
@@ -254,6 +264,7 @@ func Command() *cobra.Command {
 	cmd.Flags().DurationVar(&decommissionWaitInterval, "decommission-wait-interval", 8*time.Second, "Set the time to wait for a node decommission to happen in the cluster")
 	cmd.Flags().DurationVar(&metricsTimeout, "metrics-timeout", 8*time.Second, "Set the timeout for a checking metrics Admin API endpoint. If set to 0, then the 2 seconds default will be used")
 	cmd.Flags().DurationVar(&rpClientTimeout, "cluster-connection-timeout", 10*time.Second, "Set the timeout for internal clients used to connect to Redpanda clusters")
+	cmd.Flags().DurationSliceVar(&licenseExpiryWarningThresholds, "license-expiry-warning-thresholds", redpandacontrollers.DefaultLicenseExpiryWarningThresholds, "Set the durations before a license expires at which Redpanda clusters warn about the expiration")
 	cmd.Flags().BoolVar(&vectorizedv1alpha1.AllowDownscalingInWebhook, "allow-downscaling", true, "Allow to reduce the number of replicas in existing clusters")
 	cmd.Flags().Bool("allow-pvc-deletion", false, "Deprecated: Ignored if specified")
 	cmd.Flags().BoolVar(&vectorizedv1alpha1.AllowConsoleAnyNamespace, "allow-console-any-ns", false, "Allow to create Console in any namespace. Allowing this copies Redpanda SchemaRegistry TLS Secret to namespace (alpha feature)")
@@ -284,6 +295,12 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&cloudSecretsAWSRoleARN, "cloud-secrets-aws-role-arn", "", "AWS role ARN to assume when fetching secrets")
 	cmd.Flags().StringVar(&cloudSecretsGCPProjectID, "cloud-secrets-gcp-project-id", "", "GCP project ID in which the secrets are stored")
 	cmd.Flags().StringVar(&cloudSecretsAzureKeyVaultURI, "cloud-secrets-azure-key-vault-uri", "", "Azure Key Vault URI in which the secrets are stored")
+	cmd.Flags().StringVar(&cloudSecretsVault.Address, "cloud-secrets-vault-address", "", "Address of the HashiCorp Vault server in which the secrets are stored")
+	cmd.Flags().StringVar(&cloudSecretsVault.Role, "cloud-secrets-vault-role", "", "Vault role to log in as through the Kubernetes auth method")
+	cmd.Flags().StringVar(&cloudSecretsVault.AuthMount, "cloud-secrets-vault-auth-mount", pkgsecrets.DefaultVaultAuthMount, "Path at which the Kubernetes auth method is mounted in Vault")
+	cmd.Flags().StringVar(&cloudSecretsVault.KVMount, "cloud-secrets-vault-kv-mount", pkgsecrets.DefaultVaultKVMount, "Path at which the KV v2 secrets engine is mounted in Vault")
+	cmd.Flags().StringVar(&cloudSecretsVault.TokenPath, "cloud-secrets-vault-token-path", pkgsecrets.DefaultVaultTokenPath, "Path of the service account token to log in to Vault with")
+	cmd.Flags().StringVar(&cloudSecretsK8sNamespace, "cloud-secrets-kubernetes-namespace", "", "Namespace of the Kubernetes Secrets in which the secrets are stored, in which the service account must be allowed to get Secrets")
 
 	// Deprecated flags.
 	cmd.Flags().Bool("debug", false, "A deprecated and unused flag")
@@ -350,7 +367,10 @@ func Run(
 	cloudSecretsAWSRoleARN string,
 	cloudSecretsGCPProjectID string,
 	cloudSecretsAzureKeyVaultURI string,
+	cloudSecretsVault pkgsecrets.VaultConfiguration,
+	cloudSecretsK8sNamespace string,
 	rpClientTimeout time.Duration,
+	licenseExpiryWarningThresholds []time.Duration,
 ) error {
 	setupLog := ctrl.LoggerFrom(ctx).WithName("setup")
 
@@ -479,6 +499,8 @@ func Run(
 		CloudSecretsAWSRoleARN:       cloudSecretsAWSRoleARN,
 		CloudSecretsGCPProjectID:     cloudSecretsGCPProjectID,
 		CloudSecretsAzureKeyVaultURI: cloudSecretsAzureKeyVaultURI,
+		CloudSecretsVault:            cloudSecretsVault,
+		CloudSecretsK8sNamespace:     cloudSecretsK8sNamespace,
 	}
 
 	// init running state values if we are not in operator mode
@@ -590,6 +612,8 @@ func Run(
 			CloudSecretsAWSRoleARN:       cloudSecretsAWSRoleARN,
 			CloudSecretsGCPProjectID:     cloudSecretsGCPProjectID,
 			CloudSecretsAzureKeyVaultURI: cloudSecretsAzureKeyVaultURI,
+			CloudSecretsVault:            cloudSecretsVault,
+			CloudSecretsK8sNamespace:     cloudSecretsK8sNamespace,
 		}
 		redpandaImage := lifecycle.Image{
 			Repository: configuratorBaseImage,
@@ -598,12 +622,13 @@ func Run(
 
 		// Redpanda Reconciler
 		if err = (&redpandacontrollers.RedpandaReconciler{
-			KubeConfig:           mgr.GetConfig(),
-			Client:               mgr.GetClient(),
-			EventRecorder:        mgr.GetEventRecorderFor("RedpandaReconciler"),
-			LifecycleClient:      lifecycle.NewResourceClient(mgr, lifecycle.V2ResourceManagers(redpandaImage, cloudSecrets)),
-			ClientFactory:        factory,
-			CloudSecretsExpander: cloudExpander,
+			KubeConfig:                     mgr.GetConfig(),
+			Client:                         mgr.GetClient(),
+			EventRecorder:                  mgr.GetEventRecorderFor("RedpandaReconciler"),
+			LifecycleClient:                lifecycle.NewResourceClient(mgr, lifecycle.V2ResourceManagers(redpandaImage, cloudSecrets)),
+			ClientFactory:                  factory,
+			CloudSecretsExpander:           cloudExpander,
+			LicenseExpiryWarningThresholds: licenseExpiryWarningThresholds,
 		}).SetupWithManager(ctx, mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Redpanda")
 			return err
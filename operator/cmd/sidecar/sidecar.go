@@ -29,8 +29,17 @@ import (
 	internalclient "github.com/redpanda-data/redpanda-operator/operator/pkg/client"
 )
 
+const (
+	certificateRotationNone    = "none"
+	certificateRotationRestart = "restart"
+)
+
 // +kubebuilder:rbac:groups=coordination.k8s.io,namespace=default,resources=leases,verbs=get;list;watch;create;update;patch;delete
 
+// The bootstrap init container of brokers reads its own Pod to evaluate the
+// value sources of redpanda.yaml.
+// +kubebuilder:rbac:groups=core,namespace=default,resources=pods,verbs=get
+
 var schemes = []func(s *runtime.Scheme) error{
 	clientgoscheme.AddToScheme,
 }
@@ -49,6 +58,10 @@ func Command() *cobra.Command {
 		redpandaYAMLPath           string
 		usersDirectoryPath         string
 		watchUsers                 bool
+		watchCertificates          bool
+		certificatesDirectoryPath  string
+		certificateRotationAction  string
+		podName                    string
 		runDecommissioner          bool
 		runBrokerProbe             bool
 		brokerProbeShutdownTimeout time.Duration
@@ -78,6 +91,10 @@ func Command() *cobra.Command {
 				redpandaYAMLPath,
 				usersDirectoryPath,
 				watchUsers,
+				watchCertificates,
+				certificatesDirectoryPath,
+				certificateRotationAction,
+				podName,
 				runDecommissioner,
 				runBrokerProbe,
 				brokerProbeShutdownTimeout,
@@ -111,6 +128,12 @@ func Command() *cobra.Command {
 	cmd.Flags().BoolVar(&watchUsers, "watch-users", false, "Specifies if the sidecar should watch and configure superusers based on a mounted users file.")
 	cmd.Flags().StringVar(&usersDirectoryPath, "users-directory", "/etc/secrets/users/", "Path to users directory where secrets are mounted.")
 
+	// certificate flags
+	cmd.Flags().BoolVar(&watchCertificates, "watch-certificates", false, "Specifies if the sidecar should watch the certificates of the broker's listeners, exporting their expiration and handling their rotation.")
+	cmd.Flags().StringVar(&certificatesDirectoryPath, "certificates-directory", "/etc/tls/certs", "Path to the directory where listener certificates are mounted.")
+	cmd.Flags().StringVar(&certificateRotationAction, "certificate-rotation-action", certificateRotationNone, "The action to take once listener certificates are rotated. One of \"none\" or \"restart\", which requests the operator to restart the broker and requires the permission to patch the broker's Pod.")
+	cmd.Flags().StringVar(&podName, "pod-name", "", "The name of the Pod the sidecar runs in, required by --certificate-rotation-action=restart.")
+
 	// broker probe flags
 	cmd.Flags().BoolVar(&runBrokerProbe, "run-broker-probe", false, "Specifies if the sidecar should run the health probe.")
 	cmd.Flags().StringVar(&brokerProbeAddr, "broker-probe-bind-address", ":8093", "The address the broker probe endpoint binds to.")
@@ -142,6 +165,10 @@ func Run(
 	redpandaYAMLPath string,
 	usersDirectoryPath string,
 	watchUsers bool,
+	watchCertificates bool,
+	certificatesDirectoryPath string,
+	certificateRotationAction string,
+	podName string,
 	runDecommissioner bool,
 	runBrokerProbe bool,
 	brokerProbeShutdownTimeout time.Duration,
@@ -241,11 +268,38 @@ func Run(
 		}
 	}
 
-	if watchUsers {
-		watcher := configwatcher.NewConfigWatcher(mgr.GetLogger(), true,
+	if watchUsers || watchCertificates {
+		// an empty users directory disables syncing users
+		if !watchUsers {
+			usersDirectoryPath = ""
+		}
+
+		options := []configwatcher.Option{
 			configwatcher.WithRedpandaConfigPath(redpandaYAMLPath),
 			configwatcher.WithUsersDirectory(usersDirectoryPath),
-		)
+		}
+		if watchCertificates {
+			options = append(options, configwatcher.WithCertificatesDirectory(certificatesDirectoryPath))
+
+			switch certificateRotationAction {
+			case certificateRotationNone:
+			case certificateRotationRestart:
+				if podName == "" {
+					err := errors.New("must specify -pod-name to restart the broker on certificate rotation")
+					setupLog.Error(err, "no pod name provided")
+					return err
+				}
+				options = append(options, configwatcher.WithCertificateRotationHandler(
+					configwatcher.RequestRestart(mgr.GetClient(), clusterNamespace, podName),
+				))
+			default:
+				err := errors.Newf("invalid certificate rotation action %q", certificateRotationAction)
+				setupLog.Error(err, "invalid -certificate-rotation-action")
+				return err
+			}
+		}
+
+		watcher := configwatcher.NewConfigWatcher(mgr.GetLogger(), true, options...)
 		if err := mgr.Add(watcher); err != nil {
 			setupLog.Error(err, "unable to run config watcher")
 			return err
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"io"
 	"maps"
 	"os"
 	"path/filepath"
@@ -31,6 +32,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/cockroachdb/errors"
 	"github.com/redpanda-data/common-go/rpadmin"
@@ -53,12 +55,17 @@ func Command() *cobra.Command {
 	var usersDirectoryPath string
 	var redpandaYAMLPath string
 	var bootstrapYAMLPath string
+	var dryRun bool
 	mergeMode := "additive"
+	output := "table"
 
 	cmd := &cobra.Command{
-		Use: "sync-cluster-config [--bootstrap-yaml file] [--redpanda-yaml file] [--users-directory file] [--mode mode]",
+		Use: "sync-cluster-config [--bootstrap-yaml file] [--redpanda-yaml file] [--users-directory file] [--mode mode] [--dry-run [--output format]]",
 		Long: fmt.Sprintf(`sync-cluster-config patches a cluster's configuration with values from the provided bootstrap.yaml.
 If present and not empty, the $%s environment variable will be set as the cluster's license.
+
+With --dry-run, the changes that would be made to the cluster's configuration are printed
+instead of being applied.
 `, licenseEnvvar),
 		SilenceUsage: true, // Don't show --help when errors are returned from RunE
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -70,11 +77,36 @@ If present and not empty, the $%s environment variable will be set as the cluste
 				return err
 			}
 
+			if output != "table" && output != "json" {
+				return fmt.Errorf("unrecognised output format %q: must be one of table or json", output)
+			}
+
 			client, err := adminAPIFromRPKConfig(redpandaYAMLPath)
 			if err != nil {
 				return err
 			}
 
+			if dryRun {
+				clusterConfig, err := loadBoostrapYAML(bootstrapYAMLPath)
+				if err != nil {
+					return err
+				}
+
+				usersTXTs, err := loadUsersFiles(ctx, usersDirectoryPath)
+				if err != nil {
+					return err
+				}
+
+				syncer := Syncer{Client: client, Mode: syncerMode}
+
+				changes, err := syncer.Diff(ctx, clusterConfig, usersTXTs)
+				if err != nil {
+					return err
+				}
+
+				return printChanges(cmd.OutOrStdout(), changes, output)
+			}
+
 			if license := os.Getenv(licenseEnvvar); license == "" {
 				logger.Info(fmt.Sprintf("$%s not set. Skipping license setting...", licenseEnvvar))
 			} else {
@@ -125,10 +157,50 @@ If present and not empty, the $%s environment variable will be set as the cluste
 	cmd.Flags().StringVar(&redpandaYAMLPath, "redpanda-yaml", "/etc/redpanda/redpanda.yaml", "Path to redpanda.yaml")
 	cmd.Flags().StringVar(&bootstrapYAMLPath, "bootstrap-yaml", "/etc/redpanda/.bootstrap.yaml", "Path to .bootstrap.yaml")
 	cmd.Flags().StringVar(&mergeMode, "mode", "additive", "Specify mode: additive | declarative")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes to the cluster's configuration instead of applying them")
+	cmd.Flags().StringVar(&output, "output", "table", "Output format of --dry-run: table | json")
 
 	return cmd
 }
 
+// printChanges prints the given changes to a cluster's configuration as
+// either a table or JSON.
+func printChanges(w io.Writer, changes []PropertyChange, output string) error {
+	if output == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(changes)
+	}
+
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "No cluster config changes to apply")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROPERTY\tOPERATION\tOLD\tNEW\tNEEDS RESTART")
+	for _, change := range changes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%t\n", change.Property, change.Operation, formatValue(change.Old), formatValue(change.New), change.NeedsRestart)
+	}
+	return tw.Flush()
+}
+
+// formatValue formats a configuration value for display in a table.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		return v
+	default:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(out)
+	}
+}
+
 // adminAPIFromRPKConfig utilizes rpk's internal configuration loading to
 // connect to the admin API.
 // We've opted to re-use rpk's config format as it's already being computed in
@@ -265,22 +337,104 @@ type ClusterConfigStatus struct {
 	NeedsRestart bool
 	// Hash of the properties that need restart
 	PropertiesThatNeedRestartHash string
+	// Changes that were applied to the cluster config, if any
+	Changes []PropertyChange
 }
 
-// Sync will compare the current cluster configuration with the desired
-// configuration and apply any changes.
-//
-// If no changes are needed, it will return the highest config version from
-// reported by the brokers. If there's a change, it will return the new version
-// of the cluster config.
-func (s *Syncer) Sync(ctx context.Context, desired map[string]any, usersTXT map[string][]byte) (*ClusterConfigStatus, error) {
+// PropertyOperation is the way in which syncing changes a property.
+type PropertyOperation string
+
+const (
+	PropertyAdded   = PropertyOperation("added")
+	PropertyChanged = PropertyOperation("changed")
+	PropertyRemoved = PropertyOperation("removed")
+)
+
+// redactedValue replaces the values of secret properties in a diff.
+const redactedValue = "[REDACTED]"
+
+// PropertyChange is a single entry of the diff between the current and the
+// desired cluster configuration.
+type PropertyChange struct {
+	Property  string            `json:"property"`
+	Operation PropertyOperation `json:"operation"`
+	// Old is the current value of the property, if any.
+	Old any `json:"old,omitempty"`
+	// New is the desired value of the property, if any.
+	New any `json:"new,omitempty"`
+	// NeedsRestart is true if the change only takes effect once brokers
+	// have been restarted, as reported by the cluster's config schema.
+	NeedsRestart bool `json:"needsRestart"`
+}
+
+// syncPlan holds everything that Sync needs to apply a desired configuration.
+type syncPlan struct {
+	current rpadmin.Config
+	status  rpadmin.ConfigStatusResponse
+	schema  rpadmin.ConfigSchema
+
+	upsert  map[string]any
+	added   []string
+	changed []string
+	removed []string
+
+	hashOfConfigsThatNeedRestart string
+}
+
+func (p *syncPlan) empty() bool {
+	return len(p.added) == 0 && len(p.changed) == 0 && len(p.removed) == 0
+}
+
+// changes returns the planned changes sorted by property name. The values of
+// secret properties are redacted.
+func (p *syncPlan) changes() []PropertyChange {
+	changes := []PropertyChange{}
+
+	appendChange := func(key string, op PropertyOperation, old, desired any) {
+		meta := p.schema[key]
+		if meta.IsSecret {
+			if old != nil {
+				old = redactedValue
+			}
+			if desired != nil {
+				desired = redactedValue
+			}
+		}
+		changes = append(changes, PropertyChange{
+			Property:     key,
+			Operation:    op,
+			Old:          old,
+			New:          desired,
+			NeedsRestart: meta.NeedsRestart,
+		})
+	}
+
+	for _, key := range p.added {
+		appendChange(key, PropertyAdded, nil, p.upsert[key])
+	}
+	for _, key := range p.changed {
+		appendChange(key, PropertyChanged, p.current[key], p.upsert[key])
+	}
+	for _, key := range p.removed {
+		appendChange(key, PropertyRemoved, p.current[key], nil)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Property < changes[j].Property
+	})
+
+	return changes
+}
+
+// plan compares the current cluster configuration with the desired
+// configuration and works out which properties have to be changed.
+func (s *Syncer) plan(ctx context.Context, desired map[string]any, usersTXT map[string][]byte) (*syncPlan, error) {
 	equal := s.EqualityCheck
 	if equal == nil {
 		equal = func(_ string, desired, current any) bool {
 			return reflect.DeepEqual(desired, current)
 		}
 	}
-	logger := log.FromContext(ctx)
 
 	s.maybeMergeSuperusers(ctx, desired, usersTXT)
 
@@ -289,30 +443,28 @@ func (s *Syncer) Sync(ctx context.Context, desired map[string]any, usersTXT map[
 		return nil, err
 	}
 
-	var added []string
-	var changed []string
-	// NB: toRemove MUST default to an empty array. Otherwise redpanda will reject our request.
-	removed := []string{}
-	upsert := maps.Clone(desired)
-	status, err := s.Client.ClusterConfigStatus(ctx, true)
+	// NB: removed MUST default to an empty array. Otherwise redpanda will reject our request.
+	p := &syncPlan{current: current, removed: []string{}, upsert: maps.Clone(desired)}
+
+	p.status, err = s.Client.ClusterConfigStatus(ctx, true)
 	if err != nil {
 		return nil, err
 	}
-	schema, err := s.Client.ClusterConfigSchema(ctx)
+	p.schema, err = s.Client.ClusterConfigSchema(ctx)
 	if err != nil {
 		return nil, err
 	}
-	hashOfConfigsThatNeedRestart, err := hashConfigsThatNeedRestart(desired, schema)
+	p.hashOfConfigsThatNeedRestart, err = hashConfigsThatNeedRestart(desired, p.schema)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash config: %w", err)
 	}
 	if s.Mode == SyncerModeDeclarative {
 		for key, value := range current {
 			if currentValue, ok := desired[key]; !ok {
-				removed = append(removed, key)
+				p.removed = append(p.removed, key)
 			} else if equal(key, value, currentValue) {
 				// No change, leave it be
-				delete(upsert, key)
+				delete(p.upsert, key)
 			}
 		}
 
@@ -320,64 +472,92 @@ func (s *Syncer) Sync(ctx context.Context, desired map[string]any, usersTXT map[
 		// they will otherwise linger, since AdminAPI.Config does not return those entries.
 		// We always send requests for config status to the leader to avoid inconsistencies
 		// due to config propagation delays.
-		for i := range status {
-			for _, invalid := range status[i].Invalid {
+		for i := range p.status {
+			for _, invalid := range p.status[i].Invalid {
 				if _, ok := desired[invalid]; !ok {
-					removed = append(removed, invalid)
+					p.removed = append(p.removed, invalid)
 				}
 			}
-			for _, unknown := range status[i].Unknown {
+			for _, unknown := range p.status[i].Unknown {
 				if _, ok := desired[unknown]; !ok {
-					removed = append(removed, unknown)
+					p.removed = append(p.removed, unknown)
 				}
 			}
 		}
 	}
-	for key, value := range upsert {
+	for key, value := range p.upsert {
 		if currentValue, ok := current[key]; !ok {
-			added = append(added, key)
+			p.added = append(p.added, key)
 		} else if !equal(key, value, currentValue) {
-			changed = append(changed, key)
+			p.changed = append(p.changed, key)
 		}
 	}
-	if len(added) == 0 && len(changed) == 0 && len(removed) == 0 {
+
+	sort.Strings(p.added)
+	sort.Strings(p.changed)
+	sort.Strings(p.removed)
+	p.removed = slices.Compact(p.removed)
+
+	return p, nil
+}
+
+// Diff compares the current cluster configuration with the desired
+// configuration and returns the changes that Sync would apply, without
+// applying them.
+func (s *Syncer) Diff(ctx context.Context, desired map[string]any, usersTXT map[string][]byte) ([]PropertyChange, error) {
+	p, err := s.plan(ctx, desired, usersTXT)
+	if err != nil {
+		return nil, err
+	}
+	return p.changes(), nil
+}
+
+// Sync will compare the current cluster configuration with the desired
+// configuration and apply any changes.
+//
+// If no changes are needed, it will return the highest config version from
+// reported by the brokers. If there's a change, it will return the new version
+// of the cluster config.
+func (s *Syncer) Sync(ctx context.Context, desired map[string]any, usersTXT map[string][]byte) (*ClusterConfigStatus, error) {
+	logger := log.FromContext(ctx)
+
+	p, err := s.plan(ctx, desired, usersTXT)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.empty() {
 		logger.Info("no cluster config changes to apply")
 		// find the highest config version
 		return &ClusterConfigStatus{
-			Version: slices.MaxFunc(status, func(a, b rpadmin.ConfigStatus) int {
+			Version: slices.MaxFunc(p.status, func(a, b rpadmin.ConfigStatus) int {
 				return int(a.ConfigVersion - b.ConfigVersion)
 			}).ConfigVersion,
-			NeedsRestart:                  slices.ContainsFunc(status, func(s rpadmin.ConfigStatus) bool { return s.Restart }),
-			PropertiesThatNeedRestartHash: hashOfConfigsThatNeedRestart,
+			NeedsRestart:                  slices.ContainsFunc(p.status, func(s rpadmin.ConfigStatus) bool { return s.Restart }),
+			PropertiesThatNeedRestartHash: p.hashOfConfigsThatNeedRestart,
 		}, nil
 	}
 
 	{
-		var keys []string
-		for k := range maps.Keys(desired) {
-			keys = append(keys, k)
-		}
-		sort.Strings(added)
-		sort.Strings(changed)
-		sort.Strings(removed)
-		sort.Strings(keys)
-		logger.Info("updating cluster config", "added", added, "removed", removed, "changed", changed, "config", keys)
+		keys := slices.Sorted(maps.Keys(desired))
+		logger.Info("updating cluster config", "added", p.added, "removed", p.removed, "changed", p.changed, "config", keys)
 	}
 
-	result, err := s.Client.PatchClusterConfig(ctx, upsert, removed)
+	result, err := s.Client.PatchClusterConfig(ctx, p.upsert, p.removed)
 	if err != nil {
 		return nil, err
 	}
 
 	logger.Info("updated cluster configuration", "config_version", result.ConfigVersion)
-	status, err = s.Client.ClusterConfigStatus(ctx, true)
+	status, err := s.Client.ClusterConfigStatus(ctx, true)
 	if err != nil {
 		return nil, err
 	}
 	return &ClusterConfigStatus{
 		Version:                       int64(result.ConfigVersion),
 		NeedsRestart:                  slices.ContainsFunc(status, func(s rpadmin.ConfigStatus) bool { return s.Restart }),
-		PropertiesThatNeedRestartHash: hashOfConfigsThatNeedRestart,
+		PropertiesThatNeedRestartHash: p.hashOfConfigsThatNeedRestart,
+		Changes:                       p.changes(),
 	}, nil
 }
 
@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/yaml"
 
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/admin"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/utils/testutils"
 )
 
@@ -384,3 +385,74 @@ func TestSyncSuperusers(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncerDiff(t *testing.T) {
+	ctx := log.IntoContext(context.Background(), testr.New(t))
+
+	client := &admin.MockAdminAPI{Log: testr.New(t)}
+	client.RegisterPropertySchema("log_segment_size", rpadmin.ConfigPropertyMetadata{NeedsRestart: false})
+	client.RegisterPropertySchema("node_isolation_heartbeat_timeout", rpadmin.ConfigPropertyMetadata{NeedsRestart: true})
+	client.RegisterPropertySchema("cloud_storage_secret_key", rpadmin.ConfigPropertyMetadata{IsSecret: true})
+	client.RegisterPropertySchema("kafka_batch_max_bytes", rpadmin.ConfigPropertyMetadata{})
+
+	_, err := client.PatchClusterConfig(ctx, map[string]any{
+		"log_segment_size":         1024,
+		"cloud_storage_secret_key": "old-secret",
+		"kafka_batch_max_bytes":    10,
+	}, []string{})
+	require.NoError(t, err)
+
+	desired := map[string]any{
+		"log_segment_size":                 2048,
+		"node_isolation_heartbeat_timeout": 3000,
+		"cloud_storage_secret_key":         "new-secret",
+	}
+
+	s := Syncer{Client: client, Mode: SyncerModeAdditive}
+
+	// The mock admin API hands back values as decoded from JSON.
+	changes, err := s.Diff(ctx, maps.Clone(desired), nil)
+	require.NoError(t, err)
+	require.Equal(t, []PropertyChange{
+		{Property: "cloud_storage_secret_key", Operation: PropertyChanged, Old: redactedValue, New: redactedValue},
+		{Property: "log_segment_size", Operation: PropertyChanged, Old: json.Number("1024"), New: 2048},
+		{Property: "node_isolation_heartbeat_timeout", Operation: PropertyAdded, New: 3000, NeedsRestart: true},
+	}, changes)
+
+	// In declarative mode, unspecified properties are removed.
+	s.Mode = SyncerModeDeclarative
+
+	changes, err = s.Diff(ctx, maps.Clone(desired), nil)
+	require.NoError(t, err)
+	require.Contains(t, changes, PropertyChange{Property: "kafka_batch_max_bytes", Operation: PropertyRemoved, Old: json.Number("10")})
+
+	// Nothing has been applied.
+	require.Empty(t, client.PatchesGetter()()[1:])
+}
+
+func TestPrintChanges(t *testing.T) {
+	changes := []PropertyChange{
+		{Property: "log_segment_size", Operation: PropertyChanged, Old: 1024, New: 2048},
+		{Property: "superusers", Operation: PropertyAdded, New: []string{"admin"}, NeedsRestart: true},
+	}
+
+	var table strings.Builder
+	require.NoError(t, printChanges(&table, changes, "table"))
+	require.Equal(t, `PROPERTY          OPERATION  OLD   NEW        NEEDS RESTART
+log_segment_size  changed    1024  2048       false
+superusers        added      -     ["admin"]  true
+`, table.String())
+
+	var out strings.Builder
+	require.NoError(t, printChanges(&out, changes, "json"))
+
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, "log_segment_size", decoded[0]["property"])
+	require.Equal(t, true, decoded[1]["needsRestart"])
+
+	var empty strings.Builder
+	require.NoError(t, printChanges(&empty, nil, "table"))
+	require.Equal(t, "No cluster config changes to apply\n", empty.String())
+}
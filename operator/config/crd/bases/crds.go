@@ -104,6 +104,11 @@ func Schema() *apiextensionsv1.CustomResourceDefinition {
 	return mustT(ByName("schemas.cluster.redpanda.com"))
 }
 
+// ClusterConfigFragment returns the ClusterConfigFragment CustomResourceDefinition.
+func ClusterConfigFragment() *apiextensionsv1.CustomResourceDefinition {
+	return mustT(ByName("clusterconfigfragments.cluster.redpanda.com"))
+}
+
 // NodePool returns the NodePool CustomResourceDefinition.
 func NodePool() *apiextensionsv1.CustomResourceDefinition {
 	return mustT(ByName("nodepools.cluster.redpanda.com"))
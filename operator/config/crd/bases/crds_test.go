@@ -19,13 +19,14 @@ import (
 
 func TestCRDS(t *testing.T) {
 	names := map[string]struct{}{
-		"clusters.redpanda.vectorized.io": {},
-		"consoles.redpanda.vectorized.io": {},
-		"redpandas.cluster.redpanda.com":  {},
-		"schemas.cluster.redpanda.com":    {},
-		"topics.cluster.redpanda.com":     {},
-		"users.cluster.redpanda.com":      {},
-		"nodepools.cluster.redpanda.com":  {},
+		"clusters.redpanda.vectorized.io":             {},
+		"consoles.redpanda.vectorized.io":             {},
+		"redpandas.cluster.redpanda.com":              {},
+		"schemas.cluster.redpanda.com":                {},
+		"topics.cluster.redpanda.com":                 {},
+		"users.cluster.redpanda.com":                  {},
+		"nodepools.cluster.redpanda.com":              {},
+		"clusterconfigfragments.cluster.redpanda.com": {},
 	}
 
 	foundNames := map[string]struct{}{}
@@ -38,4 +39,5 @@ func TestCRDS(t *testing.T) {
 	require.Equal(t, "redpandas.cluster.redpanda.com", crds.Redpanda().Name)
 	require.Equal(t, "topics.cluster.redpanda.com", crds.Topic().Name)
 	require.Equal(t, "users.cluster.redpanda.com", crds.User().Name)
+	require.Equal(t, "clusterconfigfragments.cluster.redpanda.com", crds.ClusterConfigFragment().Name)
 }
@@ -0,0 +1,191 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package configwatcher
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	rpkconfig "github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
+)
+
+// +kubebuilder:rbac:groups=core,namespace=default,resources=pods,verbs=get;patch
+
+var certificateExpiration = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "redpanda_listener_certificate_expiration_timestamp_seconds",
+		Help: "Unix timestamp at which the certificate served by a listener of the broker expires",
+	}, []string{"api", "listener"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(certificateExpiration)
+}
+
+// CertificateRotationHandler is called once the certificate of any listener
+// of the broker has changed on disk.
+type CertificateRotationHandler func(ctx context.Context) error
+
+// RequestRestart returns a [CertificateRotationHandler] that requests the
+// operator to restart the broker's Pod, so that it picks up rotated
+// certificates, by annotating it with [labels.PodRestartRequestedKey]. As all
+// brokers of a cluster share their certificates, they all request a restart
+// at about the same time and the operator restarts them one at a time,
+// waiting for the cluster to be healthy in between.
+//
+// This requires the permission to patch the Pod.
+func RequestRestart(c client.Client, namespace, name string) CertificateRotationHandler {
+	return func(ctx context.Context) error {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, labels.PodRestartRequestedKey, time.Now().UTC().Format(time.RFC3339))
+
+		return c.Patch(ctx, pod, client.RawPatch(types.MergePatchType, []byte(patch)))
+	}
+}
+
+// listenerCertificate is the certificate served by a listener of the broker.
+type listenerCertificate struct {
+	API      string
+	Listener string
+	CertFile string
+}
+
+// listenerCertificates returns the certificates served by the TLS enabled
+// listeners in the given redpanda.yaml that live in the given directory.
+func listenerCertificates(config *rpkconfig.RedpandaYaml, directory string) []listenerCertificate {
+	var certificates []listenerCertificate
+	add := func(api string, listeners []rpkconfig.ServerTLS) {
+		for _, listener := range listeners {
+			if !listener.Enabled || listener.CertFile == "" {
+				continue
+			}
+			if !strings.HasPrefix(filepath.Clean(listener.CertFile), filepath.Clean(directory)+string(filepath.Separator)) {
+				continue
+			}
+			certificates = append(certificates, listenerCertificate{API: api, Listener: listener.Name, CertFile: listener.CertFile})
+		}
+	}
+
+	add("kafka", config.Redpanda.KafkaAPITLS)
+	add("admin", config.Redpanda.AdminAPITLS)
+	if config.SchemaRegistry != nil {
+		add("schema_registry", config.SchemaRegistry.SchemaRegistryAPITLS)
+	}
+	if config.Pandaproxy != nil {
+		add("http", config.Pandaproxy.PandaproxyAPITLS)
+	}
+	// the RPC server's TLS configuration isn't modeled by rpk
+	if rpc, ok := config.Redpanda.Other["rpc_server_tls"].(map[string]any); ok {
+		enabled, _ := rpc["enabled"].(bool)
+		certFile, _ := rpc["cert_file"].(string)
+		add("rpc", []rpkconfig.ServerTLS{{Name: "rpc", Enabled: enabled, CertFile: certFile}})
+	}
+
+	return certificates
+}
+
+// certificateDirectories returns the distinct directories holding the given
+// certificates, which are the directories that Kubernetes swaps the contents
+// of when the Secret they're mounted from changes.
+func certificateDirectories(certificates []listenerCertificate) []string {
+	var directories []string
+	for _, certificate := range certificates {
+		directories = append(directories, filepath.Dir(certificate.CertFile))
+	}
+	slices.Sort(directories)
+	return slices.Compact(directories)
+}
+
+// syncCertificates exports the expiration of the certificate of each
+// listener and reports whether any of them changed since the last sync.
+func (w *ConfigWatcher) syncCertificates() bool {
+	changed := false
+	for _, certificate := range w.certificates {
+		data, err := afero.ReadFile(w.fs, certificate.CertFile)
+		if err != nil {
+			w.log.Error(err, "unable to read listener certificate", "api", certificate.API, "listener", certificate.Listener, "file", certificate.CertFile)
+			continue
+		}
+
+		sum := sha256.Sum256(data)
+		if previous, ok := w.certificateSums[certificate.CertFile]; ok && !bytes.Equal(previous, sum[:]) {
+			changed = true
+		}
+		w.certificateSums[certificate.CertFile] = sum[:]
+
+		expiration, err := certificateNotAfter(data)
+		if err != nil {
+			w.log.Error(err, "unable to parse listener certificate", "api", certificate.API, "listener", certificate.Listener, "file", certificate.CertFile)
+			continue
+		}
+		certificateExpiration.WithLabelValues(certificate.API, certificate.Listener).Set(float64(expiration.Unix()))
+	}
+	return changed
+}
+
+// certificateNotAfter returns the expiration of the leaf, i.e. first,
+// certificate of a PEM encoded chain.
+func certificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, fmt.Errorf("no PEM encoded certificate found")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing certificate: %w", err)
+	}
+	return certificate.NotAfter, nil
+}
+
+// isCertificateEvent reports whether an event for the given path concerns one
+// of the watched certificate directories.
+func (w *ConfigWatcher) isCertificateEvent(path string) bool {
+	return slices.Contains(certificateDirectories(w.certificates), filepath.Dir(path))
+}
+
+// rotateCertificates calls the rotation handler if the certificate of any
+// listener changed. The handler runs in the background as it may take a while,
+// e.g. waiting for other brokers to restart, and a rotation that happens
+// while it's running is covered by it.
+func (w *ConfigWatcher) rotateCertificates(ctx context.Context) {
+	if !w.syncCertificates() {
+		return
+	}
+
+	w.log.Info("listener certificates changed")
+	if w.rotationHandler == nil || !w.rotating.TryLock() {
+		return
+	}
+
+	go func() {
+		defer w.rotating.Unlock()
+
+		if err := w.rotationHandler(ctx); err != nil {
+			w.log.Error(err, "could not handle certificate rotation")
+		}
+	}()
+}
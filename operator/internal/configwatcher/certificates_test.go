@@ -0,0 +1,143 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package configwatcher
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	rpkconfig "github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
+)
+
+func TestListenerCertificates(t *testing.T) {
+	var config rpkconfig.RedpandaYaml
+	require.NoError(t, yaml.Unmarshal([]byte(`
+redpanda:
+  kafka_api_tls:
+  - name: internal
+    enabled: true
+    cert_file: /etc/tls/certs/default/tls.crt
+  - name: default
+    enabled: true
+    cert_file: /etc/tls/certs/external/tls.crt
+  - name: disabled
+    enabled: false
+    cert_file: /etc/tls/certs/external/tls.crt
+  admin_api_tls:
+  - name: internal
+    enabled: true
+    cert_file: /somewhere/else/tls.crt
+  rpc_server_tls:
+    enabled: true
+    cert_file: /etc/tls/certs/default/tls.crt
+schema_registry:
+  schema_registry_api_tls:
+  - name: internal
+    enabled: true
+    cert_file: /etc/tls/certs/default/tls.crt
+`), &config))
+
+	certificates := listenerCertificates(&config, "/etc/tls/certs")
+	require.Equal(t, []listenerCertificate{
+		{API: "kafka", Listener: "internal", CertFile: "/etc/tls/certs/default/tls.crt"},
+		{API: "kafka", Listener: "default", CertFile: "/etc/tls/certs/external/tls.crt"},
+		{API: "schema_registry", Listener: "internal", CertFile: "/etc/tls/certs/default/tls.crt"},
+		{API: "rpc", Listener: "rpc", CertFile: "/etc/tls/certs/default/tls.crt"},
+	}, certificates)
+	require.Equal(t, []string{"/etc/tls/certs/default", "/etc/tls/certs/external"}, certificateDirectories(certificates))
+}
+
+func TestCertificateRotation(t *testing.T) {
+	ctx := context.Background()
+	fs := afero.NewMemMapFs()
+
+	writeCertificate := func(notAfter time.Time) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{SerialNumber: big.NewInt(1), NotBefore: time.Now(), NotAfter: notAfter}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fs, "/etc/tls/certs/default/tls.crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644))
+	}
+
+	rotated := make(chan struct{}, 1)
+	watcher := NewConfigWatcher(testr.New(t), true,
+		WithFs(fs),
+		WithCertificatesDirectory("/etc/tls/certs"),
+		WithCertificateRotationHandler(func(context.Context) error {
+			rotated <- struct{}{}
+			return nil
+		}),
+	)
+	watcher.certificates = []listenerCertificate{{API: "kafka", Listener: "internal", CertFile: "/etc/tls/certs/default/tls.crt"}}
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	writeCertificate(expiration)
+
+	// the initial sync only exports the expiration
+	require.False(t, watcher.syncCertificates())
+	require.Equal(t, float64(expiration.Unix()), testutil.ToFloat64(certificateExpiration.WithLabelValues("kafka", "internal")))
+
+	require.True(t, watcher.isCertificateEvent("/etc/tls/certs/default/..data"))
+	require.False(t, watcher.isCertificateEvent("/etc/secrets/users/users.txt"))
+
+	// events without changes to the certificate don't trigger the handler
+	watcher.rotateCertificates(ctx)
+	select {
+	case <-rotated:
+		t.Fatal("unexpected rotation")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	expiration = expiration.Add(24 * time.Hour)
+	writeCertificate(expiration)
+
+	watcher.rotateCertificates(ctx)
+	select {
+	case <-rotated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected rotation")
+	}
+	require.Equal(t, float64(expiration.Unix()), testutil.ToFloat64(certificateExpiration.WithLabelValues("kafka", "internal")))
+}
+
+func TestRequestRestart(t *testing.T) {
+	ctx := context.Background()
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "redpanda", Name: "redpanda-0", Annotations: map[string]string{"keep": "me"}},
+	}).Build()
+
+	require.NoError(t, RequestRestart(c, "redpanda", "redpanda-0")(ctx))
+
+	var pod corev1.Pod
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "redpanda", Name: "redpanda-0"}, &pod))
+	require.Equal(t, "me", pod.Annotations["keep"])
+	require.NotEmpty(t, pod.Annotations[labels.PodRestartRequestedKey])
+}
@@ -17,6 +17,7 @@ import (
 	"path"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -46,6 +47,12 @@ type ConfigWatcher struct {
 	fs             afero.Fs
 	log            logr.Logger
 
+	certificatesDirectory string
+	certificates          []listenerCertificate
+	certificateSums       map[string][]byte
+	rotationHandler       CertificateRotationHandler
+	rotating              sync.Mutex
+
 	// for testing mostly
 	initialized chan struct{}
 }
@@ -62,6 +69,23 @@ func WithUsersDirectory(path string) Option {
 	}
 }
 
+// WithCertificatesDirectory enables watching the certificates of the TLS
+// enabled listeners in redpanda.yaml that are mounted below the given
+// directory, exporting their expiration and handling their rotation.
+func WithCertificatesDirectory(path string) Option {
+	return func(c *ConfigWatcher) {
+		c.certificatesDirectory = path
+	}
+}
+
+// WithCertificateRotationHandler sets the handler called once listener
+// certificates have been rotated. Without one, rotations are only logged.
+func WithCertificateRotationHandler(handler CertificateRotationHandler) Option {
+	return func(c *ConfigWatcher) {
+		c.rotationHandler = handler
+	}
+}
+
 func WithFs(fs afero.Fs) Option {
 	return func(c *ConfigWatcher) {
 		c.fs = fs
@@ -82,6 +106,8 @@ func NewConfigWatcher(log logr.Logger, watch bool, options ...Option) *ConfigWat
 		usersDirectory: defaultUsersDirectory,
 		fs:             afero.NewOsFs(),
 		initialized:    make(chan struct{}),
+
+		certificateSums: map[string][]byte{},
 	}
 
 	for _, option := range options {
@@ -108,6 +134,11 @@ func (w *ConfigWatcher) Start(ctx context.Context) error {
 
 	w.adminClient = client
 
+	if w.certificatesDirectory != "" {
+		w.certificates = listenerCertificates(config.ActualRedpandaYamlOrDefaults(), w.certificatesDirectory)
+		w.syncCertificates()
+	}
+
 	close(w.initialized)
 
 	w.syncInitial(ctx)
@@ -116,6 +147,10 @@ func (w *ConfigWatcher) Start(ctx context.Context) error {
 }
 
 func (w *ConfigWatcher) syncInitial(ctx context.Context) {
+	if w.usersDirectory == "" {
+		return
+	}
+
 	files, err := os.ReadDir(w.usersDirectory)
 	if err != nil {
 		w.log.Error(err, "unable to get user directory files")
@@ -143,8 +178,16 @@ func (w *ConfigWatcher) watchFilesystem(ctx context.Context) error {
 	}
 	defer watcher.Close()
 
-	if err := watcher.Add(w.usersDirectory); err != nil {
-		return err
+	if w.usersDirectory != "" {
+		if err := watcher.Add(w.usersDirectory); err != nil {
+			return err
+		}
+	}
+
+	for _, directory := range certificateDirectories(w.certificates) {
+		if err := watcher.Add(directory); err != nil {
+			return err
+		}
 	}
 
 	for {
@@ -155,7 +198,9 @@ func (w *ConfigWatcher) watchFilesystem(ctx context.Context) error {
 			w.log.Error(err, "watcher returned an error")
 			time.Sleep(5 * time.Second)
 		case event := <-watcher.Events:
-			if strings.HasSuffix(event.Name, ".txt") {
+			if w.isCertificateEvent(event.Name) {
+				w.rotateCertificates(ctx)
+			} else if strings.HasSuffix(event.Name, ".txt") {
 				w.SyncUsers(ctx, event.Name)
 			}
 		case <-ctx.Done():
@@ -0,0 +1,621 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	rpkconfig "github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/redpanda-data/redpanda-operator/charts/redpanda/v5"
+	"github.com/redpanda-data/redpanda-operator/gotohelm/helmette"
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+)
+
+const (
+	// clientConfigInternalListener is the name under which the internal
+	// listeners of a cluster are published.
+	clientConfigInternalListener = "internal"
+	// clientConfigPublishedKey records, on the client config ConfigMap, the
+	// namespaces that copies have been published to so that they can be
+	// cleaned up once they're no longer requested.
+	clientConfigPublishedKey = "operator.redpanda.com/client-config-published-namespaces"
+	// clientConfigNamespaceLabel and clientConfigClusterLabel identify the
+	// cluster that a copy published to another namespace belongs to. Copies
+	// can't be owned by the cluster, so only labeled copies are ever
+	// overwritten or deleted.
+	clientConfigNamespaceLabel = "operator.redpanda.com/client-config-namespace"
+	clientConfigClusterLabel   = "operator.redpanda.com/client-config-cluster"
+)
+
+// clientTLS is the TLS configuration of a listener as seen by clients.
+type clientTLS struct {
+	// Cert is the name of the certificate, in the chart's tls.certs, served
+	// by the listener.
+	Cert string
+	// RequireClientAuth is set if clients must present a certificate.
+	RequireClientAuth bool
+}
+
+// clientEndpoint is an API of a cluster reachable through a listener.
+type clientEndpoint struct {
+	Addresses []string
+	// TLS is nil if the listener doesn't use TLS.
+	TLS *clientTLS
+}
+
+// clientListener groups the APIs that clients connecting through the Kafka
+// listener of the same name may use.
+type clientListener struct {
+	Name string
+	// SASL is set if the Kafka listener authenticates clients with SASL.
+	SASL  bool
+	Kafka clientEndpoint
+	// Admin and SchemaRegistry are nil if there's no listener of the same
+	// name for those APIs.
+	Admin          *clientEndpoint
+	SchemaRegistry *clientEndpoint
+}
+
+func clientConfigName(rp *redpandav1alpha2.Redpanda) string {
+	return rp.Name + "-client-config"
+}
+
+func clientCredentialsName(rp *redpandav1alpha2.Redpanda) string {
+	return rp.Name + "-client-credentials"
+}
+
+// clientConfigNamespaces returns the namespaces, other than the cluster's,
+// named by the [ClientConfigNamespacesKey] annotation.
+func clientConfigNamespaces(rp *redpandav1alpha2.Redpanda) []string {
+	return splitNamespaces(rp.Annotations[ClientConfigNamespacesKey], rp.Namespace)
+}
+
+func splitNamespaces(value, exclude string) []string {
+	var namespaces []string
+	for _, ns := range strings.Split(value, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns != "" && ns != exclude {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	slices.Sort(namespaces)
+	return slices.Compact(namespaces)
+}
+
+// clientListenersFor returns the internal listener followed by the enabled
+// external Kafka listeners of a cluster, mirroring the addresses the chart
+// advertises for them.
+func clientListenersFor(dot *helmette.Dot) []clientListener {
+	values := helmette.Unwrap[redpanda.Values](dot.Values)
+
+	fullname := redpanda.Fullname(dot)
+	internalDomain := redpanda.InternalDomain(dot)
+	replicas := values.Statefulset.Replicas
+
+	internalTLS := func(t *redpanda.InternalTLS) *clientTLS {
+		if !t.IsEnabled(&values.TLS) {
+			return nil
+		}
+		return &clientTLS{Cert: t.Cert, RequireClientAuth: t.RequireClientAuth}
+	}
+	externalTLS := func(t *redpanda.ExternalTLS, internal *redpanda.InternalTLS) *clientTLS {
+		if !t.IsEnabled(internal, &values.TLS) {
+			return nil
+		}
+		return &clientTLS{Cert: t.GetCertName(internal), RequireClientAuth: ptr.Deref(t.RequireClientAuth, false)}
+	}
+	usesSASL := func(method *redpanda.KafkaAuthenticationMethod) bool {
+		if method := ptr.Deref(method, ""); method != "" {
+			return method == "sasl"
+		}
+		return values.Auth.IsSASLEnabled()
+	}
+
+	internal := clientListener{
+		Name: clientConfigInternalListener,
+		SASL: usesSASL(values.Listeners.Kafka.AuthenticationMethod),
+		Kafka: clientEndpoint{
+			Addresses: redpanda.ServerList(replicas, "", fullname, internalDomain, values.Listeners.Kafka.Port),
+			TLS:       internalTLS(&values.Listeners.Kafka.TLS),
+		},
+		Admin: &clientEndpoint{
+			Addresses: values.Listeners.AdminList(replicas, fullname, internalDomain),
+			TLS:       internalTLS(&values.Listeners.Admin.TLS),
+		},
+	}
+	if values.Listeners.SchemaRegistry.Enabled {
+		internal.SchemaRegistry = &clientEndpoint{
+			Addresses: values.Listeners.SchemaRegistryList(replicas, fullname, internalDomain),
+			TLS:       internalTLS(&values.Listeners.SchemaRegistry.TLS),
+		}
+	}
+
+	listeners := []clientListener{internal}
+
+	for name, kafka := range helmette.SortedMap(values.Listeners.Kafka.External) {
+		if !kafka.IsEnabled() {
+			continue
+		}
+
+		listener := clientListener{
+			Name: name,
+			SASL: usesSASL(kafka.AuthenticationMethod),
+			Kafka: clientEndpoint{
+				Addresses: externalAddresses(dot, values.Listeners.Kafka.Port, kafka.Port, kafka.AdvertisedPorts),
+				TLS:       externalTLS(kafka.TLS, &values.Listeners.Kafka.TLS),
+			},
+		}
+		if admin, ok := values.Listeners.Admin.External[name]; ok && admin.IsEnabled() {
+			listener.Admin = &clientEndpoint{
+				Addresses: externalAddresses(dot, values.Listeners.Admin.Port, admin.Port, admin.AdvertisedPorts),
+				TLS:       externalTLS(admin.TLS, &values.Listeners.Admin.TLS),
+			}
+		}
+		if sr, ok := values.Listeners.SchemaRegistry.External[name]; ok && sr.IsEnabled() && values.Listeners.SchemaRegistry.Enabled {
+			listener.SchemaRegistry = &clientEndpoint{
+				Addresses: externalAddresses(dot, values.Listeners.SchemaRegistry.Port, sr.Port, sr.AdvertisedPorts),
+				TLS:       externalTLS(sr.TLS, &values.Listeners.SchemaRegistry.TLS),
+			}
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return listeners
+}
+
+// externalAddresses returns the advertised address of each broker for an
+// external listener, following the chart's advertisedHost and
+// advertised*Port helpers.
+func externalAddresses(dot *helmette.Dot, internalPort, port int32, advertisedPorts []int32) []string {
+	values := helmette.Unwrap[redpanda.Values](dot.Values)
+
+	domain := ptr.Deref(values.External.Domain, "")
+	if domain != "" {
+		domain = helmette.Tpl(dot, domain, dot)
+	}
+
+	var addresses []string
+	for i := int32(0); i < values.Statefulset.Replicas; i++ {
+		host := fmt.Sprintf("%s-%d", redpanda.Fullname(dot), i)
+		if len(values.External.Addresses) == 1 {
+			host = values.External.Addresses[0]
+		} else if len(values.External.Addresses) > 1 {
+			host = values.External.Addresses[i]
+		}
+		if domain != "" {
+			host = fmt.Sprintf("%s.%s", host, domain)
+		}
+
+		advertised := internalPort
+		if port > 1 {
+			advertised = port
+		}
+		if len(advertisedPorts) > 1 {
+			advertised = advertisedPorts[i]
+		} else if len(advertisedPorts) == 1 {
+			advertised = advertisedPorts[0]
+		}
+
+		addresses = append(addresses, fmt.Sprintf("%s:%d", host, advertised))
+	}
+	return addresses
+}
+
+// clientCertificates returns the Secrets, and the key within the first, that
+// hold the CA and the client certificate of the given certificate. It
+// mirrors the names used by the chart's client package.
+func clientCertificates(dot *helmette.Dot, cert string) (caSecret, caKey, clientSecret string) {
+	values := helmette.Unwrap[redpanda.Values](dot.Values)
+	fullname := redpanda.Fullname(dot)
+
+	caSecret = fmt.Sprintf("%s-%s-root-certificate", fullname, cert)
+	caKey = corev1.TLSCertKey
+	clientSecret = fmt.Sprintf("%s-client", fullname)
+
+	certificate, ok := values.TLS.Certs[cert]
+	if !ok || !ptr.Deref(certificate.Enabled, true) {
+		return caSecret, caKey, clientSecret
+	}
+	if certificate.ClientSecretRef != nil {
+		clientSecret = certificate.ClientSecretRef.Name
+	}
+	if certificate.SecretRef != nil {
+		caSecret = certificate.SecretRef.Name
+		if certificate.CAEnabled {
+			caKey = "ca.crt"
+		}
+	}
+	return caSecret, caKey, clientSecret
+}
+
+// rpkTLS returns the TLS section of an rpk profile for an endpoint. Paths are
+// relative to the directory the ConfigMap and Secret are mounted in.
+func rpkTLS(listener string, tls *clientTLS) *rpkconfig.TLS {
+	if tls == nil {
+		return nil
+	}
+	config := &rpkconfig.TLS{TruststoreFile: listener + ".ca.crt"}
+	if tls.RequireClientAuth {
+		config.CertFile = tls.Cert + ".tls.crt"
+		config.KeyFile = tls.Cert + ".tls.key"
+	}
+	return config
+}
+
+// rpkProfileFor returns the rpk profile for connecting through a listener.
+// Credentials are never part of it, only the SASL mechanism to use.
+func rpkProfileFor(rp *redpandav1alpha2.Redpanda, listener clientListener, mechanism string) rpkconfig.RpkProfile {
+	profile := rpkconfig.RpkProfile{
+		Name:        fmt.Sprintf("%s-%s", rp.Name, listener.Name),
+		Description: fmt.Sprintf("Redpanda cluster %s/%s through its %s listener", rp.Namespace, rp.Name, listener.Name),
+		KafkaAPI: rpkconfig.RpkKafkaAPI{
+			Brokers: listener.Kafka.Addresses,
+			TLS:     rpkTLS(listener.Name, listener.Kafka.TLS),
+		},
+	}
+	if listener.SASL {
+		profile.KafkaAPI.SASL = &rpkconfig.SASL{Mechanism: mechanism}
+	}
+	if listener.Admin != nil {
+		profile.AdminAPI = rpkconfig.RpkAdminAPI{
+			Addresses: listener.Admin.Addresses,
+			TLS:       rpkTLS(listener.Name, listener.Admin.TLS),
+		}
+	}
+	if listener.SchemaRegistry != nil {
+		profile.SR = rpkconfig.RpkSchemaRegistryAPI{
+			Addresses: listener.SchemaRegistry.Addresses,
+			TLS:       rpkTLS(listener.Name, listener.SchemaRegistry.TLS),
+		}
+	}
+	return profile
+}
+
+// kafkaClientPropertiesFor returns the Kafka client properties for
+// connecting through a listener. The JAAS configuration holding the
+// credentials is published separately, in the client credentials Secret.
+func kafkaClientPropertiesFor(listener clientListener, mechanism string) string {
+	protocol := "PLAINTEXT"
+	switch {
+	case listener.SASL && listener.Kafka.TLS != nil:
+		protocol = "SASL_SSL"
+	case listener.SASL:
+		protocol = "SASL_PLAINTEXT"
+	case listener.Kafka.TLS != nil:
+		protocol = "SSL"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "bootstrap.servers=%s\n", strings.Join(listener.Kafka.Addresses, ","))
+	fmt.Fprintf(&b, "security.protocol=%s\n", protocol)
+	if tls := listener.Kafka.TLS; tls != nil {
+		fmt.Fprintf(&b, "ssl.truststore.type=PEM\n")
+		fmt.Fprintf(&b, "ssl.truststore.location=%s.ca.crt\n", listener.Name)
+		if tls.RequireClientAuth {
+			fmt.Fprintf(&b, "ssl.keystore.type=PEM\n")
+			fmt.Fprintf(&b, "ssl.keystore.location=%s.keystore.pem\n", tls.Cert)
+		}
+	}
+	if listener.SASL {
+		fmt.Fprintf(&b, "sasl.mechanism=%s\n", mechanism)
+	}
+	return b.String()
+}
+
+// reconcileClientConfig publishes, for each listener of the cluster, an rpk
+// profile, Kafka client properties and the CA bundle to verify the brokers
+// with in the client config ConfigMap. If [ClientConfigCredentialsKey] names
+// a User of the cluster, its SASL credentials and the client certificates
+// are published in the client credentials Secret. Both are copied to the
+// namespaces listed by [ClientConfigNamespacesKey], from which they're
+// removed once unlisted or the cluster is deleted.
+func (r *RedpandaReconciler) reconcileClientConfig(ctx context.Context, rp *redpandav1alpha2.Redpanda) (err error) {
+	// Parinoided panic catch as we're calling directly into helm functions.
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Newf("recovered panic: %+v", r)
+		}
+	}()
+
+	dot, err := rp.GetDot(r.KubeConfig)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	values := helmette.Unwrap[redpanda.Values](dot.Values)
+	userName := rp.Annotations[ClientConfigCredentialsKey]
+
+	credentials := map[string][]byte{}
+	mechanism := ""
+	if values.Auth.IsSASLEnabled() {
+		mechanism = values.Auth.SASL.BootstrapUser.GetMechanism()
+
+		if userName != "" {
+			username, password, userMechanism, err := r.clientUserCredentials(ctx, rp, values.Auth.SASL.BootstrapUser.Username(), userName)
+			if err != nil {
+				return err
+			}
+
+			mechanism = userMechanism
+			module := "org.apache.kafka.common.security.scram.ScramLoginModule"
+			credentials["username"] = []byte(username)
+			credentials["password"] = []byte(password)
+			credentials["mechanism"] = []byte(mechanism)
+			credentials["sasl.jaas.config"] = []byte(fmt.Sprintf("%s required username=%q password=%q;", module, username, password))
+		}
+	}
+
+	config := map[string]string{}
+	for _, listener := range clientListenersFor(dot) {
+		profile, err := yaml.Marshal(rpkProfileFor(rp, listener, mechanism))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		config[listener.Name+".rpk.yaml"] = string(profile)
+		config[listener.Name+".client.properties"] = kafkaClientPropertiesFor(listener, mechanism)
+
+		var bundle [][]byte
+		for _, endpoint := range []*clientEndpoint{&listener.Kafka, listener.Admin, listener.SchemaRegistry} {
+			if endpoint == nil || endpoint.TLS == nil {
+				continue
+			}
+
+			caSecret, caKey, clientSecret := clientCertificates(dot, endpoint.TLS.Cert)
+			ca, err := r.secretValue(ctx, rp.Namespace, caSecret, caKey)
+			if err != nil {
+				return err
+			}
+			ca = append(bytes.TrimSpace(ca), '\n')
+			if !slices.ContainsFunc(bundle, func(b []byte) bool { return bytes.Equal(b, ca) }) {
+				bundle = append(bundle, ca)
+			}
+
+			if endpoint.TLS.RequireClientAuth && userName != "" {
+				if err := r.addClientCertificate(ctx, rp.Namespace, clientSecret, endpoint.TLS.Cert, credentials); err != nil {
+					return err
+				}
+			}
+		}
+		if len(bundle) > 0 {
+			config[listener.Name+".ca.crt"] = string(bytes.Join(bundle, nil))
+		}
+	}
+
+	var existing corev1.ConfigMap
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: rp.Namespace, Name: clientConfigName(rp)}, &existing); err != nil && !apierrors.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+	published := splitNamespaces(existing.Annotations[clientConfigPublishedKey], rp.Namespace)
+	namespaces := clientConfigNamespaces(rp)
+
+	// record all namespaces that may hold copies before touching them, so
+	// that none are forgotten if publishing fails half way
+	tracked := splitNamespaces(strings.Join(append(slices.Clone(published), namespaces...), ","), rp.Namespace)
+	if err := r.writeClientConfig(ctx, rp, rp.Namespace, config, credentials, tracked); err != nil {
+		return err
+	}
+
+	for _, ns := range namespaces {
+		if err := r.writeClientConfig(ctx, rp, ns, config, credentials, nil); err != nil {
+			return err
+		}
+	}
+
+	if slices.Equal(tracked, namespaces) {
+		return nil
+	}
+	var stale []string
+	for _, ns := range tracked {
+		if !slices.Contains(namespaces, ns) {
+			stale = append(stale, ns)
+		}
+	}
+	if err := r.deleteClientConfigCopies(ctx, rp, stale); err != nil {
+		return err
+	}
+	return r.writeClientConfig(ctx, rp, rp.Namespace, config, credentials, namespaces)
+}
+
+// clientUserCredentials returns the username, password and SASL mechanism of
+// the User with the given name in the cluster's namespace. Only Users of the
+// cluster whose credentials are managed by the operator may be published,
+// which keeps the bootstrap superuser's password out of the client
+// credentials Secret.
+func (r *RedpandaReconciler) clientUserCredentials(ctx context.Context, rp *redpandav1alpha2.Redpanda, bootstrapUser, name string) (username, password, mechanism string, err error) {
+	var user redpandav1alpha2.User
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: rp.Namespace, Name: name}, &user); err != nil {
+		return "", "", "", errors.Wrapf(err, "fetching User %s/%s", rp.Namespace, name)
+	}
+
+	if source := user.Spec.ClusterSource; source == nil || source.ClusterRef == nil || source.ClusterRef.Name != rp.Name {
+		return "", "", "", errors.Newf("User %s/%s doesn't reference cluster %s", user.Namespace, user.Name, rp.Name)
+	}
+	if user.Name == bootstrapUser {
+		return "", "", "", errors.Newf("User %s/%s is the cluster's bootstrap user", user.Namespace, user.Name)
+	}
+
+	auth := user.Spec.Authentication
+	if auth == nil || (auth.Password.Value == "" && (auth.Password.ValueFrom == nil || auth.Password.ValueFrom.SecretKeyRef == nil)) {
+		return "", "", "", errors.Newf("User %s/%s has no password managed by the operator", user.Namespace, user.Name)
+	}
+
+	password, err = auth.Password.Fetch(ctx, r.Client, user.Namespace)
+	if err != nil {
+		return "", "", "", errors.WithStack(err)
+	}
+
+	mechanism = strings.ToUpper(string(ptr.Deref(auth.Type, redpandav1alpha2.SASLMechanismScramSHA512)))
+	return user.Name, password, mechanism, nil
+}
+
+// writeClientConfig writes the client config ConfigMap and, if there are any
+// credentials, the client credentials Secret to the given namespace. Copies
+// outside of the cluster's namespace can't be owned by it and are labeled
+// instead.
+func (r *RedpandaReconciler) writeClientConfig(ctx context.Context, rp *redpandav1alpha2.Redpanda, namespace string, config map[string]string, credentials map[string][]byte, published []string) error {
+	meta := func(name string) metav1.ObjectMeta {
+		if namespace == rp.Namespace {
+			return metav1.ObjectMeta{
+				Namespace:       namespace,
+				Name:            name,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rp, redpandav1alpha2.GroupVersion.WithKind("Redpanda"))},
+			}
+		}
+		return metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				clientConfigNamespaceLabel: rp.Namespace,
+				clientConfigClusterLabel:   rp.Name,
+			},
+		}
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: meta(clientConfigName(rp)), Data: config}
+	if len(published) > 0 {
+		cm.Annotations = map[string]string{clientConfigPublishedKey: strings.Join(published, ",")}
+	}
+	if err := r.writeClientConfigObject(ctx, rp, cm); err != nil {
+		return err
+	}
+
+	if len(credentials) == 0 {
+		secret := &corev1.Secret{ObjectMeta: meta(clientCredentialsName(rp))}
+		if namespace != rp.Namespace {
+			return r.deleteClientConfigCopy(ctx, rp, secret)
+		}
+		return errors.WithStack(client.IgnoreNotFound(r.Client.Delete(ctx, secret)))
+	}
+
+	return r.writeClientConfigObject(ctx, rp, &corev1.Secret{ObjectMeta: meta(clientCredentialsName(rp)), Data: credentials})
+}
+
+// writeClientConfigObject creates or updates obj. Objects outside of the
+// cluster's namespace are only updated if they're copies of its client
+// config, so that unrelated objects of the same name are never overwritten.
+func (r *RedpandaReconciler) writeClientConfigObject(ctx context.Context, rp *redpandav1alpha2.Redpanda, obj client.Object) error {
+	if obj.GetNamespace() != rp.Namespace {
+		existing := obj.DeepCopyObject().(client.Object)
+		err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "fetching %T %s/%s", obj, obj.GetNamespace(), obj.GetName())
+		}
+		if err == nil && !isClientConfigCopy(rp, existing) {
+			return errors.Newf("%T %s/%s exists and isn't a copy of the client config of %s/%s", obj, obj.GetNamespace(), obj.GetName(), rp.Namespace, rp.Name)
+		}
+	}
+	return r.createOrUpdate(ctx, obj)
+}
+
+// isClientConfigCopy returns whether obj is labeled as a copy of the client
+// config of the given cluster.
+func isClientConfigCopy(rp *redpandav1alpha2.Redpanda, obj client.Object) bool {
+	labels := obj.GetLabels()
+	return labels[clientConfigNamespaceLabel] == rp.Namespace && labels[clientConfigClusterLabel] == rp.Name
+}
+
+// deleteClientConfigCopy deletes obj if it's a copy of the client config of
+// the given cluster. Namespaces that the operator may not access can't hold
+// any copies, which keeps revoked permissions from blocking the deletion of
+// the cluster.
+func (r *RedpandaReconciler) deleteClientConfigCopy(ctx context.Context, rp *redpandav1alpha2.Redpanda, obj client.Object) error {
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+	if !isClientConfigCopy(rp, obj) {
+		return nil
+	}
+	if err := r.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "deleting client config from namespace %q", obj.GetNamespace())
+	}
+	return nil
+}
+
+// deleteClientConfigCopies deletes the copies of the client config ConfigMap
+// and Secret from the given namespaces.
+func (r *RedpandaReconciler) deleteClientConfigCopies(ctx context.Context, rp *redpandav1alpha2.Redpanda, namespaces []string) error {
+	for _, ns := range namespaces {
+		for _, obj := range []client.Object{
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: clientConfigName(rp)}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: clientCredentialsName(rp)}},
+		} {
+			if err := r.deleteClientConfigCopy(ctx, rp, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deletePublishedClientConfig deletes all copies of the client config
+// published outside of the cluster's namespace, which aren't garbage
+// collected along with the cluster.
+func (r *RedpandaReconciler) deletePublishedClientConfig(ctx context.Context, rp *redpandav1alpha2.Redpanda) error {
+	var cm corev1.ConfigMap
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: rp.Namespace, Name: clientConfigName(rp)}, &cm); err != nil {
+		return errors.WithStack(client.IgnoreNotFound(err))
+	}
+	return r.deleteClientConfigCopies(ctx, rp, splitNamespaces(cm.Annotations[clientConfigPublishedKey], rp.Namespace))
+}
+
+// addClientCertificate adds the client certificate for the given server
+// certificate to credentials, both as separate files and as a PEM keystore
+// holding the key followed by the certificate.
+func (r *RedpandaReconciler) addClientCertificate(ctx context.Context, namespace, name, cert string, credentials map[string][]byte) error {
+	crt, err := r.secretValue(ctx, namespace, name, corev1.TLSCertKey)
+	if err != nil {
+		return err
+	}
+	key, err := r.secretValue(ctx, namespace, name, corev1.TLSPrivateKeyKey)
+	if err != nil {
+		return err
+	}
+
+	credentials[cert+".tls.crt"] = crt
+	credentials[cert+".tls.key"] = key
+	credentials[cert+".keystore.pem"] = append(append(append(bytes.TrimSpace(slices.Clone(key)), '\n'), bytes.TrimSpace(crt)...), '\n')
+	return nil
+}
+
+func (r *RedpandaReconciler) secretValue(ctx context.Context, namespace, name, key string) ([]byte, error) {
+	var secret corev1.Secret
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+		return nil, errors.Wrapf(err, "fetching Secret %s/%s", namespace, name)
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return nil, errors.Newf("secret %s/%s has no key %q", namespace, name, key)
+	}
+	return value, nil
+}
+
+func (r *RedpandaReconciler) createOrUpdate(ctx context.Context, obj client.Object) error {
+	err := r.Client.Update(ctx, obj)
+	if apierrors.IsNotFound(err) {
+		err = r.Client.Create(ctx, obj)
+	}
+	return errors.Wrapf(err, "writing %T %s/%s", obj, obj.GetNamespace(), obj.GetName())
+}
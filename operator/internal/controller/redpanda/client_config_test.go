@@ -0,0 +1,173 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"testing"
+
+	rpkconfig "github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/controller"
+)
+
+func TestClientConfig(t *testing.T) {
+	ctx := context.Background()
+
+	secret := func(name string, data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}, Data: map[string][]byte{}}
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+		return s
+	}
+
+	rp := &redpandav1alpha2.Redpanda{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "redpanda",
+			UID:       "1234",
+			Annotations: map[string]string{
+				ClientConfigNamespacesKey:  "apps, tools,default",
+				ClientConfigCredentialsKey: "client",
+			},
+		},
+		Spec: redpandav1alpha2.RedpandaSpec{
+			ClusterSpec: &redpandav1alpha2.RedpandaClusterSpec{
+				Auth: &redpandav1alpha2.Auth{SASL: &redpandav1alpha2.SASL{Enabled: ptr.To(true)}},
+			},
+		},
+	}
+
+	user := func(name, cluster string) *redpandav1alpha2.User {
+		return &redpandav1alpha2.User{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: redpandav1alpha2.UserSpec{
+				ClusterSource: &redpandav1alpha2.ClusterSource{ClusterRef: &redpandav1alpha2.ClusterRef{Name: cluster}},
+				Authentication: &redpandav1alpha2.UserAuthenticationSpec{
+					Type: ptr.To(redpandav1alpha2.SASLMechanismScramSHA512),
+					Password: redpandav1alpha2.Password{ValueFrom: &redpandav1alpha2.PasswordSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name + "-password"}},
+					}},
+				},
+			},
+		}
+	}
+
+	r := &RedpandaReconciler{Client: fake.NewClientBuilder().
+		WithScheme(controller.V2Scheme).
+		WithObjects(
+			secret("redpanda-default-root-certificate", map[string]string{"tls.crt": "DEFAULT CA"}),
+			secret("redpanda-external-root-certificate", map[string]string{"tls.crt": "EXTERNAL CA"}),
+			secret("redpanda-bootstrap-user", map[string]string{"password": "hunter2"}),
+			secret("client-password", map[string]string{"password": "swordfish"}),
+			user("client", "redpanda"),
+			user("other", "other-cluster"),
+			user("kubernetes-controller", "redpanda"),
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "unrelated", Name: "redpanda-client-config"}, Data: map[string]string{"key": "value"}},
+		).
+		Build()}
+
+	require.NoError(t, r.reconcileClientConfig(ctx, rp))
+
+	var cm corev1.ConfigMap
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "redpanda-client-config"}, &cm))
+	require.Equal(t, "apps,tools", cm.Annotations[clientConfigPublishedKey])
+	require.Len(t, cm.OwnerReferences, 1)
+
+	var profile rpkconfig.RpkProfile
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["internal.rpk.yaml"]), &profile))
+	require.Equal(t, []string{
+		"redpanda-0.redpanda.default.svc.cluster.local.:9093",
+		"redpanda-1.redpanda.default.svc.cluster.local.:9093",
+		"redpanda-2.redpanda.default.svc.cluster.local.:9093",
+	}, profile.KafkaAPI.Brokers)
+	require.Equal(t, &rpkconfig.TLS{TruststoreFile: "internal.ca.crt"}, profile.KafkaAPI.TLS)
+	require.Equal(t, &rpkconfig.SASL{Mechanism: "SCRAM-SHA-512"}, profile.KafkaAPI.SASL)
+	require.Len(t, profile.AdminAPI.Addresses, 3)
+	require.Len(t, profile.SR.Addresses, 3)
+	require.Equal(t, "DEFAULT CA\n", cm.Data["internal.ca.crt"])
+
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["default.rpk.yaml"]), &profile))
+	require.Equal(t, []string{"redpanda-0:31092", "redpanda-1:31092", "redpanda-2:31092"}, profile.KafkaAPI.Brokers)
+	require.Equal(t, []string{"redpanda-0:31644", "redpanda-1:31644", "redpanda-2:31644"}, profile.AdminAPI.Addresses)
+	require.Equal(t, "EXTERNAL CA\n", cm.Data["default.ca.crt"])
+
+	require.Equal(t, "bootstrap.servers=redpanda-0:31092,redpanda-1:31092,redpanda-2:31092\n"+
+		"security.protocol=SASL_SSL\n"+
+		"ssl.truststore.type=PEM\n"+
+		"ssl.truststore.location=default.ca.crt\n"+
+		"sasl.mechanism=SCRAM-SHA-512\n", cm.Data["default.client.properties"])
+
+	var credentials corev1.Secret
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "redpanda-client-credentials"}, &credentials))
+	require.Equal(t, "client", string(credentials.Data["username"]))
+	require.Equal(t, "swordfish", string(credentials.Data["password"]))
+	require.Equal(t, "SCRAM-SHA-512", string(credentials.Data["mechanism"]))
+	require.Equal(t, `org.apache.kafka.common.security.scram.ScramLoginModule required username="client" password="swordfish";`, string(credentials.Data["sasl.jaas.config"]))
+	require.Len(t, credentials.OwnerReferences, 1)
+
+	for _, ns := range []string{"apps", "tools"} {
+		var copied corev1.ConfigMap
+		require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: "redpanda-client-config"}, &copied))
+		require.Equal(t, cm.Data, copied.Data)
+		require.Empty(t, copied.OwnerReferences)
+		require.Equal(t, map[string]string{clientConfigNamespaceLabel: "default", clientConfigClusterLabel: "redpanda"}, copied.Labels)
+
+		var copiedCredentials corev1.Secret
+		require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: "redpanda-client-credentials"}, &copiedCredentials))
+		require.Equal(t, credentials.Data, copiedCredentials.Data)
+	}
+
+	// objects of the same name that aren't copies are left alone
+	rp.Annotations[ClientConfigNamespacesKey] = "apps,tools,unrelated"
+	require.Error(t, r.reconcileClientConfig(ctx, rp))
+	var unrelated corev1.ConfigMap
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "unrelated", Name: "redpanda-client-config"}, &unrelated))
+	require.Equal(t, map[string]string{"key": "value"}, unrelated.Data)
+	rp.Annotations[ClientConfigNamespacesKey] = "apps, tools,default"
+	require.NoError(t, r.reconcileClientConfig(ctx, rp))
+
+	// only Users of the cluster that aren't its bootstrap user are published
+	for _, name := range []string{"other", "kubernetes-controller", "missing"} {
+		rp.Annotations[ClientConfigCredentialsKey] = name
+		require.Error(t, r.reconcileClientConfig(ctx, rp))
+	}
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "redpanda-client-credentials"}, &credentials))
+	require.Equal(t, "client", string(credentials.Data["username"]))
+
+	// namespaces that are no longer listed lose their copies, as do all
+	// namespaces once credentials are no longer requested
+	rp.Annotations[ClientConfigNamespacesKey] = "apps"
+	delete(rp.Annotations, ClientConfigCredentialsKey)
+	require.NoError(t, r.reconcileClientConfig(ctx, rp))
+
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "redpanda-client-config"}, &cm))
+	require.Equal(t, "apps", cm.Annotations[clientConfigPublishedKey])
+	require.Contains(t, cm.Data["default.client.properties"], "sasl.mechanism=SCRAM-SHA-256\n")
+	require.True(t, apierrors.IsNotFound(r.Client.Get(ctx, client.ObjectKey{Namespace: "tools", Name: "redpanda-client-config"}, &corev1.ConfigMap{})))
+	require.True(t, apierrors.IsNotFound(r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "redpanda-client-credentials"}, &corev1.Secret{})))
+	require.True(t, apierrors.IsNotFound(r.Client.Get(ctx, client.ObjectKey{Namespace: "apps", Name: "redpanda-client-credentials"}, &corev1.Secret{})))
+
+	// copies are removed independently of the cluster's owned objects
+	require.NoError(t, r.deletePublishedClientConfig(ctx, rp))
+	require.True(t, apierrors.IsNotFound(r.Client.Get(ctx, client.ObjectKey{Namespace: "apps", Name: "redpanda-client-config"}, &corev1.ConfigMap{})))
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "redpanda-client-config"}, &corev1.ConfigMap{}))
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "unrelated", Name: "redpanda-client-config"}, &corev1.ConfigMap{}))
+}
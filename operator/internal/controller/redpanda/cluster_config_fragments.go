@@ -0,0 +1,257 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+)
+
+// clusterConfigFragmentMerge is the result of merging the
+// ClusterConfigFragments of a cluster into its configuration.
+type clusterConfigFragmentMerge struct {
+	// Fragments holds the merged fragments, in the order they claim keys.
+	Fragments []*redpandav1alpha2.ClusterConfigFragment
+	// Config holds the keys of all fragments that are applied.
+	Config redpandav1alpha2.ClusterConfiguration
+	// Statuses holds the resulting status of each fragment, by name.
+	Statuses map[string]redpandav1alpha2.ClusterConfigFragmentStatus
+}
+
+// clusterConfigOwner formats the source owning a cluster configuration key.
+func clusterConfigOwner(kind, name string) string {
+	return kind + "/" + name
+}
+
+// watchClusterConfigFragments reconciles the cluster referenced by a
+// ClusterConfigFragment whenever its spec changes or it is deleted. Status
+// updates are ignored as they're made by the reconciliation itself.
+func watchClusterConfigFragments(b *builder.Builder) {
+	b.Watches(&redpandav1alpha2.ClusterConfigFragment{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, o client.Object) []reconcile.Request {
+		fragment := o.(*redpandav1alpha2.ClusterConfigFragment)
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{
+			Namespace: fragment.Namespace,
+			Name:      fragment.Spec.ClusterRef.Name,
+		}}}
+	}), builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+}
+
+// clusterConfigFragments returns the ClusterConfigFragments referencing rp,
+// oldest first, which is the order in which they claim keys.
+func (r *RedpandaReconciler) clusterConfigFragments(ctx context.Context, rp *redpandav1alpha2.Redpanda) ([]*redpandav1alpha2.ClusterConfigFragment, error) {
+	var list redpandav1alpha2.ClusterConfigFragmentList
+	if err := r.Client.List(ctx, &list, client.InNamespace(rp.Namespace)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var fragments []*redpandav1alpha2.ClusterConfigFragment
+	for _, fragment := range list.GetItems() {
+		// deleted fragments give up their keys straight away
+		if fragment.Spec.ClusterRef.Name == rp.Name && fragment.DeletionTimestamp == nil {
+			fragments = append(fragments, fragment)
+		}
+	}
+
+	slices.SortFunc(fragments, func(a, b *redpandav1alpha2.ClusterConfigFragment) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return fragments, nil
+}
+
+// validateClusterConfigFragments resolves the keys of each fragment on its
+// own and checks them against schema, so that a mistake in one fragment
+// doesn't keep the configuration of the whole cluster from being applied.
+// It returns the invalid keys of each fragment, by name.
+func (r *RedpandaReconciler) validateClusterConfigFragments(ctx context.Context, fragments []*redpandav1alpha2.ClusterConfigFragment, schema rpadmin.ConfigSchema) (map[string]clusterconfiguration.ValidationErrors, error) {
+	invalid := map[string]clusterconfiguration.ValidationErrors{}
+	for _, fragment := range fragments {
+		errs, err := r.validateClusterConfigFragment(ctx, fragment, schema)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving ClusterConfigFragment %q", fragment.Name)
+		}
+		if len(errs) > 0 {
+			invalid[fragment.Name] = errs
+		}
+	}
+	return invalid, nil
+}
+
+func (r *RedpandaReconciler) validateClusterConfigFragment(ctx context.Context, fragment *redpandav1alpha2.ClusterConfigFragment, schema rpadmin.ConfigSchema) (clusterconfiguration.ValidationErrors, error) {
+	config := maps.Clone(fragment.Spec.Config)
+
+	var invalid clusterconfiguration.ValidationErrors
+	for {
+		conf := clusterconfiguration.NewClusterCfg(clusterconfiguration.NewPodContext(fragment.Namespace))
+		for k, v := range config {
+			conf.Set(k, v)
+		}
+
+		resolved, err := conf.Reify(ctx, r.Client, r.CloudSecretsExpander, schema)
+
+		// keys that can't be converted are dropped before resolving the
+		// remaining ones again, which can't fail the same way
+		var unconvertible clusterconfiguration.ValidationErrors
+		if errors.As(err, &unconvertible) {
+			remaining := len(config)
+			for _, e := range unconvertible {
+				delete(config, e.Key)
+			}
+			if len(config) < remaining {
+				invalid = append(invalid, unconvertible...)
+				continue
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		errs, _ := clusterconfiguration.ValidateClusterConfiguration(resolved, schema)
+		invalid = append(invalid, errs...)
+		break
+	}
+
+	slices.SortFunc(invalid, func(a, b *clusterconfiguration.ValidationError) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return invalid, nil
+}
+
+// mergeClusterConfigFragments claims the keys of fragments, in order, that
+// are valid and aren't owned yet. owners maps the keys that are already
+// owned, i.e. those set by the Redpanda resource, to their owner and is
+// updated in place. invalid holds the invalid keys of each fragment, as
+// returned by validateClusterConfigFragments.
+func mergeClusterConfigFragments(owners map[string]string, fragments []*redpandav1alpha2.ClusterConfigFragment, invalid map[string]clusterconfiguration.ValidationErrors) clusterConfigFragmentMerge {
+	merge := clusterConfigFragmentMerge{
+		Fragments: fragments,
+		Config:    redpandav1alpha2.ClusterConfiguration{},
+		Statuses:  map[string]redpandav1alpha2.ClusterConfigFragmentStatus{},
+	}
+
+	for _, fragment := range fragments {
+		var status redpandav1alpha2.ClusterConfigFragmentStatus
+
+		// invalid keys don't claim ownership, leaving them to valid ones of
+		// later fragments
+		rejected := map[string]bool{}
+		for _, e := range invalid[fragment.Name] {
+			rejected[e.Key] = true
+			status.InvalidKeys = append(status.InvalidKeys, redpandav1alpha2.ClusterConfigInvalidKey{Key: e.Key, Message: e.Message})
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(fragment.Spec.Config)) {
+			if rejected[key] {
+				continue
+			}
+			if owner, ok := owners[key]; ok {
+				status.Conflicts = append(status.Conflicts, redpandav1alpha2.ClusterConfigConflict{Key: key, Owner: owner})
+				continue
+			}
+
+			owners[key] = clusterConfigOwner("ClusterConfigFragment", fragment.Name)
+			merge.Config[key] = fragment.Spec.Config[key]
+			status.AppliedKeys = append(status.AppliedKeys, key)
+		}
+
+		merge.Statuses[fragment.Name] = status
+	}
+
+	return merge
+}
+
+// syncClusterConfigFragmentStatuses reports which keys of each fragment
+// have been applied to its cluster. syncErr is the error syncing the
+// cluster configuration failed with, if any, in which case no fragment is
+// reported as applied.
+func (r *RedpandaReconciler) syncClusterConfigFragmentStatuses(ctx context.Context, merge clusterConfigFragmentMerge, syncErr error) error {
+	var errs []error
+	for _, fragment := range merge.Fragments {
+		merged := merge.Statuses[fragment.Name]
+
+		status := fragment.Status.DeepCopy()
+		status.ObservedGeneration = fragment.Generation
+		status.AppliedKeys = merged.AppliedKeys
+		status.Conflicts = merged.Conflicts
+		status.InvalidKeys = merged.InvalidKeys
+
+		condition := metav1.Condition{
+			Type:               redpandav1alpha2.ClusterConfigFragmentConditionApplied,
+			Status:             metav1.ConditionTrue,
+			Reason:             redpandav1alpha2.ClusterConfigFragmentReasonApplied,
+			Message:            "All keys are applied to the cluster",
+			ObservedGeneration: fragment.Generation,
+		}
+
+		var messages []string
+		if len(merged.InvalidKeys) > 0 {
+			invalid := make([]string, 0, len(merged.InvalidKeys))
+			for _, key := range merged.InvalidKeys {
+				invalid = append(invalid, fmt.Sprintf("%s (%s)", key.Key, key.Message))
+			}
+
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = redpandav1alpha2.ClusterConfigFragmentReasonInvalid
+			messages = append(messages, "Invalid keys are not applied: "+strings.Join(invalid, ", "))
+		}
+		if len(merged.Conflicts) > 0 {
+			conflicts := make([]string, 0, len(merged.Conflicts))
+			for _, conflict := range merged.Conflicts {
+				conflicts = append(conflicts, fmt.Sprintf("%s (owned by %s)", conflict.Key, conflict.Owner))
+			}
+
+			if condition.Status == metav1.ConditionTrue {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = redpandav1alpha2.ClusterConfigFragmentReasonConflict
+			}
+			messages = append(messages, "Keys set by other sources are not applied: "+strings.Join(conflicts, ", "))
+		}
+		if len(messages) > 0 {
+			condition.Message = strings.Join(messages, "; ")
+		}
+		if syncErr != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = redpandav1alpha2.ClusterConfigFragmentReasonError
+			condition.Message = "Applying the cluster configuration failed: " + syncErr.Error()
+		}
+		apimeta.SetStatusCondition(&status.Conditions, condition)
+
+		if equality.Semantic.DeepEqual(*status, fragment.Status) {
+			continue
+		}
+
+		fragment.Status = *status
+		if err := r.Client.Status().Update(ctx, fragment); err != nil {
+			errs = append(errs, errors.Wrapf(err, "updating status of ClusterConfigFragment %q", fragment.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
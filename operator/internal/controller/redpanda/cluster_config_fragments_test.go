@@ -0,0 +1,231 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	vectorizedv1alpha1 "github.com/redpanda-data/redpanda-operator/operator/api/vectorized/v1alpha1"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/controller"
+)
+
+func TestClusterConfigFragments(t *testing.T) {
+	ctx := context.Background()
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fragment := func(name, cluster string, age time.Duration, config map[string]string) *redpandav1alpha2.ClusterConfigFragment {
+		fragment := &redpandav1alpha2.ClusterConfigFragment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              name,
+				Generation:        1,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec: redpandav1alpha2.ClusterConfigFragmentSpec{
+				ClusterRef: redpandav1alpha2.ClusterRef{Name: cluster},
+				Config:     redpandav1alpha2.ClusterConfiguration{},
+			},
+		}
+		for key, repr := range config {
+			fragment.Spec.Config[key] = vectorizedv1alpha1.ClusterConfigValue{Repr: ptr.To(vectorizedv1alpha1.YAMLRepresentation(repr))}
+		}
+		return fragment
+	}
+
+	rp := &redpandav1alpha2.Redpanda{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster"}}
+	r := &RedpandaReconciler{Client: fake.NewClientBuilder().
+		WithScheme(controller.V2Scheme).
+		WithStatusSubresource(&redpandav1alpha2.ClusterConfigFragment{}).
+		WithObjects(
+			fragment("tenants", "cluster", time.Hour, map[string]string{"kafka_batch_max_bytes": "1048576", "log_retention_ms": "-1"}),
+			fragment("platform", "cluster", 2*time.Hour, map[string]string{"log_retention_ms": "604800000", "auto_create_topics_enabled": "true"}),
+			fragment("security", "cluster", time.Hour, map[string]string{"admin_api_require_auth": "true"}),
+			fragment("other", "other-cluster", 3*time.Hour, map[string]string{"log_retention_ms": "0"}),
+		).
+		Build()}
+
+	fragments, err := r.clusterConfigFragments(ctx, rp)
+	require.NoError(t, err)
+
+	// oldest first, ties broken by name
+	var names []string
+	for _, fragment := range fragments {
+		names = append(names, fragment.Name)
+	}
+	require.Equal(t, []string{"platform", "security", "tenants"}, names)
+
+	merge := mergeClusterConfigFragments(map[string]string{"auto_create_topics_enabled": "Redpanda/cluster"}, fragments, nil)
+
+	require.ElementsMatch(t, []string{"log_retention_ms", "admin_api_require_auth", "kafka_batch_max_bytes"}, slices.Collect(maps.Keys(merge.Config)))
+	require.Equal(t, vectorizedv1alpha1.YAMLRepresentation("604800000"), *merge.Config["log_retention_ms"].Repr)
+
+	require.Equal(t, redpandav1alpha2.ClusterConfigFragmentStatus{
+		AppliedKeys: []string{"log_retention_ms"},
+		Conflicts:   []redpandav1alpha2.ClusterConfigConflict{{Key: "auto_create_topics_enabled", Owner: "Redpanda/cluster"}},
+	}, merge.Statuses["platform"])
+	require.Equal(t, redpandav1alpha2.ClusterConfigFragmentStatus{
+		AppliedKeys: []string{"kafka_batch_max_bytes"},
+		Conflicts:   []redpandav1alpha2.ClusterConfigConflict{{Key: "log_retention_ms", Owner: "ClusterConfigFragment/platform"}},
+	}, merge.Statuses["tenants"])
+
+	// nothing is applied until the configuration has been synced
+	require.NoError(t, r.syncClusterConfigFragmentStatuses(ctx, merge, errors.New("brokers unavailable")))
+
+	var security redpandav1alpha2.ClusterConfigFragment
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "security"}, &security))
+	condition := apimeta.FindStatusCondition(security.Status.Conditions, redpandav1alpha2.ClusterConfigFragmentConditionApplied)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, redpandav1alpha2.ClusterConfigFragmentReasonError, condition.Reason)
+
+	require.NoError(t, r.syncClusterConfigFragmentStatuses(ctx, merge, nil))
+
+	for name, expected := range map[string]metav1.ConditionStatus{
+		"platform": metav1.ConditionFalse,
+		"security": metav1.ConditionTrue,
+		"tenants":  metav1.ConditionFalse,
+	} {
+		var fragment redpandav1alpha2.ClusterConfigFragment
+		require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, &fragment))
+
+		condition := apimeta.FindStatusCondition(fragment.Status.Conditions, redpandav1alpha2.ClusterConfigFragmentConditionApplied)
+		require.NotNil(t, condition, name)
+		require.Equal(t, expected, condition.Status, name)
+		require.Equal(t, int64(1), fragment.Status.ObservedGeneration, name)
+	}
+
+	var tenants redpandav1alpha2.ClusterConfigFragment
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "tenants"}, &tenants))
+	require.Contains(t, apimeta.FindStatusCondition(tenants.Status.Conditions, redpandav1alpha2.ClusterConfigFragmentConditionApplied).Message, "log_retention_ms (owned by ClusterConfigFragment/platform)")
+
+	// once the owning fragment is gone, the key moves to the next in line
+	require.NoError(t, r.Client.Delete(ctx, fragments[0]))
+
+	fragments, err = r.clusterConfigFragments(ctx, rp)
+	require.NoError(t, err)
+
+	merge = mergeClusterConfigFragments(map[string]string{}, fragments, nil)
+	require.Equal(t, vectorizedv1alpha1.YAMLRepresentation("-1"), *merge.Config["log_retention_ms"].Repr)
+	require.NotContains(t, merge.Config, "auto_create_topics_enabled")
+	require.Empty(t, merge.Statuses["tenants"].Conflicts)
+}
+
+func TestClusterConfigFragmentsInvalidKeys(t *testing.T) {
+	ctx := context.Background()
+
+	schema := rpadmin.ConfigSchema{
+		"log_retention_ms":      {Type: "integer"},
+		"kafka_batch_max_bytes": {Type: "integer"},
+		"log_compression_type":  {Type: "string", EnumValues: []string{"none", "zstd"}},
+	}
+
+	fragment := func(name string, age time.Duration, config map[string]string) *redpandav1alpha2.ClusterConfigFragment {
+		fragment := &redpandav1alpha2.ClusterConfigFragment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              name,
+				Generation:        1,
+				CreationTimestamp: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(-age)),
+			},
+			Spec: redpandav1alpha2.ClusterConfigFragmentSpec{
+				ClusterRef: redpandav1alpha2.ClusterRef{Name: "cluster"},
+				Config:     redpandav1alpha2.ClusterConfiguration{},
+			},
+		}
+		for key, repr := range config {
+			fragment.Spec.Config[key] = vectorizedv1alpha1.ClusterConfigValue{Repr: ptr.To(vectorizedv1alpha1.YAMLRepresentation(repr))}
+		}
+		return fragment
+	}
+
+	rp := &redpandav1alpha2.Redpanda{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster"},
+		Spec: redpandav1alpha2.RedpandaSpec{
+			ClusterSpec: &redpandav1alpha2.RedpandaClusterSpec{},
+		},
+	}
+	r := &RedpandaReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(controller.V2Scheme).
+			WithStatusSubresource(&redpandav1alpha2.ClusterConfigFragment{}).
+			WithObjects(
+				// older, so its invalid keys would win if they claimed
+				// ownership
+				fragment("broken", 2*time.Hour, map[string]string{
+					"log_retention_ms":      "forever",
+					"log_compression_type":  "lz5",
+					"log_segmnt_size":       "1",
+					"kafka_batch_max_bytes": "1048576",
+				}),
+				fragment("retention", time.Hour, map[string]string{"log_retention_ms": "604800000"}),
+			).
+			Build(),
+		EventRecorder: record.NewFakeRecorder(100),
+	}
+
+	// the keys the Redpanda resource sets by default are left for the
+	// brokers to validate
+	rendered, _, err := (&RedpandaReconciler{Client: fake.NewClientBuilder().WithScheme(controller.V2Scheme).Build()}).clusterConfigFor(ctx, rp, nil)
+	require.NoError(t, err)
+	for key := range rendered {
+		if _, ok := schema[key]; !ok {
+			schema[key] = rpadmin.ConfigPropertyMetadata{}
+		}
+	}
+
+	// a mistake in one fragment doesn't keep the cluster from being
+	// configured
+	desired, err := r.desiredClusterConfig(ctx, rp, schema)
+	require.NoError(t, err)
+	require.Equal(t, int64(1048576), desired.Config["kafka_batch_max_bytes"])
+	require.Equal(t, int64(604800000), desired.Config["log_retention_ms"])
+	require.NotContains(t, desired.Config, "log_compression_type")
+	require.NotContains(t, desired.Config, "log_segmnt_size")
+	require.NotNil(t, desired.Fragments)
+
+	status := desired.Fragments.Statuses["broken"]
+	require.Equal(t, []string{"kafka_batch_max_bytes"}, status.AppliedKeys)
+	require.Empty(t, status.Conflicts)
+
+	var keys []string
+	for _, invalid := range status.InvalidKeys {
+		keys = append(keys, invalid.Key)
+	}
+	require.Equal(t, []string{"log_compression_type", "log_retention_ms", "log_segmnt_size"}, keys)
+
+	require.NoError(t, r.syncClusterConfigFragmentStatuses(ctx, *desired.Fragments, nil))
+
+	var broken redpandav1alpha2.ClusterConfigFragment
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "broken"}, &broken))
+	require.Equal(t, status.InvalidKeys, broken.Status.InvalidKeys)
+
+	condition := apimeta.FindStatusCondition(broken.Status.Conditions, redpandav1alpha2.ClusterConfigFragmentConditionApplied)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, redpandav1alpha2.ClusterConfigFragmentReasonInvalid, condition.Reason)
+	require.Contains(t, condition.Message, "log_segmnt_size")
+
+	var retention redpandav1alpha2.ClusterConfigFragment
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "retention"}, &retention))
+	require.True(t, apimeta.IsStatusConditionTrue(retention.Status.Conditions, redpandav1alpha2.ClusterConfigFragmentConditionApplied))
+}
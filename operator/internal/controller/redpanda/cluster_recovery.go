@@ -0,0 +1,170 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/lifecycle"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/statuses"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/log"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/trace"
+)
+
+const (
+	// recoveryStateInactive is reported by the Admin API when no cluster
+	// recovery has ever been started.
+	recoveryStateInactive = "inactive"
+	// recoveryStateComplete is reported by the Admin API once a cluster
+	// recovery has successfully finished.
+	recoveryStateComplete = "complete"
+	// recoveryStateFailed is reported by the Admin API if a cluster recovery
+	// could not be finished.
+	recoveryStateFailed = "failed"
+
+	// recoveryStartTimeout is how long a started recovery may be reported as
+	// inactive before it is considered failed.
+	recoveryStartTimeout = 5 * time.Minute
+)
+
+// reconcileClusterRecovery starts a whole cluster recovery from the Tiered
+// Storage bucket of a freshly bootstrapped cluster and tracks its progress in
+// the given status. It returns whether reconciliation should be requeued
+// because the recovery is still in progress.
+func (r *RedpandaReconciler) reconcileClusterRecovery(ctx context.Context, admin *rpadmin.AdminAPI, rp *redpandav1alpha2.Redpanda, status *lifecycle.ClusterStatus) (_ bool, err error) {
+	ctx, span := trace.Start(ctx, "reconcileClusterRecovery")
+	defer func() { trace.EndSpan(span, err) }()
+
+	logger := log.FromContext(ctx)
+
+	if rp.Spec.ClusterRecovery == nil || !rp.Spec.ClusterRecovery.Enabled {
+		return false, nil
+	}
+
+	// once finished, a recovery is never looked at again
+	if condition := apimeta.FindStatusCondition(rp.Status.Conditions, statuses.ClusterRecovered); condition != nil {
+		switch statuses.ClusterRecoveredCondition(condition.Reason) {
+		case statuses.ClusterRecoveredReasonRecovered, statuses.ClusterRecoveredReasonFailed, statuses.ClusterRecoveredReasonNotFresh:
+			return false, nil
+		}
+	}
+
+	var started *metav1.Time
+	if rp.Status.Recovery != nil {
+		started = rp.Status.Recovery.StartTime
+	}
+
+	if started == nil {
+		// never recover on top of a cluster that we have already configured, as
+		// it may already hold data that would conflict with the recovered one
+		if apimeta.IsStatusConditionTrue(rp.Status.Conditions, statuses.ClusterConfigurationApplied) {
+			status.Status.SetRecovered(statuses.ClusterRecoveredReasonNotFresh)
+			status.Recovery = &lifecycle.RecoveryStatus{State: recoveryStateInactive}
+
+			r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityError, "Cluster recovery is only started on freshly bootstrapped clusters")
+
+			return false, nil
+		}
+	}
+
+	reported, err := admin.PollAutomatedRecoveryStatus(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "polling cluster recovery status")
+	}
+
+	if started == nil {
+		// a recovery may have been started right before a previous status
+		// update failed, in which case we must not start another one
+		if reported.State == "" || reported.State == recoveryStateInactive {
+			logger.Info("starting cluster recovery from tiered storage")
+
+			if _, err := admin.StartAutomatedRecovery(ctx); err != nil {
+				return false, errors.Wrap(err, "starting cluster recovery")
+			}
+
+			r.EventRecorder.Event(rp, "Normal", redpandav1alpha2.EventSeverityInfo, "Started cluster recovery from tiered storage")
+
+			status.Status.SetRecovered(statuses.ClusterRecoveredReasonInProgress, "Cluster recovery started")
+			status.Recovery = &lifecycle.RecoveryStatus{
+				State:     recoveryStateInactive,
+				StartTime: ptrNow(),
+			}
+
+			return true, nil
+		}
+
+		started = ptrNow()
+	}
+
+	recovery, reason, message := recoveryStatus(reported, started, time.Now())
+
+	status.Status.SetRecovered(reason, message)
+	status.Recovery = recovery
+
+	switch reason {
+	case statuses.ClusterRecoveredReasonRecovered:
+		r.EventRecorder.Event(rp, "Normal", redpandav1alpha2.EventSeverityInfo, "Cluster recovery from tiered storage finished")
+	case statuses.ClusterRecoveredReasonFailed:
+		r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityError, message)
+	default:
+		logger.V(log.DebugLevel).Info("cluster recovery in progress", "state", recovery.State, "pending", recovery.PendingDownloads)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// recoveryStatus maps the recovery status reported by the Admin API onto the
+// status and the reason and message of the Recovered condition recorded on
+// the cluster.
+func recoveryStatus(reported *rpadmin.TopicRecoveryStatus, started *metav1.Time, now time.Time) (*lifecycle.RecoveryStatus, statuses.ClusterRecoveredCondition, string) {
+	recovery := &lifecycle.RecoveryStatus{
+		State:     reported.State,
+		StartTime: started,
+	}
+
+	for _, downloads := range reported.TopicDownloads {
+		recovery.PendingDownloads += int32(downloads.PendingDownloads)       //nolint:gosec // counts never overflow an int32
+		recovery.SuccessfulDownloads += int32(downloads.SuccessfulDownloads) //nolint:gosec // counts never overflow an int32
+		recovery.FailedDownloads += int32(downloads.FailedDownloads)         //nolint:gosec // counts never overflow an int32
+	}
+
+	switch reported.State {
+	case recoveryStateComplete:
+		recovery.CompletionTime = ptrNow()
+		return recovery, statuses.ClusterRecoveredReasonRecovered, ""
+	case recoveryStateFailed:
+		recovery.CompletionTime = ptrNow()
+		return recovery, statuses.ClusterRecoveredReasonFailed, fmt.Sprintf("Cluster recovery from tiered storage failed with %d failed downloads", recovery.FailedDownloads)
+	case "", recoveryStateInactive:
+		// a recovery that never leaves the inactive state, for instance
+		// because the broker that accepted it restarted, would otherwise
+		// block dependent resources forever
+		if now.Sub(started.Time) >= recoveryStartTimeout {
+			recovery.CompletionTime = ptrNow()
+			return recovery, statuses.ClusterRecoveredReasonFailed, fmt.Sprintf("Cluster recovery from tiered storage did not start within %s", recoveryStartTimeout)
+		}
+	}
+
+	return recovery, statuses.ClusterRecoveredReasonInProgress, fmt.Sprintf("Cluster recovery in state %q with %d pending downloads", reported.State, recovery.PendingDownloads)
+}
+
+func ptrNow() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
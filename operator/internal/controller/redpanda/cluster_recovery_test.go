@@ -0,0 +1,172 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/lifecycle"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/statuses"
+)
+
+func TestReconcileClusterRecovery(t *testing.T) {
+	started := metav1.Now()
+	stale := metav1.NewTime(started.Add(-2 * recoveryStartTimeout))
+
+	downloads := []rpadmin.TopicDownloadCounts{
+		{TopicNamespace: "kafka/a", PendingDownloads: 1, SuccessfulDownloads: 2},
+		{TopicNamespace: "kafka/b", PendingDownloads: 1, FailedDownloads: 1},
+	}
+
+	for name, tt := range map[string]struct {
+		disabled   bool
+		conditions []metav1.Condition
+		startTime  *metav1.Time
+		reported   rpadmin.TopicRecoveryStatus
+		reason     statuses.ClusterRecoveredCondition
+		requeue    bool
+		started    bool
+	}{
+		"disabled": {
+			disabled: true,
+		},
+		"fresh-cluster": {
+			reported: rpadmin.TopicRecoveryStatus{State: recoveryStateInactive},
+			reason:   statuses.ClusterRecoveredReasonInProgress,
+			requeue:  true,
+			started:  true,
+		},
+		"configured-cluster": {
+			conditions: []metav1.Condition{{Type: statuses.ClusterConfigurationApplied, Status: metav1.ConditionTrue}},
+			reason:     statuses.ClusterRecoveredReasonNotFresh,
+		},
+		"already-started": {
+			reported: rpadmin.TopicRecoveryStatus{State: "recovered_topic_data", TopicDownloads: downloads},
+			reason:   statuses.ClusterRecoveredReasonInProgress,
+			requeue:  true,
+		},
+		"in-progress": {
+			startTime: &started,
+			reported:  rpadmin.TopicRecoveryStatus{State: "recovered_topic_data", TopicDownloads: downloads},
+			reason:    statuses.ClusterRecoveredReasonInProgress,
+			requeue:   true,
+		},
+		"not-yet-active": {
+			startTime: &started,
+			reported:  rpadmin.TopicRecoveryStatus{State: recoveryStateInactive},
+			reason:    statuses.ClusterRecoveredReasonInProgress,
+			requeue:   true,
+		},
+		"never-active": {
+			startTime: &stale,
+			reported:  rpadmin.TopicRecoveryStatus{State: recoveryStateInactive},
+			reason:    statuses.ClusterRecoveredReasonFailed,
+		},
+		"complete": {
+			startTime: &started,
+			reported:  rpadmin.TopicRecoveryStatus{State: recoveryStateComplete},
+			reason:    statuses.ClusterRecoveredReasonRecovered,
+		},
+		"failed": {
+			startTime: &started,
+			reported:  rpadmin.TopicRecoveryStatus{State: recoveryStateFailed, TopicDownloads: downloads},
+			reason:    statuses.ClusterRecoveredReasonFailed,
+		},
+		"finished": {
+			conditions: []metav1.Condition{{Type: statuses.ClusterRecovered, Status: metav1.ConditionTrue, Reason: string(statuses.ClusterRecoveredReasonRecovered)}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			startCalls := 0
+
+			// the Admin API is faked so that each state of a recovery can be
+			// exercised without a bucket to recover from
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/v1/cloud_storage/automated_recovery", r.URL.Path)
+
+				if r.Method == http.MethodPost {
+					startCalls++
+					require.NoError(t, json.NewEncoder(w).Encode(rpadmin.RecoveryStartResponse{Code: http.StatusOK}))
+					return
+				}
+
+				require.NoError(t, json.NewEncoder(w).Encode(tt.reported))
+			}))
+			defer server.Close()
+
+			admin, err := rpadmin.NewAdminAPI([]string{server.URL}, &rpadmin.NopAuth{}, nil)
+			require.NoError(t, err)
+			defer admin.Close()
+
+			rp := &redpandav1alpha2.Redpanda{
+				Spec: redpandav1alpha2.RedpandaSpec{
+					ClusterRecovery: &redpandav1alpha2.ClusterRecovery{Enabled: !tt.disabled},
+				},
+				Status: redpandav1alpha2.RedpandaStatus{
+					Conditions: tt.conditions,
+				},
+			}
+			if tt.startTime != nil {
+				rp.Status.Recovery = &redpandav1alpha2.ClusterRecoveryStatus{StartTime: tt.startTime}
+			}
+
+			r := &RedpandaReconciler{EventRecorder: record.NewFakeRecorder(10)}
+
+			status := lifecycle.NewClusterStatus()
+
+			requeue, err := r.reconcileClusterRecovery(context.Background(), admin, rp, status)
+			require.NoError(t, err)
+			require.Equal(t, tt.requeue, requeue)
+
+			if tt.started {
+				require.Equal(t, 1, startCalls)
+			} else {
+				require.Zero(t, startCalls)
+			}
+
+			recovery := status.Recovery
+
+			if tt.reason == "" {
+				require.Nil(t, recovery)
+				return
+			}
+
+			require.NotNil(t, recovery)
+
+			status.Status.UpdateConditions(rp)
+			condition := apimeta.FindStatusCondition(rp.Status.Conditions, statuses.ClusterRecovered)
+			require.NotNil(t, condition)
+			require.Equal(t, string(tt.reason), condition.Reason)
+
+			if tt.reason != statuses.ClusterRecoveredReasonNotFresh {
+				require.NotNil(t, recovery.StartTime)
+			}
+			if tt.startTime != nil {
+				require.Equal(t, tt.startTime, recovery.StartTime)
+			}
+			if len(tt.reported.TopicDownloads) > 0 && !tt.started {
+				require.Equal(t, int32(2), recovery.PendingDownloads)
+				require.Equal(t, int32(2), recovery.SuccessfulDownloads)
+				require.Equal(t, int32(1), recovery.FailedDownloads)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+)
+
+// deprecationWarnings remembers, per cluster, the desired cluster
+// configuration whose deprecated properties were last warned about, so that
+// every sync of an unchanged configuration doesn't emit the same events.
+type deprecationWarnings struct {
+	mu     sync.Mutex
+	hashes map[types.UID]string
+}
+
+// changed records the given desired configuration for the cluster and
+// returns whether it differs from the one last recorded.
+func (w *deprecationWarnings) changed(rp *redpandav1alpha2.Redpanda, config map[string]any) bool {
+	// maps are marshalled with sorted keys, so equal configurations always
+	// hash the same
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return true
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256(encoded))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.hashes == nil {
+		w.hashes = map[types.UID]string{}
+	}
+
+	if w.hashes[rp.UID] == hash {
+		return false
+	}
+	w.hashes[rp.UID] = hash
+	return true
+}
+
+// forget drops what has been recorded for a deleted cluster.
+func (w *deprecationWarnings) forget(rp *redpandav1alpha2.Redpanda) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.hashes, rp.UID)
+}
+
+// warnDeprecatedProperties emits a Warning event for each deprecated property
+// of the desired cluster configuration, but only when that configuration has
+// changed since the cluster was last warned about.
+func (r *RedpandaReconciler) warnDeprecatedProperties(rp *redpandav1alpha2.Redpanda, config map[string]any, deprecated clusterconfiguration.ValidationErrors) {
+	if !r.deprecationWarnings.changed(rp, config) {
+		return
+	}
+
+	for _, property := range deprecated {
+		r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityInfo, fmt.Sprintf("Cluster configuration property %s", property.Error()))
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+)
+
+func TestWarnDeprecatedProperties(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &RedpandaReconciler{EventRecorder: recorder}
+
+	rp := &redpandav1alpha2.Redpanda{ObjectMeta: metav1.ObjectMeta{UID: "cluster"}}
+	deprecated := clusterconfiguration.ValidationErrors{{Key: "old_property", Message: "is deprecated"}}
+
+	config := map[string]any{"old_property": true, "other": 1}
+	r.warnDeprecatedProperties(rp, config, deprecated)
+	require.Len(t, recorder.Events, 1)
+	<-recorder.Events
+
+	// syncing the same configuration again doesn't warn again
+	r.warnDeprecatedProperties(rp, map[string]any{"other": 1, "old_property": true}, deprecated)
+	require.Empty(t, recorder.Events)
+
+	// but changing it does
+	config["other"] = 2
+	r.warnDeprecatedProperties(rp, config, deprecated)
+	require.Len(t, recorder.Events, 1)
+	<-recorder.Events
+
+	// as does a cluster that has been deleted and recreated
+	r.deprecationWarnings.forget(rp)
+	r.warnDeprecatedProperties(rp, config, deprecated)
+	require.Len(t, recorder.Events, 1)
+}
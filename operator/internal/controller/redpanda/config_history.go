@@ -0,0 +1,191 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/cmd/syncclusterconfig"
+)
+
+const (
+	// configHistoryLimit is the number of applied cluster configurations
+	// that are kept around to roll back to.
+	configHistoryLimit = 10
+	// configHistorySizeLimit keeps the history comfortably below the size
+	// limit of a ConfigMap.
+	configHistorySizeLimit = 768 * 1024
+	// configHistoryKey is the key of the history in its ConfigMap.
+	configHistoryKey = "history.json"
+)
+
+// configHistoryEntry is a cluster configuration applied by the operator.
+type configHistoryEntry struct {
+	// Version is the config version that applying the configuration resulted in.
+	Version int64 `json:"version"`
+	// Time is when the configuration was applied.
+	Time metav1.Time `json:"time"`
+	// Generation is the generation of the Redpanda resource that the
+	// configuration was applied for.
+	Generation int64 `json:"generation"`
+	// RollbackOf is the version that was rolled back to, if the
+	// configuration was applied by a rollback.
+	RollbackOf int64 `json:"rollbackOf,omitempty"`
+	// Changes is the diff that was applied.
+	Changes []syncclusterconfig.PropertyChange `json:"changes"`
+	// Config is the full configuration that was applied, without secret
+	// properties as they must never be written to a ConfigMap.
+	Config map[string]any `json:"config"`
+}
+
+// configRollbackError is returned when a rollback can't be performed as
+// requested, which retrying won't fix.
+type configRollbackError struct {
+	message string
+}
+
+func (e *configRollbackError) Error() string {
+	return e.message
+}
+
+func configHistoryName(rp *redpandav1alpha2.Redpanda) string {
+	return rp.Name + "-config-history"
+}
+
+// loadConfigHistory returns the recorded cluster configurations of the given
+// cluster, oldest first.
+func (r *RedpandaReconciler) loadConfigHistory(ctx context.Context, rp *redpandav1alpha2.Redpanda) ([]configHistoryEntry, error) {
+	var cm corev1.ConfigMap
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: rp.Namespace, Name: configHistoryName(rp)}, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	var history []configHistoryEntry
+	if data, ok := cm.Data[configHistoryKey]; ok {
+		if err := json.Unmarshal([]byte(data), &history); err != nil {
+			return nil, errors.Wrapf(err, "decoding cluster config history of %s", cm.Name)
+		}
+	}
+
+	return history, nil
+}
+
+// recordConfigHistory appends an applied cluster configuration to the history
+// of the given cluster, dropping the oldest entries past its limits.
+func (r *RedpandaReconciler) recordConfigHistory(ctx context.Context, rp *redpandav1alpha2.Redpanda, entry configHistoryEntry) error {
+	history, err := r.loadConfigHistory(ctx, rp)
+	if err != nil {
+		return err
+	}
+
+	history = append(history, entry)
+	if len(history) > configHistoryLimit {
+		history = history[len(history)-configHistoryLimit:]
+	}
+
+	var data []byte
+	for {
+		data, err = json.Marshal(history)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if len(data) <= configHistorySizeLimit || len(history) == 1 {
+			break
+		}
+		history = history[1:]
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rp.Namespace,
+			Name:      configHistoryName(rp),
+			// tie the history to the lifetime of the cluster
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rp, redpandav1alpha2.GroupVersion.WithKind("Redpanda"))},
+		},
+		Data: map[string]string{configHistoryKey: string(data)},
+	}
+
+	err = r.Client.Update(ctx, cm)
+	if apierrors.IsNotFound(err) {
+		err = r.Client.Create(ctx, cm)
+	}
+	return errors.WithStack(err)
+}
+
+// configRollbackVersion returns the config version named by the
+// [ConfigRollbackVersionKey] annotation, if the cluster is rolled back.
+func configRollbackVersion(rp *redpandav1alpha2.Redpanda) (int64, bool, error) {
+	annotation, ok := rp.Annotations[ConfigRollbackVersionKey]
+	if !ok {
+		return 0, false, nil
+	}
+
+	version, err := strconv.ParseInt(annotation, 10, 64)
+	if err != nil {
+		return 0, false, &configRollbackError{message: fmt.Sprintf("invalid value %q for %s: must be a config version", annotation, ConfigRollbackVersionKey)}
+	}
+	return version, true, nil
+}
+
+// rolledBackClusterConfig returns the configuration recorded for the given
+// config version. As secret properties aren't recorded, they keep their
+// currently desired values.
+func (r *RedpandaReconciler) rolledBackClusterConfig(ctx context.Context, rp *redpandav1alpha2.Redpanda, version int64, desired map[string]any, schema rpadmin.ConfigSchema) (map[string]any, error) {
+	history, err := r.loadConfigHistory(ctx, rp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range history {
+		if entry.Version != version {
+			continue
+		}
+
+		config := maps.Clone(entry.Config)
+		if config == nil {
+			config = map[string]any{}
+		}
+		for key, value := range desired {
+			if schema[key].IsSecret {
+				config[key] = value
+			}
+		}
+		return config, nil
+	}
+
+	return nil, &configRollbackError{message: fmt.Sprintf("cannot roll back to config version %d: it is not in the history kept in ConfigMap %s", version, configHistoryName(rp))}
+}
+
+// withoutSecrets returns a copy of the given configuration without its
+// secret properties.
+func withoutSecrets(config map[string]any, schema rpadmin.ConfigSchema) map[string]any {
+	filtered := make(map[string]any, len(config))
+	for key, value := range config {
+		if !schema[key].IsSecret {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/cmd/syncclusterconfig"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/controller"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+)
+
+func TestConfigHistory(t *testing.T) {
+	ctx := context.Background()
+
+	rp := &redpandav1alpha2.Redpanda{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster", UID: "uid"},
+	}
+	r := &RedpandaReconciler{Client: fake.NewClientBuilder().WithScheme(controller.V2Scheme).Build()}
+
+	schema := rpadmin.ConfigSchema{
+		"log_segment_size":         {Type: "integer"},
+		"cloud_storage_secret_key": {Type: "string", IsSecret: true},
+	}
+
+	for version := int64(1); version <= configHistoryLimit+2; version++ {
+		config := map[string]any{"log_segment_size": version, "cloud_storage_secret_key": "secret"}
+		require.NoError(t, r.recordConfigHistory(ctx, rp, configHistoryEntry{
+			Version: version,
+			Changes: []syncclusterconfig.PropertyChange{{Property: "log_segment_size", Operation: syncclusterconfig.PropertyChanged, New: version}},
+			Config:  withoutSecrets(config, schema),
+		}))
+	}
+
+	history, err := r.loadConfigHistory(ctx, rp)
+	require.NoError(t, err)
+	require.Len(t, history, configHistoryLimit)
+	require.Equal(t, int64(3), history[0].Version)
+	require.Equal(t, int64(configHistoryLimit+2), history[len(history)-1].Version)
+
+	// secrets must never end up in the ConfigMap
+	var cm corev1.ConfigMap
+	require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "cluster-config-history"}, &cm))
+	require.False(t, strings.Contains(cm.Data[configHistoryKey], "secret_key"))
+	require.Len(t, cm.OwnerReferences, 1)
+	require.Equal(t, rp.UID, cm.OwnerReferences[0].UID)
+
+	t.Run("rollback", func(t *testing.T) {
+		desired := map[string]any{"log_segment_size": 1024, "cloud_storage_secret_key": "rotated"}
+
+		config, err := r.rolledBackClusterConfig(ctx, rp, 5, desired, schema)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"log_segment_size": float64(5), "cloud_storage_secret_key": "rotated"}, config)
+	})
+
+	t.Run("rollback-missing-version", func(t *testing.T) {
+		_, err := r.rolledBackClusterConfig(ctx, rp, 1, nil, schema)
+
+		var rollback *configRollbackError
+		require.True(t, errors.As(err, &rollback))
+	})
+}
+
+func TestConfigRollbackVersion(t *testing.T) {
+	rp := &redpandav1alpha2.Redpanda{}
+
+	_, ok, err := configRollbackVersion(rp)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	rp.Annotations = map[string]string{ConfigRollbackVersionKey: "7"}
+	version, ok, err := configRollbackVersion(rp)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(7), version)
+
+	rp.Annotations[ConfigRollbackVersionKey] = "latest"
+	_, _, err = configRollbackVersion(rp)
+	var rollback *configRollbackError
+	require.True(t, errors.As(err, &rollback))
+}
+
+func TestDesiredClusterConfigRollback(t *testing.T) {
+	ctx := context.Background()
+
+	schema := rpadmin.ConfigSchema{
+		"log_segment_size":         {Type: "integer"},
+		"cloud_storage_secret_key": {Type: "string", IsSecret: true},
+	}
+
+	for name, tc := range map[string]struct {
+		cluster string
+		mode    syncclusterconfig.SyncerMode
+	}{
+		// the spec renders but contains unknown properties
+		"invalid": {cluster: `{"log_segmnt_size":1}`, mode: syncclusterconfig.SyncerModeDeclarative},
+		// the spec can't be rendered at all, so secret properties can't be
+		// restored and the configured mode is kept
+		"unrenderable": {cluster: `{"log_segment_size":"huge"}`, mode: syncclusterconfig.SyncerModeAdditive},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rp := &redpandav1alpha2.Redpanda{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster", UID: "uid"},
+				Spec: redpandav1alpha2.RedpandaSpec{
+					ClusterSpec: &redpandav1alpha2.RedpandaClusterSpec{
+						Config: &redpandav1alpha2.Config{
+							Cluster: &runtime.RawExtension{Raw: []byte(tc.cluster)},
+						},
+					},
+				},
+			}
+			r := &RedpandaReconciler{
+				Client:        fake.NewClientBuilder().WithScheme(controller.V2Scheme).Build(),
+				EventRecorder: record.NewFakeRecorder(100),
+			}
+
+			require.NoError(t, r.recordConfigHistory(ctx, rp, configHistoryEntry{
+				Version: 3,
+				Config:  map[string]any{"log_segment_size": 1024},
+			}))
+
+			_, err := r.desiredClusterConfig(ctx, rp, schema)
+			var invalid clusterconfiguration.ValidationErrors
+			require.True(t, errors.As(err, &invalid))
+
+			// the current spec doesn't prevent rolling back to a valid
+			// configuration
+			rp.Annotations = map[string]string{ConfigRollbackVersionKey: "3"}
+
+			desired, err := r.desiredClusterConfig(ctx, rp, schema)
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{"log_segment_size": float64(1024)}, desired.Config)
+			require.Equal(t, tc.mode, desired.Mode)
+			require.Nil(t, desired.Fragments)
+		})
+	}
+}
+
+func TestDesiredClusterConfigRollbackRemovesAddedProperties(t *testing.T) {
+	ctx := context.Background()
+
+	schema := rpadmin.ConfigSchema{
+		"log_segment_size": {Type: "integer"},
+		"retention_bytes":  {Type: "integer"},
+	}
+
+	// the bad revision added retention_bytes on top of the configuration
+	// that is rolled back to
+	rp := &redpandav1alpha2.Redpanda{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "cluster",
+			UID:         "uid",
+			Annotations: map[string]string{ConfigRollbackVersionKey: "3", SyncerModeKey: SyncerModeAdditive},
+		},
+		Spec: redpandav1alpha2.RedpandaSpec{
+			ClusterSpec: &redpandav1alpha2.RedpandaClusterSpec{
+				Config: &redpandav1alpha2.Config{
+					Cluster: &runtime.RawExtension{Raw: []byte(`{"log_segment_size":2048,"retention_bytes":5}`)},
+				},
+			},
+		},
+	}
+	r := &RedpandaReconciler{
+		Client:        fake.NewClientBuilder().WithScheme(controller.V2Scheme).Build(),
+		EventRecorder: record.NewFakeRecorder(100),
+	}
+
+	require.NoError(t, r.recordConfigHistory(ctx, rp, configHistoryEntry{
+		Version: 3,
+		Config:  map[string]any{"log_segment_size": 1024},
+	}))
+
+	desired, err := r.desiredClusterConfig(ctx, rp, schema)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"log_segment_size": float64(1024)}, desired.Config)
+
+	// rollbacks are synced declaratively, regardless of the configured mode,
+	// so that retention_bytes is removed from the cluster
+	require.Equal(t, syncclusterconfig.SyncerModeDeclarative, desired.Mode)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redpanda-data/common-go/rpadmin"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/statuses"
+)
+
+// DefaultLicenseExpiryWarningThresholds are the durations before a license's
+// expiration at which the operator warns about it by default.
+var DefaultLicenseExpiryWarningThresholds = []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}
+
+var (
+	licenseExpiration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "redpanda_license_expiration_timestamp_seconds",
+			Help: "Unix timestamp at which the license of a Redpanda cluster expires",
+		}, []string{"namespace", "cluster"},
+	)
+	licenseFeatureInUse = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "redpanda_license_enterprise_feature_in_use",
+			Help: "Whether an enterprise feature is in use by a Redpanda cluster",
+		}, []string{"namespace", "cluster", "feature"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(licenseExpiration, licenseFeatureInUse)
+}
+
+// recordLicenseMetrics exports the license expiration and the enterprise
+// features of the given cluster. The expiration keeps being exported once the
+// license has expired so that alerts on the remaining time keep firing; it's
+// only dropped when no license is loaded.
+func recordLicenseMetrics(rp *redpandav1alpha2.Redpanda, features []rpadmin.EnterpriseFeature, license rpadmin.License) {
+	labels := prometheus.Labels{"namespace": rp.Namespace, "cluster": rp.Name}
+
+	licenseFeatureInUse.DeletePartialMatch(labels)
+	for _, feature := range features {
+		inUse := 0.0
+		if feature.Enabled {
+			inUse = 1
+		}
+		licenseFeatureInUse.WithLabelValues(rp.Namespace, rp.Name, feature.Name).Set(inUse)
+	}
+
+	if !license.Loaded {
+		licenseExpiration.Delete(labels)
+		return
+	}
+	licenseExpiration.With(labels).Set(float64(max(license.Properties.Expires, 0)))
+}
+
+// forgetLicenseMetrics stops exporting the license metrics of a deleted cluster.
+func forgetLicenseMetrics(rp *redpandav1alpha2.Redpanda) {
+	labels := prometheus.Labels{"namespace": rp.Namespace, "cluster": rp.Name}
+
+	licenseExpiration.Delete(labels)
+	licenseFeatureInUse.DeletePartialMatch(labels)
+}
+
+// licenseExpiringSoonCondition returns the reason and message of the
+// LicenseExpiringSoon condition for the given license. The message names the
+// smallest threshold that has been crossed so that it only changes once per
+// crossed threshold.
+func licenseExpiringSoonCondition(license *redpandav1alpha2.RedpandaLicenseStatus, thresholds []time.Duration, now time.Time) (statuses.ClusterLicenseExpiringSoonCondition, string) {
+	switch {
+	case license.Expired != nil && *license.Expired:
+		return statuses.ClusterLicenseExpiringSoonReasonExpired, ""
+	case license.Expiration == nil:
+		return statuses.ClusterLicenseExpiringSoonReasonNotPresent, ""
+	}
+
+	remaining := license.Expiration.Sub(now)
+
+	sorted := slices.Clone(thresholds)
+	slices.Sort(sorted)
+
+	for _, threshold := range sorted {
+		if remaining <= threshold {
+			return statuses.ClusterLicenseExpiringSoonReasonExpiringSoon, fmt.Sprintf("License expires on %s, within the %s warning threshold", license.Expiration.UTC().Format(time.RFC3339), threshold)
+		}
+	}
+
+	return statuses.ClusterLicenseExpiringSoonReasonNotExpiringSoon, fmt.Sprintf("License expires on %s", license.Expiration.UTC().Format(time.RFC3339))
+}
+
+// crossedLicenseThreshold returns whether the given LicenseExpiringSoon
+// reason and message report a warning threshold that the cluster doesn't
+// already report, in which case a warning should be emitted.
+func crossedLicenseThreshold(rp *redpandav1alpha2.Redpanda, reason statuses.ClusterLicenseExpiringSoonCondition, message string) bool {
+	if reason != statuses.ClusterLicenseExpiringSoonReasonExpiringSoon {
+		return false
+	}
+
+	existing := apimeta.FindStatusCondition(rp.Status.Conditions, statuses.ClusterLicenseExpiringSoon)
+	return existing == nil || existing.Reason != string(reason) || existing.Message != message
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/statuses"
+)
+
+func TestLicenseExpiringSoonCondition(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	expiring := func(in time.Duration) *redpandav1alpha2.RedpandaLicenseStatus {
+		return &redpandav1alpha2.RedpandaLicenseStatus{
+			Expired:    ptr.To(false),
+			Expiration: &metav1.Time{Time: now.Add(in)},
+		}
+	}
+
+	for name, tt := range map[string]struct {
+		license   *redpandav1alpha2.RedpandaLicenseStatus
+		reason    statuses.ClusterLicenseExpiringSoonCondition
+		threshold string
+	}{
+		"no-license": {
+			license: &redpandav1alpha2.RedpandaLicenseStatus{},
+			reason:  statuses.ClusterLicenseExpiringSoonReasonNotPresent,
+		},
+		"expired": {
+			license: &redpandav1alpha2.RedpandaLicenseStatus{Expired: ptr.To(true)},
+			reason:  statuses.ClusterLicenseExpiringSoonReasonExpired,
+		},
+		"far-from-expiring": {
+			license: expiring(90 * day),
+			reason:  statuses.ClusterLicenseExpiringSoonReasonNotExpiringSoon,
+		},
+		"within-largest-threshold": {
+			license:   expiring(20 * day),
+			reason:    statuses.ClusterLicenseExpiringSoonReasonExpiringSoon,
+			threshold: "720h0m0s",
+		},
+		"within-smallest-threshold": {
+			license:   expiring(12 * time.Hour),
+			reason:    statuses.ClusterLicenseExpiringSoonReasonExpiringSoon,
+			threshold: "24h0m0s",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			reason, message := licenseExpiringSoonCondition(tt.license, DefaultLicenseExpiryWarningThresholds, now)
+			require.Equal(t, tt.reason, reason)
+			if tt.threshold != "" {
+				require.Contains(t, message, tt.threshold)
+			}
+		})
+	}
+}
+
+func TestCrossedLicenseThreshold(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	license := &redpandav1alpha2.RedpandaLicenseStatus{
+		Expired:    ptr.To(false),
+		Expiration: &metav1.Time{Time: now.Add(5 * 24 * time.Hour)},
+	}
+
+	rp := &redpandav1alpha2.Redpanda{}
+
+	reason, message := licenseExpiringSoonCondition(license, DefaultLicenseExpiryWarningThresholds, now)
+	require.True(t, crossedLicenseThreshold(rp, reason, message))
+
+	status := statuses.NewCluster()
+	status.SetLicenseExpiringSoon(reason, message)
+	status.UpdateConditions(rp)
+
+	// the same threshold a day later doesn't warn again
+	reason, message = licenseExpiringSoonCondition(license, DefaultLicenseExpiryWarningThresholds, now.Add(24*time.Hour))
+	require.False(t, crossedLicenseThreshold(rp, reason, message))
+
+	// but the next threshold does
+	reason, message = licenseExpiringSoonCondition(license, DefaultLicenseExpiryWarningThresholds, now.Add(4*24*time.Hour+time.Hour))
+	require.True(t, crossedLicenseThreshold(rp, reason, message))
+}
+
+func TestRecordLicenseMetrics(t *testing.T) {
+	rp := &redpandav1alpha2.Redpanda{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "license-metrics"}}
+	expiration := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	recordLicenseMetrics(rp, []rpadmin.EnterpriseFeature{
+		{Name: "audit_logging", Enabled: true},
+		{Name: "oidc", Enabled: false},
+	}, rpadmin.License{Loaded: true, Properties: rpadmin.LicenseProperties{Expires: expiration.Unix()}})
+
+	// expired licenses keep reporting their expiration, in the past
+	require.Equal(t, float64(expiration.Unix()), testutil.ToFloat64(licenseExpiration.WithLabelValues("default", "license-metrics")))
+	require.Equal(t, float64(1), testutil.ToFloat64(licenseFeatureInUse.WithLabelValues("default", "license-metrics", "audit_logging")))
+	require.Equal(t, float64(0), testutil.ToFloat64(licenseFeatureInUse.WithLabelValues("default", "license-metrics", "oidc")))
+
+	recordLicenseMetrics(rp, nil, rpadmin.License{Loaded: true, Properties: rpadmin.LicenseProperties{Expires: -1}})
+	require.Equal(t, float64(0), testutil.ToFloat64(licenseExpiration.WithLabelValues("default", "license-metrics")))
+
+	recordLicenseMetrics(rp, nil, rpadmin.License{})
+	require.Zero(t, testutil.CollectAndCount(licenseExpiration))
+
+	recordLicenseMetrics(rp, nil, rpadmin.License{Loaded: true, Properties: rpadmin.LicenseProperties{Expires: expiration.Unix()}})
+
+	forgetLicenseMetrics(rp)
+
+	require.Zero(t, testutil.CollectAndCount(licenseExpiration))
+	require.Zero(t, testutil.CollectAndCount(licenseFeatureInUse))
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/lifecycle"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/log"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/trace"
+)
+
+const (
+	rebalanceTriggerOnDemand  = "OnDemand"
+	rebalanceTriggerScheduled = "Scheduled"
+	rebalanceTriggerScaleUp   = "ScaleUp"
+
+	// the partition balancer statuses that mean no more partitions are
+	// going to be moved, see [rpadmin.PartitionBalancerStatus]
+	balancerStatusOff     = "off"
+	balancerStatusReady   = "ready"
+	balancerStatusStalled = "stalled"
+
+	// rebalanceSettleTime is how long a triggered rebalance that has not
+	// been seen moving any partitions is given before the partition balancer
+	// reporting no reassignments is taken as the rebalance having finished.
+	// It spans a couple of ticks of the balancer, which are 30s by default,
+	// as the balancer may only plan the requested moves on its next tick.
+	rebalanceSettleTime = time.Minute
+)
+
+// reconcilePartitionRebalance triggers on-demand, scheduled and post scale up
+// rebalances of partitions and tracks their progress through the partition
+// balancer status. It returns the rebalance status to record, if any, and
+// whether reconciliation should be requeued because a rebalance is running.
+func (r *RedpandaReconciler) reconcilePartitionRebalance(ctx context.Context, admin *rpadmin.AdminAPI, rp *redpandav1alpha2.Redpanda, brokers int32, settled bool) (_ *lifecycle.RebalanceStatus, _ bool, err error) {
+	ctx, span := trace.Start(ctx, "reconcilePartitionRebalance")
+	defer func() { trace.EndSpan(span, err) }()
+
+	logger := log.FromContext(ctx)
+
+	request := rp.Annotations[RebalancePartitionsKey]
+	if rp.Spec.PartitionRebalancing == nil && request == "" && rp.Status.Rebalance == nil {
+		return nil, false, nil
+	}
+
+	status := rebalanceStatusFromCluster(rp.Status.Rebalance)
+
+	// only track the number of brokers once all of them have joined so that
+	// a scale up is seen as a whole rather than broker by broker
+	previousBrokers := status.Brokers
+	if settled || status.Brokers == 0 {
+		status.Brokers = brokers
+	}
+
+	running := status.StartTime != nil && status.CompletionTime == nil
+
+	if !running {
+		trigger := rebalanceTrigger(rp, status, previousBrokers, brokers, settled, time.Now())
+		if trigger != "" {
+			logger.Info("triggering partition rebalance", "trigger", trigger)
+
+			if err := admin.TriggerBalancer(ctx); err != nil {
+				return nil, false, errors.Wrap(err, "triggering partition rebalance")
+			}
+
+			r.EventRecorder.Event(rp, "Normal", redpandav1alpha2.EventSeverityInfo, fmt.Sprintf("Triggered %s partition rebalance", trigger))
+
+			status.Trigger = trigger
+			if trigger == rebalanceTriggerOnDemand {
+				status.Request = request
+			}
+			status.StartTime = ptrNow()
+			status.CompletionTime = nil
+			status.MovingPartitions = 0
+			status.PeakMovingPartitions = 0
+
+			return status, true, nil
+		}
+	}
+
+	balancer, err := admin.GetPartitionStatus(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "fetching partition balancer status")
+	}
+
+	// whether this rebalance has already been seen moving partitions, in
+	// which case the balancer has planned its moves
+	moved := status.MovingPartitions > 0
+
+	setBalancerStatus(status, balancer, running)
+
+	if !running {
+		return status, false, nil
+	}
+
+	if !rebalanceFinished(balancer, moved, status.StartTime.Time, time.Now()) {
+		logger.V(log.DebugLevel).Info("partition rebalance in progress", "status", balancer.Status, "moving", balancer.CurrentReassignmentsCount)
+		return status, true, nil
+	}
+
+	status.CompletionTime = ptrNow()
+
+	if balancer.Status == balancerStatusReady {
+		r.EventRecorder.Event(rp, "Normal", redpandav1alpha2.EventSeverityInfo, "Partition rebalance finished")
+	} else {
+		r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityError, fmt.Sprintf("Partition rebalance finished with partition balancer status %q", balancer.Status))
+	}
+
+	return status, false, nil
+}
+
+// rebalanceTrigger returns what, if anything, should trigger a new rebalance.
+func rebalanceTrigger(rp *redpandav1alpha2.Redpanda, status *lifecycle.RebalanceStatus, previousBrokers, brokers int32, settled bool, now time.Time) string {
+	if request := rp.Annotations[RebalancePartitionsKey]; request != "" && request != status.Request {
+		return rebalanceTriggerOnDemand
+	}
+
+	rebalancing := rp.Spec.PartitionRebalancing
+	if rebalancing == nil {
+		return ""
+	}
+
+	if rebalancing.AfterScaleUp && settled && previousBrokers > 0 && brokers > previousBrokers {
+		return rebalanceTriggerScaleUp
+	}
+
+	if rebalancing.Interval != nil && rebalancing.Interval.Duration > 0 {
+		last := rp.CreationTimestamp.Time
+		if status.StartTime != nil {
+			last = status.StartTime.Time
+		}
+
+		if !now.Before(last.Add(rebalancing.Interval.Duration)) {
+			return rebalanceTriggerScheduled
+		}
+	}
+
+	return ""
+}
+
+// rebalanceFinished returns whether the partition balancer has stopped
+// moving partitions for a rebalance started at the given time. Unless the
+// rebalance has been seen moving partitions, an idle balancer is only
+// trusted once [rebalanceSettleTime] has passed since the rebalance started.
+func rebalanceFinished(balancer rpadmin.PartitionBalancerStatus, moved bool, started, now time.Time) bool {
+	switch balancer.Status {
+	case balancerStatusReady, balancerStatusStalled, balancerStatusOff:
+		if balancer.CurrentReassignmentsCount != 0 {
+			return false
+		}
+		return moved || !now.Before(started.Add(rebalanceSettleTime))
+	default:
+		return false
+	}
+}
+
+// setBalancerStatus records the given partition balancer status. While a
+// rebalance is running, the number of moving partitions also feeds the peak
+// observed during the rebalance as the current count drops back to zero once
+// it finishes.
+func setBalancerStatus(status *lifecycle.RebalanceStatus, balancer rpadmin.PartitionBalancerStatus, running bool) {
+	status.BalancerStatus = balancer.Status
+	status.MovingPartitions = int32(balancer.CurrentReassignmentsCount) //nolint:gosec // counts never overflow an int32
+	if running {
+		status.PeakMovingPartitions = max(status.PeakMovingPartitions, status.MovingPartitions)
+	}
+	status.UnavailableBrokers = toInt32s(balancer.Violations.UnavailableNodes)
+	status.OverDiskLimitBrokers = toInt32s(balancer.Violations.OverDiskLimitNodes)
+}
+
+func rebalanceStatusFromCluster(existing *redpandav1alpha2.PartitionRebalanceStatus) *lifecycle.RebalanceStatus {
+	if existing == nil {
+		return &lifecycle.RebalanceStatus{}
+	}
+
+	return &lifecycle.RebalanceStatus{
+		Trigger:              existing.Trigger,
+		Request:              existing.Request,
+		Brokers:              existing.Brokers,
+		StartTime:            existing.StartTime,
+		CompletionTime:       existing.CompletionTime,
+		BalancerStatus:       existing.BalancerStatus,
+		MovingPartitions:     existing.MovingPartitions,
+		PeakMovingPartitions: existing.PeakMovingPartitions,
+		UnavailableBrokers:   existing.UnavailableBrokers,
+		OverDiskLimitBrokers: existing.OverDiskLimitBrokers,
+	}
+}
+
+// poolsSettled returns whether every pool runs as many ready replicas as
+// it desires.
+func poolsSettled(pools []lifecycle.PoolStatus) bool {
+	for _, pool := range pools {
+		if pool.ReadyReplicas != pool.DesiredReplicas || pool.Replicas != pool.DesiredReplicas {
+			return false
+		}
+	}
+	return true
+}
+
+func toInt32s(values []int) []int32 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	converted := make([]int32, 0, len(values))
+	for _, value := range values {
+		converted = append(converted, int32(value)) //nolint:gosec // broker ids never overflow an int32
+	}
+	return converted
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/lifecycle"
+)
+
+func TestReconcilePartitionRebalance(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	justStarted := metav1.NewTime(time.Now().Add(-5 * time.Second))
+	old := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+
+	for name, tt := range map[string]struct {
+		rebalancing *redpandav1alpha2.PartitionRebalancing
+		annotation  string
+		existing    *redpandav1alpha2.PartitionRebalanceStatus
+		brokers     int32
+		settled     bool
+		reported    rpadmin.PartitionBalancerStatus
+		peak        int32
+		trigger     string
+		triggered   bool
+		requeue     bool
+		completed   bool
+		none        bool
+	}{
+		"disabled": {
+			none: true,
+		},
+		"on-demand": {
+			annotation: "first",
+			trigger:    rebalanceTriggerOnDemand,
+			triggered:  true,
+			requeue:    true,
+		},
+		"on-demand-already-handled": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &started, CompletionTime: &started},
+			reported:   rpadmin.PartitionBalancerStatus{Status: balancerStatusReady},
+			trigger:    rebalanceTriggerOnDemand,
+			completed:  true,
+		},
+		"scheduled": {
+			rebalancing: &redpandav1alpha2.PartitionRebalancing{Interval: &metav1.Duration{Duration: time.Hour}},
+			existing:    &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerScheduled, StartTime: &old, CompletionTime: &old},
+			trigger:     rebalanceTriggerScheduled,
+			triggered:   true,
+			requeue:     true,
+		},
+		"not-yet-scheduled": {
+			rebalancing: &redpandav1alpha2.PartitionRebalancing{Interval: &metav1.Duration{Duration: time.Hour}},
+			existing:    &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerScheduled, StartTime: &started, CompletionTime: &started},
+			reported:    rpadmin.PartitionBalancerStatus{Status: balancerStatusReady},
+			trigger:     rebalanceTriggerScheduled,
+			completed:   true,
+		},
+		"scale-up": {
+			rebalancing: &redpandav1alpha2.PartitionRebalancing{AfterScaleUp: true},
+			existing:    &redpandav1alpha2.PartitionRebalanceStatus{Brokers: 3},
+			brokers:     5,
+			settled:     true,
+			trigger:     rebalanceTriggerScaleUp,
+			triggered:   true,
+			requeue:     true,
+		},
+		"scaling-up": {
+			rebalancing: &redpandav1alpha2.PartitionRebalancing{AfterScaleUp: true},
+			existing:    &redpandav1alpha2.PartitionRebalanceStatus{Brokers: 3},
+			brokers:     4,
+			reported:    rpadmin.PartitionBalancerStatus{Status: balancerStatusReady},
+		},
+		"in-progress": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &started},
+			reported:   rpadmin.PartitionBalancerStatus{Status: "in_progress", CurrentReassignmentsCount: 4},
+			peak:       4,
+			trigger:    rebalanceTriggerOnDemand,
+			requeue:    true,
+		},
+		"in-progress-past-peak": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &started, MovingPartitions: 10, PeakMovingPartitions: 10},
+			reported:   rpadmin.PartitionBalancerStatus{Status: "in_progress", CurrentReassignmentsCount: 4},
+			peak:       10,
+			trigger:    rebalanceTriggerOnDemand,
+			requeue:    true,
+		},
+		"finished": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &started},
+			reported:   rpadmin.PartitionBalancerStatus{Status: balancerStatusReady},
+			trigger:    rebalanceTriggerOnDemand,
+			completed:  true,
+		},
+		"just-triggered": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &justStarted},
+			reported:   rpadmin.PartitionBalancerStatus{Status: balancerStatusReady},
+			trigger:    rebalanceTriggerOnDemand,
+			requeue:    true,
+		},
+		"finished-after-moving": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &justStarted, MovingPartitions: 2, PeakMovingPartitions: 2},
+			reported:   rpadmin.PartitionBalancerStatus{Status: balancerStatusReady},
+			peak:       2,
+			trigger:    rebalanceTriggerOnDemand,
+			completed:  true,
+		},
+		"stalled": {
+			annotation: "first",
+			existing:   &redpandav1alpha2.PartitionRebalanceStatus{Trigger: rebalanceTriggerOnDemand, Request: "first", StartTime: &started},
+			reported: rpadmin.PartitionBalancerStatus{Status: balancerStatusStalled, Violations: rpadmin.PartitionBalancerViolations{
+				UnavailableNodes: []int{2},
+			}},
+			trigger:   rebalanceTriggerOnDemand,
+			completed: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			triggerCalls := 0
+
+			// the Admin API is faked so that each stage of a rebalance can be
+			// exercised without moving any partitions
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/partitions/rebalance":
+					require.Equal(t, http.MethodPost, r.Method)
+					triggerCalls++
+				case "/v1/cluster/partition_balancer/status":
+					require.NoError(t, json.NewEncoder(w).Encode(tt.reported))
+				default:
+					t.Fatalf("unexpected request to %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			admin, err := rpadmin.NewAdminAPI([]string{server.URL}, &rpadmin.NopAuth{}, nil)
+			require.NoError(t, err)
+			defer admin.Close()
+
+			rp := &redpandav1alpha2.Redpanda{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: old},
+				Spec: redpandav1alpha2.RedpandaSpec{
+					PartitionRebalancing: tt.rebalancing,
+				},
+				Status: redpandav1alpha2.RedpandaStatus{
+					Rebalance: tt.existing,
+				},
+			}
+			if tt.annotation != "" {
+				rp.Annotations = map[string]string{RebalancePartitionsKey: tt.annotation}
+			}
+
+			brokers := tt.brokers
+			if brokers == 0 {
+				brokers = 3
+			}
+
+			r := &RedpandaReconciler{EventRecorder: record.NewFakeRecorder(10)}
+
+			rebalance, requeue, err := r.reconcilePartitionRebalance(context.Background(), admin, rp, brokers, tt.settled)
+			require.NoError(t, err)
+			require.Equal(t, tt.requeue, requeue)
+
+			if tt.none {
+				require.Nil(t, rebalance)
+				return
+			}
+
+			require.NotNil(t, rebalance)
+			require.Equal(t, tt.trigger, rebalance.Trigger)
+			require.Equal(t, tt.completed, rebalance.CompletionTime != nil)
+
+			// the peak survives the moving partitions dropping back to zero
+			require.Equal(t, tt.peak, rebalance.PeakMovingPartitions)
+
+			// a new rebalance is only triggered when none is running
+			if tt.triggered {
+				require.Equal(t, 1, triggerCalls)
+			} else {
+				require.Zero(t, triggerCalls)
+			}
+
+			if tt.reported.Status != "" {
+				require.Equal(t, tt.reported.Status, rebalance.BalancerStatus)
+				require.Equal(t, int32(tt.reported.CurrentReassignmentsCount), rebalance.MovingPartitions) //nolint:gosec // test counts are small
+				require.Len(t, rebalance.UnavailableBrokers, len(tt.reported.Violations.UnavailableNodes))
+			}
+
+			// the number of brokers is only recorded once pools have settled
+			if tt.settled || tt.existing == nil || tt.existing.Brokers == 0 {
+				require.Equal(t, brokers, rebalance.Brokers)
+			} else {
+				require.Equal(t, tt.existing.Brokers, rebalance.Brokers)
+			}
+		})
+	}
+}
+
+func TestPoolsSettled(t *testing.T) {
+	require.True(t, poolsSettled(nil))
+	require.True(t, poolsSettled([]lifecycle.PoolStatus{{Replicas: 3, DesiredReplicas: 3, ReadyReplicas: 3}}))
+	require.False(t, poolsSettled([]lifecycle.PoolStatus{{Replicas: 5, DesiredReplicas: 5, ReadyReplicas: 4}}))
+	require.False(t, poolsSettled([]lifecycle.PoolStatus{{Replicas: 3, DesiredReplicas: 5, ReadyReplicas: 3}}))
+}
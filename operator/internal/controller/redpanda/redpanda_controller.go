@@ -13,6 +13,7 @@ package redpanda
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -49,6 +50,11 @@ const (
 	SyncerModeKey                   = "operator.redpanda.com/config-sync-mode"
 	SyncerModeDeclarative           = "declarative"
 	SyncerModeAdditive              = "additive" // The default for the moment
+	ConfigSyncDryRunKey             = "operator.redpanda.com/config-sync-dry-run"
+	ConfigRollbackVersionKey        = "operator.redpanda.com/config-rollback-version"
+	RebalancePartitionsKey          = "operator.redpanda.com/rebalance-partitions"
+	ClientConfigNamespacesKey       = "operator.redpanda.com/client-config-namespaces"
+	ClientConfigCredentialsKey      = "operator.redpanda.com/client-config-credentials"
 
 	NotManaged = "false"
 
@@ -80,6 +86,12 @@ type RedpandaReconciler struct {
 	EventRecorder        kuberecorder.EventRecorder
 	ClientFactory        internalclient.ClientFactory
 	CloudSecretsExpander *pkgsecrets.CloudExpander
+	// LicenseExpiryWarningThresholds are the durations before a license's
+	// expiration at which the reconciler warns about it. Defaults to
+	// DefaultLicenseExpiryWarningThresholds.
+	LicenseExpiryWarningThresholds []time.Duration
+
+	deprecationWarnings deprecationWarnings
 }
 
 // Any resource that the Redpanda helm chart creates and needs to reconcile.
@@ -104,6 +116,8 @@ type RedpandaReconciler struct {
 // +kubebuilder:rbac:groups=cluster.redpanda.com,resources=redpandas,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.redpanda.com,resources=redpandas/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.redpanda.com,resources=redpandas/finalizers,verbs=update
+// +kubebuilder:rbac:groups=cluster.redpanda.com,resources=clusterconfigfragments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.redpanda.com,resources=clusterconfigfragments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core,namespace=default,resources=events,verbs=create;patch
 
 // sidecar resources
@@ -118,6 +132,8 @@ func (r *RedpandaReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Mana
 		return err
 	}
 
+	watchClusterConfigFragments(builder)
+
 	return builder.Complete(r)
 }
 
@@ -155,6 +171,13 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 	logger := log.FromContext(ctx)
 
 	if !isRedpandaManaged(ctx, rp) {
+		// copies of the client config in other namespaces can't be owned by
+		// the cluster, so they must be cleaned up along with its finalizer
+		if controllerutil.ContainsFinalizer(rp, FinalizerKey) {
+			if err := r.deletePublishedClientConfig(ctx, rp); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
 		if controllerutil.RemoveFinalizer(rp, FinalizerKey) {
 			if err := r.Client.Update(ctx, rp); err != nil {
 				logger.Error(err, "updating cluster finalizer")
@@ -195,6 +218,15 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		if deleted, err := r.LifecycleClient.DeleteAll(ctx, cluster); deleted || err != nil {
 			return r.syncStatusErr(ctx, err, status, cluster)
 		}
+		forgetLicenseMetrics(rp)
+		r.deprecationWarnings.forget(rp)
+
+		// copies of the client config in other namespaces can't be owned by
+		// the cluster, so they must be cleaned up before it's gone
+		if err := r.deletePublishedClientConfig(ctx, rp); err != nil {
+			return r.syncStatusErr(ctx, err, status, cluster)
+		}
+
 		if controllerutil.RemoveFinalizer(rp, FinalizerKey) {
 			if err := r.Client.Update(ctx, rp); err != nil {
 				logger.Error(err, "updating cluster finalizer")
@@ -247,6 +279,14 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		return r.syncStatusAndRequeue(ctx, status, cluster)
 	}
 
+	// publish how to connect to the cluster now that its listeners are up,
+	// clients are a convenience so failing to publish doesn't fail
+	// reconciliation
+	if err := r.reconcileClientConfig(ctx, rp); err != nil {
+		logger.Error(err, "error publishing client config")
+		r.EventRecorder.Eventf(rp, "Warning", redpandav1alpha2.EventSeverityError, "publishing client config: %s", err.Error())
+	}
+
 	admin, err := r.ClientFactory.RedpandaAdminClient(ctx, rp)
 	if err != nil {
 		logger.Error(err, "error fetching redpanda admin client")
@@ -275,6 +315,17 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		return r.syncStatusAndRequeue(ctx, status, cluster)
 	}
 
+	// resize any broker volumes whose requested size grew or whose disk usage
+	// is above the autoscaling threshold
+	volumes, err := r.reconcileVolumes(ctx, admin, rp, pools.VolumeClaims())
+	if err != nil {
+		status.Status.SetResourcesSynced(statuses.ClusterResourcesSyncedReasonError, err.Error())
+
+		logger.Error(err, "error expanding volumes")
+		return r.syncStatusErr(ctx, err, status, cluster)
+	}
+	status.Volumes = volumes
+
 	status.Status.SetResourcesSynced(statuses.ClusterResourcesSyncedReasonSynced)
 	if health.IsHealthy {
 		status.Status.SetHealthy(statuses.ClusterHealthyReasonHealthy)
@@ -283,6 +334,17 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		status.Status.SetHealthy(statuses.ClusterHealthyReasonNotHealthy, "Cluster is not healthy")
 	}
 
+	// restore the topics and metadata of a freshly bootstrapped cluster from
+	// tiered storage before we apply any configuration on top of it
+	requeue, err = r.reconcileClusterRecovery(ctx, admin, rp, status)
+	if err != nil {
+		logger.Error(err, "error recovering cluster")
+		return r.syncStatusErr(ctx, err, status, cluster)
+	}
+	if requeue {
+		return r.syncStatusAndRequeue(ctx, status, cluster)
+	}
+
 	// we rate limit setting cluster configuration to once a minute if it's already been applied and is up-to-date
 	// NB: For this and the next block, we heavily rely on the likelihood of things getting requeued on a fairly regular
 	// basis, but in some odd cases this could potentially be problematic. Take for instance someone changing a ConfigMap
@@ -294,23 +356,48 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 	// This could cause issues of staleness where we have to wait for retrigger of reconciliation either via some watched resource
 	// change, or, in worst case, the default runtime cache-sync interval of ~10 hours. On the flip-side, it causes us to hammer
 	// the API less often.
+	pendingConfigChanges := false
 	if !statuses.HasRecentCondition(rp, statuses.ClusterConfigurationApplied, metav1.ConditionTrue, time.Minute) {
-		version, requeue, err := r.reconcileClusterConfig(ctx, admin, rp)
-		if err != nil {
-			status.Status.SetConfigurationApplied(statuses.ClusterConfigurationAppliedReasonError, err.Error())
+		if r.configSyncDryRun(rp) {
+			changes, err := r.diffClusterConfig(ctx, admin, rp)
+			if err != nil {
+				logger.Error(err, "error diffing cluster config")
+				if r.setConfigurationAppliedError(rp, status, err) {
+					return r.syncStatus(ctx, status, cluster)
+				}
+				return r.syncStatusErr(ctx, err, status, cluster)
+			}
 
-			logger.Error(err, "error reconciling cluster config")
-			return r.syncStatusErr(ctx, err, status, cluster)
-		}
+			status.PendingConfigChanges = changes
+
+			// nothing has been applied, so the configuration is only as
+			// applied as it was before
+			if len(changes) > 0 {
+				pendingConfigChanges = true
+				status.Status.SetConfigurationAppliedFromCurrent(rp)
+			}
+		} else {
+			version, requeue, err := r.reconcileClusterConfig(ctx, admin, rp)
+			if err != nil {
+				logger.Error(err, "error reconciling cluster config")
+				if r.setConfigurationAppliedError(rp, status, err) {
+					return r.syncStatus(ctx, status, cluster)
+				}
+				return r.syncStatusErr(ctx, err, status, cluster)
+			}
 
-		status.ConfigVersion = ptr.To(version)
+			status.ConfigVersion = ptr.To(version)
+			status.PendingConfigChanges = []lifecycle.ConfigChange{}
 
-		if requeue {
-			return r.syncStatusAndRequeue(ctx, status, cluster)
+			if requeue {
+				return r.syncStatusAndRequeue(ctx, status, cluster)
+			}
 		}
 	}
 
-	status.Status.SetConfigurationApplied(statuses.ClusterConfigurationAppliedReasonApplied)
+	if !pendingConfigChanges {
+		status.Status.SetConfigurationApplied(statuses.ClusterConfigurationAppliedReasonApplied)
+	}
 
 	// rate limit license reconciliation
 	if !statuses.HasRecentCondition(rp, statuses.ClusterLicenseValid, metav1.ConditionTrue, time.Minute) {
@@ -323,8 +410,21 @@ func (r *RedpandaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 			rp.Status.LicenseStatus = license
 		}
 	} else {
-		// just copy over the license status from our existing status
+		// just copy over the license statuses from our existing status
 		status.Status.SetLicenseValidFromCurrent(rp)
+		status.Status.SetLicenseExpiringSoonFromCurrent(rp)
+	}
+
+	rebalance, requeue, err := r.reconcilePartitionRebalance(ctx, admin, rp, int32(len(health.AllNodes)), poolsSettled(status.Pools)) //nolint:gosec // broker counts never overflow an int32
+	if err != nil {
+		logger.Error(err, "error reconciling partition rebalance")
+		return r.syncStatusErr(ctx, err, status, cluster)
+	}
+
+	status.Rebalance = rebalance
+
+	if requeue {
+		return r.syncStatusAndRequeue(ctx, status, cluster)
 	}
 
 	return r.syncStatus(ctx, status, cluster)
@@ -542,7 +642,7 @@ func (r *RedpandaReconciler) reconcileLicense(ctx context.Context, admin *rpadmi
 		status.Status.SetLicenseValid(statuses.ClusterLicenseValidReasonValid)
 	}
 
-	licenseStatus := func() *redpandav1alpha2.RedpandaLicenseStatus {
+	license := func() *redpandav1alpha2.RedpandaLicenseStatus {
 		inUseFeatures := []string{}
 		for _, feature := range features.Features {
 			if feature.Enabled {
@@ -573,9 +673,22 @@ func (r *RedpandaReconciler) reconcileLicense(ctx context.Context, admin *rpadmi
 		}
 
 		return status
+	}()
+
+	recordLicenseMetrics(rp, features.Features, licenseInfo)
+
+	thresholds := r.LicenseExpiryWarningThresholds
+	if thresholds == nil {
+		thresholds = DefaultLicenseExpiryWarningThresholds
+	}
+
+	reason, message := licenseExpiringSoonCondition(license, thresholds, time.Now())
+	if crossedLicenseThreshold(rp, reason, message) {
+		r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityError, message)
 	}
+	status.Status.SetLicenseExpiringSoon(reason, message)
 
-	return licenseStatus(), nil
+	return license, nil
 }
 
 func (r *RedpandaReconciler) reconcileClusterConfig(ctx context.Context, admin *rpadmin.AdminAPI, rp *redpandav1alpha2.Redpanda) (_ string, _ bool, err error) {
@@ -587,25 +700,207 @@ func (r *RedpandaReconciler) reconcileClusterConfig(ctx context.Context, admin *
 		return "", false, errors.WithStack(err)
 	}
 
-	config, err := r.clusterConfigFor(ctx, rp, schema)
+	desired, err := r.desiredClusterConfig(ctx, rp, schema)
 	if err != nil {
-		return "", false, errors.WithStack(err)
+		return "", false, err
 	}
 
-	usersTXT, err := r.usersTXTFor(ctx, rp)
+	syncer := syncclusterconfig.Syncer{Client: admin, Mode: desired.Mode}
+	configStatus, syncErr := syncer.Sync(ctx, desired.Config, desired.UsersTXT)
+
+	// fragments are only reported as applied once the configuration they're
+	// part of has been synced, failing to do so doesn't fail reconciliation
+	if desired.Fragments != nil {
+		if err := r.syncClusterConfigFragmentStatuses(ctx, *desired.Fragments, syncErr); err != nil {
+			log.FromContext(ctx).Error(err, "error updating ClusterConfigFragment statuses")
+		}
+	}
+
+	if syncErr != nil {
+		return "", false, errors.WithStack(syncErr)
+	}
+
+	if len(configStatus.Changes) > 0 {
+		entry := configHistoryEntry{
+			Version:    configStatus.Version,
+			Time:       metav1.Now(),
+			Generation: rp.Generation,
+			Changes:    configStatus.Changes,
+			Config:     withoutSecrets(desired.Config, schema),
+		}
+
+		if version, ok, _ := configRollbackVersion(rp); ok {
+			entry.RollbackOf = version
+			r.EventRecorder.Event(rp, "Normal", redpandav1alpha2.EventSeverityInfo, fmt.Sprintf("Rolled back cluster configuration to config version %d", version))
+		}
+
+		// the configuration has been applied regardless, so failing to
+		// record it doesn't fail reconciliation
+		if err := r.recordConfigHistory(ctx, rp, entry); err != nil {
+			log.FromContext(ctx).Error(err, "error recording cluster config history")
+		}
+	}
+
+	return configStatus.PropertiesThatNeedRestartHash, configStatus.NeedsRestart, nil
+}
+
+// diffClusterConfig returns the changes that reconcileClusterConfig would
+// make to the cluster configuration without applying them.
+func (r *RedpandaReconciler) diffClusterConfig(ctx context.Context, admin *rpadmin.AdminAPI, rp *redpandav1alpha2.Redpanda) (_ []lifecycle.ConfigChange, err error) {
+	ctx, span := trace.Start(ctx, "diffClusterConfig")
+	defer func() { trace.EndSpan(span, err) }()
+
+	schema, err := admin.ClusterConfigSchema(ctx)
 	if err != nil {
-		return "", false, errors.WithStack(err)
+		return nil, errors.WithStack(err)
+	}
+
+	desired, err := r.desiredClusterConfig(ctx, rp, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	syncer := syncclusterconfig.Syncer{Client: admin, Mode: desired.Mode}
+	diff, err := syncer.Diff(ctx, desired.Config, desired.UsersTXT)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	changes := []lifecycle.ConfigChange{}
+	for _, change := range diff {
+		current, err := encodeConfigValue(change.Old)
+		if err != nil {
+			return nil, err
+		}
+		desired, err := encodeConfigValue(change.New)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, lifecycle.ConfigChange{
+			Property:     change.Property,
+			Operation:    string(change.Operation),
+			CurrentValue: current,
+			DesiredValue: desired,
+			NeedsRestart: change.NeedsRestart,
+		})
 	}
 
+	return changes, nil
+}
+
+// clusterConfigSync is the cluster configuration to sync to a cluster.
+type clusterConfigSync struct {
+	Config   map[string]any
+	UsersTXT map[string][]byte
+	Mode     syncclusterconfig.SyncerMode
+	// Fragments holds the ClusterConfigFragments merged into Config, which
+	// is nil when rolling back as they're not applied then.
+	Fragments *clusterConfigFragmentMerge
+}
+
+// desiredClusterConfig returns the cluster configuration to apply along with
+// the mode to sync it in. Rollbacks are synced declaratively so that they
+// restore a configuration exactly as it was, with the exception of rollbacks
+// of resources whose configuration can't be rendered which are synced in the
+// configured mode.
+func (r *RedpandaReconciler) desiredClusterConfig(ctx context.Context, rp *redpandav1alpha2.Redpanda, schema rpadmin.ConfigSchema) (clusterConfigSync, error) {
 	mode := r.configSyncMode(ctx, rp)
 
-	syncer := syncclusterconfig.Syncer{Client: admin, Mode: mode}
-	configStatus, err := syncer.Sync(ctx, config, usersTXT)
+	// a rollback pins the cluster to a previously applied configuration
+	// for as long as it is requested, so it's resolved first to not depend
+	// on the current spec being valid
+	version, rollback, err := configRollbackVersion(rp)
 	if err != nil {
-		return "", false, errors.WithStack(err)
+		return clusterConfigSync{}, err
+	}
+	if rollback {
+		mode = syncclusterconfig.SyncerModeDeclarative
 	}
 
-	return configStatus.PropertiesThatNeedRestartHash, configStatus.NeedsRestart, nil
+	config, fragments, err := r.clusterConfigFor(ctx, rp, schema)
+	if err != nil {
+		if !rollback {
+			return clusterConfigSync{}, errors.WithStack(err)
+		}
+
+		// secret properties aren't recorded and can only be taken from the
+		// current spec, so rather than forcing a declarative sync that
+		// would remove them the configured mode is kept
+		log.FromContext(ctx).Error(err, "error rendering cluster config, rolling back without secret properties")
+		config = nil
+		mode = r.configSyncMode(ctx, rp)
+	}
+
+	if rollback {
+		config, err = r.rolledBackClusterConfig(ctx, rp, version, config, schema)
+		if err != nil {
+			return clusterConfigSync{}, err
+		}
+		fragments = nil
+	}
+
+	// catch mistakes before they are sent to the brokers, which would
+	// otherwise reject or silently ignore them
+	invalid, deprecated := clusterconfiguration.ValidateClusterConfiguration(config, schema)
+	r.warnDeprecatedProperties(rp, config, deprecated)
+	if len(invalid) > 0 {
+		return clusterConfigSync{}, errors.WithStack(invalid)
+	}
+
+	usersTXT, err := r.usersTXTFor(ctx, rp)
+	if err != nil {
+		return clusterConfigSync{}, errors.WithStack(err)
+	}
+
+	return clusterConfigSync{
+		Config:    config,
+		UsersTXT:  usersTXT,
+		Mode:      mode,
+		Fragments: fragments,
+	}, nil
+}
+
+// setConfigurationAppliedError sets the ConfigurationApplied condition for
+// an error that occurred while applying the cluster configuration. Invalid
+// configuration won't fix itself by retrying, so it is reported per
+// property through Warning events and marked as terminal, in which case true
+// is returned.
+func (r *RedpandaReconciler) setConfigurationAppliedError(rp *redpandav1alpha2.Redpanda, status *lifecycle.ClusterStatus, err error) bool {
+	var invalid clusterconfiguration.ValidationErrors
+	var rollback *configRollbackError
+
+	switch {
+	case errors.As(err, &invalid):
+		for _, property := range invalid {
+			r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityError, fmt.Sprintf("Invalid cluster configuration property %s", property.Error()))
+		}
+		status.Status.SetConfigurationApplied(statuses.ClusterConfigurationAppliedReasonTerminalError, invalid.Error())
+		return true
+	case errors.As(err, &rollback):
+		r.EventRecorder.Event(rp, "Warning", redpandav1alpha2.EventSeverityError, rollback.Error())
+		status.Status.SetConfigurationApplied(statuses.ClusterConfigurationAppliedReasonTerminalError, rollback.Error())
+		return true
+	default:
+		status.Status.SetConfigurationApplied(statuses.ClusterConfigurationAppliedReasonError, err.Error())
+		return false
+	}
+}
+
+func encodeConfigValue(value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(encoded), nil
+}
+
+func (r *RedpandaReconciler) configSyncDryRun(rp *redpandav1alpha2.Redpanda) bool {
+	return strings.EqualFold(rp.Annotations[ConfigSyncDryRunKey], "true")
 }
 
 func (r *RedpandaReconciler) configSyncMode(ctx context.Context, rp *redpandav1alpha2.Redpanda) syncclusterconfig.SyncerMode {
@@ -644,7 +939,9 @@ func (r *RedpandaReconciler) usersTXTFor(ctx context.Context, rp *redpandav1alph
 	return users.Data, nil
 }
 
-func (r *RedpandaReconciler) clusterConfigFor(ctx context.Context, rp *redpandav1alpha2.Redpanda, schema rpadmin.ConfigSchema) (_ map[string]any, err error) {
+// clusterConfigFor renders the cluster configuration of rp, including the
+// keys of its ClusterConfigFragments, which are returned merged.
+func (r *RedpandaReconciler) clusterConfigFor(ctx context.Context, rp *redpandav1alpha2.Redpanda, schema rpadmin.ConfigSchema) (_ map[string]any, _ *clusterConfigFragmentMerge, err error) {
 	// Parinoided panic catch as we're calling directly into helm functions.
 	defer func() {
 		if r := recover(); r != nil {
@@ -654,7 +951,7 @@ func (r *RedpandaReconciler) clusterConfigFor(ctx context.Context, rp *redpandav
 
 	dot, err := rp.GetDot(r.KubeConfig)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, nil, errors.WithStack(err)
 	}
 
 	// The most reliable way to get the correct and full cluster config is to
@@ -664,29 +961,47 @@ func (r *RedpandaReconciler) clusterConfigFor(ctx context.Context, rp *redpandav
 	job := redpanda.PostInstallUpgradeJob(dot)
 	clusterConfigTemplate, fixups := redpanda.BootstrapContents(dot)
 	conf := clusterconfiguration.NewClusterCfg(clusterconfiguration.NewPodContext(rp.Namespace))
+	// Keys set by the Redpanda resource, explicitly or derived from its other
+	// settings, take precedence over those of ClusterConfigFragments.
+	owners := map[string]string{}
 	for k, v := range clusterConfigTemplate {
 		conf.SetAdditionalConfiguration(k, v)
+		owners[k] = clusterConfigOwner("Redpanda", rp.Name)
 	}
 	for _, f := range fixups {
 		conf.AddFixup(f.Field, f.CEL)
+		owners[f.Field] = clusterConfigOwner("Redpanda", rp.Name)
+	}
+
+	fragments, err := r.clusterConfigFragments(ctx, rp)
+	if err != nil {
+		return nil, nil, err
+	}
+	invalid, err := r.validateClusterConfigFragments(ctx, fragments, schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	merge := mergeClusterConfigFragments(owners, fragments, invalid)
+	for k, v := range merge.Config {
+		conf.Set(k, v)
 	}
 	for _, e := range job.Spec.Template.Spec.InitContainers[0].Env {
 		if err := conf.EnsureInitEnv(e); err != nil {
-			return nil, errors.WithStack(err)
+			return nil, nil, errors.WithStack(err)
 		}
 	}
 	for _, e := range job.Spec.Template.Spec.InitContainers[0].EnvFrom {
 		if err := conf.EnsureInitEnvFrom(e); err != nil {
-			return nil, errors.WithStack(err)
+			return nil, nil, errors.WithStack(err)
 		}
 	}
 
 	desired, err := conf.Reify(ctx, r.Client, r.CloudSecretsExpander, schema)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, nil, errors.WithStack(err)
 	}
 
-	return desired, nil
+	return desired, &merge, nil
 }
 
 // syncStatus updates the status of the Redpanda cluster at the end of reconciliation when
@@ -820,6 +1135,17 @@ func validateClusterParameters(rp *redpandav1alpha2.Redpanda) error {
 		return errors.New("Specifying chartVersion is no longer supported. Please downgrade or unset `chartRef.chartVersion`")
 	}
 
+	if rp.Spec.ClusterRecovery != nil && rp.Spec.ClusterRecovery.Enabled {
+		values, err := rp.GetValues()
+		if err != nil {
+			return err
+		}
+
+		if !values.Storage.IsTieredStorageEnabled() {
+			return errors.New("clusterRecovery requires Tiered Storage to be enabled. Please set `clusterSpec.storage.tiered.config.cloud_storage_enabled`")
+		}
+	}
+
 	return nil
 }
 
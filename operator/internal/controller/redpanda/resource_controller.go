@@ -142,6 +142,10 @@ func handleResourceSyncErrors(err error) (metav1.Condition, error) {
 	if internalclient.IsInvalidClusterError(err) {
 		return redpandav1alpha2.ResourceNotSyncedCondition(redpandav1alpha2.ResourceConditionReasonClusterRefInvalid, err), nil
 	}
+	// The cluster will eventually finish recovering, so requeue.
+	if internalclient.IsClusterRecoveringError(err) {
+		return redpandav1alpha2.ResourceNotSyncedCondition(redpandav1alpha2.ResourceConditionReasonClusterRecovering, err), err
+	}
 	if internalclient.IsConfigurationError(err) {
 		return redpandav1alpha2.ResourceNotSyncedCondition(redpandav1alpha2.ResourceConditionReasonConfigurationInvalid, err), nil
 	}
@@ -138,6 +138,11 @@ func (r *TopicReconciler) reconcile(ctx context.Context, topic *redpandav1alpha2
 	}
 
 	kafkaClient, err := r.createKafkaClient(ctx, topic, l)
+	if internalclient.IsClusterRecoveringError(err) {
+		// the topic may be restored by the recovery, so wait for it to finish
+		l.V(log.DebugLevel).Info("waiting for cluster recovery to finish", "topic-name", topic.GetTopicName())
+		return redpandav1alpha2.TopicProgressing(topic), ctrl.Result{RequeueAfter: interval.Duration}, nil
+	}
 	if err != nil {
 		return redpandav1alpha2.TopicFailed(topic), ctrl.Result{}, err
 	}
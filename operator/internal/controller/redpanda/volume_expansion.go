@@ -0,0 +1,311 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/lifecycle"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/log"
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/trace"
+)
+
+const (
+	// LastVolumeExpansionKey is the annotation recording on a PersistentVolumeClaim
+	// the last time it was resized by the operator. It is used to enforce the
+	// cooldown of usage based expansion.
+	LastVolumeExpansionKey = "operator.redpanda.com/last-volume-expansion"
+
+	// datadirVolume is the name of the volumeClaimTemplate holding a broker's
+	// data directory, the only volume that usage based expansion applies to.
+	datadirVolume = "datadir"
+
+	defaultVolumeUsageThresholdPercent = 80
+	defaultVolumeIncreasePercent       = 25
+	defaultVolumeExpansionCooldown     = time.Hour
+
+	// volumeSizeIncrement is the granularity that usage based expansions are
+	// rounded up to as most cloud providers only provision whole gibibytes.
+	volumeSizeIncrement = 1 << 30
+
+	volumeNotExpandable      = "StorageClassNotExpandable"
+	volumeMaximumSizeReached = "MaximumSizeReached"
+)
+
+// +kubebuilder:rbac:groups=core,namespace=default,resources=persistentvolumeclaims,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+
+// reconcileVolumes resizes the PersistentVolumeClaims of existing brokers,
+// either because the size requested by their pool grew or because a broker's
+// disk usage crossed the configured autoscaling threshold. claims are the
+// claims mounted by existing brokers, see [lifecycle.PoolTracker.VolumeClaims].
+// It returns the status of every examined claim.
+func (r *RedpandaReconciler) reconcileVolumes(ctx context.Context, admin *rpadmin.AdminAPI, rp *redpandav1alpha2.Redpanda, claims []*lifecycle.VolumeClaim) (_ []lifecycle.VolumeStatus, err error) {
+	ctx, span := trace.Start(ctx, "reconcileVolumes")
+	defer func() { trace.EndSpan(span, err) }()
+
+	logger := log.FromContext(ctx)
+
+	expansion := rp.Spec.VolumeExpansion
+	if expansion == nil {
+		expansion = &redpandav1alpha2.VolumeExpansion{}
+	}
+
+	autoscaling := expansion.Autoscaling != nil && expansion.Autoscaling.Enabled
+	if !ptr.Deref(expansion.Enabled, true) && !autoscaling {
+		return nil, nil
+	}
+
+	usage := map[string]int32{}
+	if autoscaling {
+		usage, err = r.fetchDiskUsage(ctx, admin)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetching disk usage")
+		}
+	}
+
+	// Warnings about claims that can't be expanded are only emitted when a
+	// claim becomes blocked as opposed to on every reconciliation.
+	blocked := map[string]string{}
+	for _, volume := range rp.Status.Volumes {
+		blocked[volume.Name] = volume.ExpansionBlockedReason
+	}
+
+	now := time.Now()
+	volumes := []lifecycle.VolumeStatus{}
+	for _, claim := range claims {
+		var pvc corev1.PersistentVolumeClaim
+		if err := r.Client.Get(ctx, claim.Name, &pvc); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, errors.WithStack(err)
+		}
+
+		var podUsage *int32
+		if percent, ok := usage[claim.Pod.Name]; ok && claim.Template == datadirVolume {
+			podUsage = ptr.To(percent)
+		}
+
+		lastExpansion := lastVolumeExpansion(&pvc)
+		current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		blockedReason := ""
+
+		if target, reason, ok := desiredVolumeSize(expansion, claim, current, podUsage, lastExpansion, now); ok {
+			expandable, err := r.isVolumeExpandable(ctx, &pvc)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			if !expandable {
+				blockedReason = volumeNotExpandable
+				if blocked[pvc.Name] != blockedReason {
+					r.EventRecorder.Eventf(rp, corev1.EventTypeWarning, redpandav1alpha2.EventSeverityError, "cannot expand PersistentVolumeClaim %s to %s: its StorageClass does not allow volume expansion", pvc.Name, target.String())
+				}
+			} else {
+				logger.V(log.TraceLevel).Info("expanding PersistentVolumeClaim", "PersistentVolumeClaim", claim.Name.String(), "from", current.String(), "to", target.String())
+
+				patch := client.MergeFrom(pvc.DeepCopy())
+				if pvc.Annotations == nil {
+					pvc.Annotations = map[string]string{}
+				}
+				pvc.Annotations[LastVolumeExpansionKey] = now.UTC().Format(time.RFC3339)
+				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = target
+
+				if err := r.Client.Patch(ctx, &pvc, patch); err != nil {
+					return nil, errors.Wrap(err, "expanding persistentvolumeclaim")
+				}
+
+				r.EventRecorder.Eventf(rp, corev1.EventTypeNormal, redpandav1alpha2.EventSeverityInfo, "expanding PersistentVolumeClaim %s from %s to %s: %s", pvc.Name, current.String(), target.String(), reason)
+
+				current = target
+				lastExpansion = &metav1.Time{Time: now.UTC().Truncate(time.Second)}
+			}
+		} else if autoscaling && podUsage != nil && *podUsage >= usageThresholdPercent(expansion.Autoscaling) && current.Cmp(expansion.Autoscaling.MaxSize) >= 0 {
+			blockedReason = volumeMaximumSizeReached
+			if blocked[pvc.Name] != blockedReason {
+				r.EventRecorder.Eventf(rp, corev1.EventTypeWarning, redpandav1alpha2.EventSeverityError, "cannot expand PersistentVolumeClaim %s with a disk usage of %d%%: it has reached the maximum size of %s", pvc.Name, *podUsage, expansion.Autoscaling.MaxSize.String())
+			}
+		}
+
+		var capacity *resource.Quantity
+		if size, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+			capacity = ptr.To(size.DeepCopy())
+		}
+
+		volumes = append(volumes, lifecycle.VolumeStatus{
+			Name:                   pvc.Name,
+			Pod:                    claim.Pod.Name,
+			RequestedSize:          current.DeepCopy(),
+			Capacity:               capacity,
+			UsagePercent:           podUsage,
+			LastExpansionTime:      lastExpansion,
+			ExpansionBlockedReason: blockedReason,
+		})
+	}
+
+	return volumes, nil
+}
+
+// fetchDiskUsage returns the percentage of disk space used by the data
+// directory of every broker, keyed by the name of the broker's pod. Brokers
+// that can't be reached are omitted.
+func (r *RedpandaReconciler) fetchDiskUsage(ctx context.Context, admin *rpadmin.AdminAPI) (map[string]int32, error) {
+	logger := log.FromContext(ctx)
+
+	brokers, err := admin.Brokers(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	usage := map[string]int32{}
+	for _, broker := range brokers {
+		if !ptr.Deref(broker.IsAlive, true) {
+			continue
+		}
+
+		podName := strings.Split(broker.InternalRPCAddress, ".")[0]
+
+		stat, err := func() (rpadmin.DiskStatInfo, error) {
+			brokerAdmin, err := admin.ForBroker(ctx, broker.NodeID)
+			if err != nil {
+				return nil, err
+			}
+			defer brokerAdmin.Close()
+
+			return brokerAdmin.DiskData(ctx)
+		}()
+		if err != nil {
+			logger.V(log.TraceLevel).Info("fetching disk usage", "Pod", podName, "error", err.Error())
+			continue
+		}
+
+		if percent, ok := diskUsagePercent(stat); ok {
+			usage[podName] = percent
+		}
+	}
+
+	return usage, nil
+}
+
+func (r *RedpandaReconciler) isVolumeExpandable(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	name := ptr.Deref(pvc.Spec.StorageClassName, "")
+	if name == "" {
+		return false, nil
+	}
+
+	var class storagev1.StorageClass
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: name}, &class); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+
+	return ptr.Deref(class.AllowVolumeExpansion, false), nil
+}
+
+// desiredVolumeSize returns the size that a claim currently requesting current
+// should be expanded to along with the reason for the expansion. If the claim
+// should not be expanded, it returns false. Claims are never shrunk.
+func desiredVolumeSize(expansion *redpandav1alpha2.VolumeExpansion, claim *lifecycle.VolumeClaim, current resource.Quantity, usage *int32, lastExpansion *metav1.Time, now time.Time) (resource.Quantity, string, bool) {
+	target := current.DeepCopy()
+	reason := ""
+
+	if ptr.Deref(expansion.Enabled, true) && claim.Size.Cmp(target) > 0 {
+		target = claim.Size.DeepCopy()
+		reason = fmt.Sprintf("requested size is %s", claim.Size.String())
+	}
+
+	if autoscaling := expansion.Autoscaling; autoscaling != nil && autoscaling.Enabled && usage != nil {
+		threshold := usageThresholdPercent(autoscaling)
+		cooledDown := lastExpansion == nil || now.Sub(lastExpansion.Time) >= volumeExpansionCooldown(autoscaling)
+
+		if *usage >= threshold && cooledDown {
+			increase := int64(defaultVolumeIncreasePercent)
+			if autoscaling.IncreasePercent > 0 {
+				increase = int64(autoscaling.IncreasePercent)
+			}
+
+			grown := current.Value() * (100 + increase) / 100
+			grown = ((grown + volumeSizeIncrement - 1) / volumeSizeIncrement) * volumeSizeIncrement
+
+			size := resource.NewQuantity(grown, resource.BinarySI)
+			if size.Cmp(autoscaling.MaxSize) > 0 {
+				size = ptr.To(autoscaling.MaxSize.DeepCopy())
+			}
+
+			if size.Cmp(target) > 0 {
+				target = *size
+				reason = fmt.Sprintf("disk usage of %d%% exceeds the threshold of %d%%", *usage, threshold)
+			}
+		}
+	}
+
+	return target, reason, target.Cmp(current) > 0
+}
+
+// diskUsagePercent computes the used percentage of a disk from the
+// statistics returned by the Admin API.
+func diskUsagePercent(stat rpadmin.DiskStatInfo) (int32, bool) {
+	total, ok := stat["total_bytes"].(float64)
+	if !ok || total <= 0 {
+		return 0, false
+	}
+
+	free, ok := stat["free_bytes"].(float64)
+	if !ok {
+		return 0, false
+	}
+
+	return int32((total - free) * 100 / total), true
+}
+
+func lastVolumeExpansion(pvc *corev1.PersistentVolumeClaim) *metav1.Time {
+	value, ok := pvc.Annotations[LastVolumeExpansionKey]
+	if !ok {
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+
+	return &metav1.Time{Time: parsed}
+}
+
+func usageThresholdPercent(autoscaling *redpandav1alpha2.VolumeAutoscaling) int32 {
+	if autoscaling.UsageThresholdPercent > 0 {
+		return autoscaling.UsageThresholdPercent
+	}
+	return defaultVolumeUsageThresholdPercent
+}
+
+func volumeExpansionCooldown(autoscaling *redpandav1alpha2.VolumeAutoscaling) time.Duration {
+	if autoscaling.Cooldown != nil {
+		return autoscaling.Cooldown.Duration
+	}
+	return defaultVolumeExpansionCooldown
+}
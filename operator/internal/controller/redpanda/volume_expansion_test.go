@@ -0,0 +1,311 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/controller"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/lifecycle"
+)
+
+func TestDesiredVolumeSize(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	autoscaling := func(enabled *bool) *redpandav1alpha2.VolumeExpansion {
+		return &redpandav1alpha2.VolumeExpansion{
+			Enabled: enabled,
+			Autoscaling: &redpandav1alpha2.VolumeAutoscaling{
+				Enabled:               true,
+				UsageThresholdPercent: 80,
+				IncreasePercent:       50,
+				MaxSize:               resource.MustParse("25Gi"),
+			},
+		}
+	}
+
+	for name, tt := range map[string]struct {
+		expansion     *redpandav1alpha2.VolumeExpansion
+		template      string
+		size          string
+		current       string
+		usage         *int32
+		lastExpansion *metav1.Time
+		expected      string
+		expand        bool
+	}{
+		"unchanged": {
+			expansion: &redpandav1alpha2.VolumeExpansion{},
+			size:      "10Gi",
+			current:   "10Gi",
+			expected:  "10Gi",
+		},
+		"requested-size-grew": {
+			expansion: &redpandav1alpha2.VolumeExpansion{},
+			size:      "20Gi",
+			current:   "10Gi",
+			expected:  "20Gi",
+			expand:    true,
+		},
+		"requested-size-grew-disabled": {
+			expansion: &redpandav1alpha2.VolumeExpansion{Enabled: ptr.To(false)},
+			size:      "20Gi",
+			current:   "10Gi",
+			expected:  "10Gi",
+		},
+		"requested-size-shrunk": {
+			expansion: &redpandav1alpha2.VolumeExpansion{},
+			size:      "5Gi",
+			current:   "10Gi",
+			expected:  "10Gi",
+		},
+		"usage-below-threshold": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "10Gi",
+			current:   "10Gi",
+			usage:     ptr.To(int32(79)),
+			expected:  "10Gi",
+		},
+		"usage-above-threshold": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "10Gi",
+			current:   "10Gi",
+			usage:     ptr.To(int32(80)),
+			expected:  "15Gi",
+			expand:    true,
+		},
+		"usage-above-threshold-rounded-up": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "3Gi",
+			current:   "3Gi",
+			usage:     ptr.To(int32(95)),
+			expected:  "5Gi",
+			expand:    true,
+		},
+		"usage-above-threshold-capped": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "20Gi",
+			current:   "20Gi",
+			usage:     ptr.To(int32(90)),
+			expected:  "25Gi",
+			expand:    true,
+		},
+		"usage-above-threshold-at-max": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "25Gi",
+			current:   "25Gi",
+			usage:     ptr.To(int32(90)),
+			expected:  "25Gi",
+		},
+		"usage-above-threshold-cooling-down": {
+			expansion:     autoscaling(nil),
+			template:      datadirVolume,
+			size:          "10Gi",
+			current:       "10Gi",
+			usage:         ptr.To(int32(90)),
+			lastExpansion: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+			expected:      "10Gi",
+		},
+		"usage-above-threshold-cooled-down": {
+			expansion:     autoscaling(nil),
+			template:      datadirVolume,
+			size:          "10Gi",
+			current:       "10Gi",
+			usage:         ptr.To(int32(90)),
+			lastExpansion: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+			expected:      "15Gi",
+			expand:        true,
+		},
+		"usage-grows-past-requested-size": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "12Gi",
+			current:   "10Gi",
+			usage:     ptr.To(int32(90)),
+			expected:  "15Gi",
+			expand:    true,
+		},
+		"requested-size-past-max": {
+			expansion: autoscaling(nil),
+			template:  datadirVolume,
+			size:      "30Gi",
+			current:   "10Gi",
+			usage:     ptr.To(int32(90)),
+			expected:  "30Gi",
+			expand:    true,
+		},
+		"autoscaling-only": {
+			expansion: autoscaling(ptr.To(false)),
+			template:  datadirVolume,
+			size:      "20Gi",
+			current:   "10Gi",
+			usage:     ptr.To(int32(90)),
+			expected:  "15Gi",
+			expand:    true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			claim := &lifecycle.VolumeClaim{
+				Template: tt.template,
+				Size:     resource.MustParse(tt.size),
+			}
+
+			size, reason, expand := desiredVolumeSize(tt.expansion, claim, resource.MustParse(tt.current), tt.usage, tt.lastExpansion, now)
+			require.Equal(t, tt.expand, expand)
+			expected := resource.MustParse(tt.expected)
+			require.Zero(t, expected.Cmp(size), "expected %s, got %s", tt.expected, size.String())
+			if expand {
+				require.NotEmpty(t, reason)
+			}
+		})
+	}
+}
+
+func TestDiskUsagePercent(t *testing.T) {
+	for name, tt := range map[string]struct {
+		stat     rpadmin.DiskStatInfo
+		expected int32
+		ok       bool
+	}{
+		"empty": {
+			stat: rpadmin.DiskStatInfo{},
+		},
+		"no-total": {
+			stat: rpadmin.DiskStatInfo{"total_bytes": float64(0), "free_bytes": float64(0)},
+		},
+		"half-full": {
+			stat:     rpadmin.DiskStatInfo{"total_bytes": float64(200), "free_bytes": float64(100)},
+			expected: 50,
+			ok:       true,
+		},
+		"full": {
+			stat:     rpadmin.DiskStatInfo{"total_bytes": float64(200), "free_bytes": float64(0)},
+			expected: 100,
+			ok:       true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			percent, ok := diskUsagePercent(tt.stat)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, percent)
+		})
+	}
+}
+
+func TestReconcileVolumes(t *testing.T) {
+	ctx := context.Background()
+
+	pvc := func(name, storageClass, size string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To(storageClass),
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+			},
+		}
+	}
+
+	claim := func(name, pod, size string) *lifecycle.VolumeClaim {
+		return &lifecycle.VolumeClaim{
+			Pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: pod}},
+			Name:     client.ObjectKey{Namespace: "default", Name: name},
+			Template: datadirVolume,
+			Size:     resource.MustParse(size),
+		}
+	}
+
+	r := &RedpandaReconciler{
+		EventRecorder: record.NewFakeRecorder(10),
+		Client: fake.NewClientBuilder().
+			WithScheme(controller.V2Scheme).
+			WithObjects(
+				&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "expandable"}, AllowVolumeExpansion: ptr.To(true)},
+				&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "fixed"}},
+				pvc("datadir-redpanda-0", "expandable", "10Gi"),
+				pvc("datadir-redpanda-1", "expandable", "20Gi"),
+				pvc("datadir-redpanda-2", "expandable", "30Gi"),
+				pvc("datadir-redpanda-3", "fixed", "10Gi"),
+			).
+			Build(),
+	}
+
+	rp := &redpandav1alpha2.Redpanda{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "redpanda"}}
+
+	volumes, err := r.reconcileVolumes(ctx, nil, rp, []*lifecycle.VolumeClaim{
+		claim("datadir-redpanda-0", "redpanda-0", "20Gi"), // expanded
+		claim("datadir-redpanda-1", "redpanda-1", "20Gi"), // unchanged
+		claim("datadir-redpanda-2", "redpanda-2", "20Gi"), // never shrunk
+		claim("datadir-redpanda-3", "redpanda-3", "20Gi"), // not expandable
+		claim("datadir-redpanda-4", "redpanda-4", "20Gi"), // missing
+	})
+	require.NoError(t, err)
+
+	requested := map[string]string{}
+	blocked := map[string]string{}
+	for _, volume := range volumes {
+		requested[volume.Name] = volume.RequestedSize.String()
+		blocked[volume.Name] = volume.ExpansionBlockedReason
+	}
+	require.Equal(t, map[string]string{
+		"datadir-redpanda-0": "20Gi",
+		"datadir-redpanda-1": "20Gi",
+		"datadir-redpanda-2": "30Gi",
+		"datadir-redpanda-3": "10Gi",
+	}, requested)
+	require.Equal(t, map[string]string{
+		"datadir-redpanda-0": "",
+		"datadir-redpanda-1": "",
+		"datadir-redpanda-2": "",
+		"datadir-redpanda-3": volumeNotExpandable,
+	}, blocked)
+
+	for name, expected := range map[string]struct {
+		size      string
+		annotated bool
+	}{
+		"datadir-redpanda-0": {size: "20Gi", annotated: true},
+		"datadir-redpanda-1": {size: "20Gi"},
+		"datadir-redpanda-2": {size: "30Gi"},
+		"datadir-redpanda-3": {size: "10Gi"},
+	} {
+		var actual corev1.PersistentVolumeClaim
+		require.NoError(t, r.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, &actual))
+
+		size := actual.Spec.Resources.Requests[corev1.ResourceStorage]
+		require.Equal(t, expected.size, size.String(), name)
+
+		_, annotated := actual.Annotations[LastVolumeExpansionKey]
+		require.Equal(t, expected.annotated, annotated, name)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
 	"github.com/redpanda-data/redpanda-operator/pkg/kube"
 )
 
@@ -183,6 +184,10 @@ func (r *ResourceClient[T, U]) WatchResources(builder Builder, cluster client.Ob
 	// set an Owns on node pool statefulsets
 	builder.Owns(&appsv1.StatefulSet{})
 
+	// brokers request to be restarted, e.g. once their certificates have
+	// been rotated, by annotating their pods, so don't wait for a resync
+	builder.Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.ownerForRestartRequestedPod))
+
 	for _, resourceType := range r.simpleResourceRenderer.WatchedResourceTypes() {
 		mapping, err := getResourceScope(r.mapper, r.scheme, resourceType)
 		if err != nil {
@@ -218,6 +223,35 @@ func (r *ResourceClient[T, U]) WatchResources(builder Builder, cluster client.Ob
 	return nil
 }
 
+// ownerForRestartRequestedPod maps a pod that requested to be restarted back
+// to its cluster. Pods aren't labeled with their owner, so the cluster is
+// resolved through the StatefulSet of the node pool that controls the pod.
+func (r *ResourceClient[T, U]) ownerForRestartRequestedPod(ctx context.Context, o client.Object) []reconcile.Request {
+	if _, ok := o.GetAnnotations()[labels.PodRestartRequestedKey]; !ok {
+		return nil
+	}
+
+	ref := metav1.GetControllerOf(o)
+	if ref == nil || ref.Kind != "StatefulSet" {
+		return nil
+	}
+
+	var set appsv1.StatefulSet
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: o.GetNamespace(), Name: ref.Name}, &set); err != nil {
+		if !k8sapierrors.IsNotFound(err) {
+			r.logger.Error(err, "fetching statefulset of pod", "pod", client.ObjectKeyFromObject(o))
+		}
+		return nil
+	}
+
+	if owner := r.ownershipResolver.OwnerForObject(&set); owner != nil {
+		return []reconcile.Request{{
+			NamespacedName: *owner,
+		}}
+	}
+	return nil
+}
+
 // DeleteAll deletes all resources owned by the given cluster, including node pools.
 func (r *ResourceClient[T, U]) DeleteAll(ctx context.Context, owner U) (bool, error) {
 	// since this is a widespread deletion, we can delete even stateful sets
@@ -16,6 +16,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 	appsv1 "k8s.io/api/apps/v1"
@@ -28,10 +29,14 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
 )
 
 var parentCtx = context.Background()
@@ -357,11 +362,12 @@ func TestClientWatchResources(t *testing.T) {
 		testParams       clientTest
 	}{
 		"base": {
-			ownedResources: []string{"*v1.StatefulSet"},
+			ownedResources:   []string{"*v1.StatefulSet"},
+			watchedResources: []string{"*v1.Pod"},
 		},
 		"cluster-scoped-resources": {
 			ownedResources:   []string{"*v1.StatefulSet"},
-			watchedResources: []string{"*v1.PersistentVolume"},
+			watchedResources: []string{"*v1.Pod", "*v1.PersistentVolume"},
 			testParams: clientTest{
 				watchedResources: []client.Object{
 					&corev1.PersistentVolume{},
@@ -370,7 +376,7 @@ func TestClientWatchResources(t *testing.T) {
 		},
 		"namespace-and-cluster-scoped-resources": {
 			ownedResources:   []string{"*v1.StatefulSet", "*v1.PersistentVolumeClaim", "*v1.Pod", "*v1.Secret", "*v1.ConfigMap"},
-			watchedResources: []string{"*v1.PersistentVolume"},
+			watchedResources: []string{"*v1.Pod", "*v1.PersistentVolume"},
 			testParams: clientTest{
 				watchedResources: []client.Object{
 					&corev1.PersistentVolume{},
@@ -393,6 +399,56 @@ func TestClientWatchResources(t *testing.T) {
 	}
 }
 
+func TestClientOwnerForRestartRequestedPod(t *testing.T) {
+	cluster := &MockCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "ns"}}
+	ownerLabels := map[string]string{"owner": "cluster"}
+
+	set := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "cluster-blue", Namespace: "ns", UID: "set", Labels: ownerLabels}}
+	unowned := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns", UID: "other"}}
+
+	ownershipResolver := NewMockOwnershipResolver()
+	ownershipResolver.AddOwner(cluster, ownerLabels)
+
+	resourceClient := &ResourceClient[MockCluster, *MockCluster]{
+		client:            fake.NewClientBuilder().WithObjects(set, unowned).Build(),
+		logger:            logr.Discard(),
+		ownershipResolver: ownershipResolver,
+	}
+
+	podOf := func(set *appsv1.StatefulSet, annotations map[string]string) *corev1.Pod {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: set.Name + "-0", Namespace: "ns", Annotations: annotations}}
+		require.NoError(t, controllerutil.SetControllerReference(set, pod, scheme.Scheme))
+		return pod
+	}
+	requested := map[string]string{labels.PodRestartRequestedKey: "2025-10-19T14:00:00Z"}
+
+	for name, tt := range map[string]struct {
+		pod      *corev1.Pod
+		expected []reconcile.Request
+	}{
+		"restart-requested": {
+			pod:      podOf(set, requested),
+			expected: []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(cluster)}},
+		},
+		"restart-not-requested": {
+			pod: podOf(set, nil),
+		},
+		"unowned-statefulset": {
+			pod: podOf(unowned, requested),
+		},
+		"missing-statefulset": {
+			pod: podOf(&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "ns", UID: "missing"}}, requested),
+		},
+		"uncontrolled": {
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: requested}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expected, resourceClient.ownerForRestartRequestedPod(parentCtx, tt.pod))
+		})
+	}
+}
+
 func TestClientSyncAll(t *testing.T) {
 	for name, tt := range map[string]struct {
 		renderLoops [][]client.Object
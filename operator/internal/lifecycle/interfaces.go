@@ -13,6 +13,8 @@ import (
 	"context"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +33,20 @@ type ClusterStatus struct {
 	// ConfigVersion is the configuration version from the cluster if one
 	// has been determined this reconciliation loop
 	ConfigVersion *string
+	// Volumes contains the status of the broker volumes if they have
+	// been examined this reconciliation loop
+	Volumes []VolumeStatus
+	// Recovery contains the status of the cluster's recovery from Tiered
+	// Storage if it has been examined this reconciliation loop
+	Recovery *RecoveryStatus
+	// Rebalance contains the status of the last partition rebalance if
+	// the partition balancer has been examined this reconciliation loop
+	Rebalance *RebalanceStatus
+	// PendingConfigChanges contains the cluster configuration changes that
+	// have not been applied if the cluster configuration has been examined
+	// this reconciliation loop. A nil value leaves the existing changes in
+	// place while an empty one clears them.
+	PendingConfigChanges []ConfigChange
 }
 
 type PoolStatus struct {
@@ -63,6 +79,78 @@ type PoolStatus struct {
 	RunningReplicas int32
 }
 
+type VolumeStatus struct {
+	// Name is the name of the PersistentVolumeClaim
+	Name string
+	// Pod is the name of the broker Pod the claim is mounted in.
+	Pod string
+	// RequestedSize is the storage size currently requested by the claim.
+	RequestedSize resource.Quantity
+	// Capacity is the storage capacity of the volume bound to the claim.
+	Capacity *resource.Quantity
+	// UsagePercent is the percentage of disk space used by the broker
+	// as last reported through the Admin API.
+	UsagePercent *int32
+	// LastExpansionTime is the time at which the operator last resized the claim.
+	LastExpansionTime *metav1.Time
+	// ExpansionBlockedReason is set when the claim should be expanded but can't be.
+	ExpansionBlockedReason string
+}
+
+// RecoveryStatus contains the status of a cluster's recovery from Tiered Storage.
+type RecoveryStatus struct {
+	// State is the state of the recovery as reported through the Admin API.
+	State string
+	// StartTime is the time at which the operator started the recovery.
+	StartTime *metav1.Time
+	// CompletionTime is the time at which the recovery was observed to finish.
+	CompletionTime *metav1.Time
+	// PendingDownloads is the number of topic manifests yet to be downloaded.
+	PendingDownloads int32
+	// SuccessfulDownloads is the number of topic manifests successfully downloaded.
+	SuccessfulDownloads int32
+	// FailedDownloads is the number of topic manifests that failed to download.
+	FailedDownloads int32
+}
+
+// RebalanceStatus contains the status of the last partition rebalance.
+type RebalanceStatus struct {
+	// Trigger is what triggered the last rebalance.
+	Trigger string
+	// Request is the on-demand rebalance request that was last acted upon.
+	Request string
+	// Brokers is the number of brokers in the cluster.
+	Brokers int32
+	// StartTime is the time at which the last rebalance was triggered.
+	StartTime *metav1.Time
+	// CompletionTime is the time at which the last rebalance was observed to finish.
+	CompletionTime *metav1.Time
+	// BalancerStatus is the status of the partition balancer.
+	BalancerStatus string
+	// MovingPartitions is the number of partitions being moved between brokers.
+	MovingPartitions int32
+	// PeakMovingPartitions is the highest number of partitions seen being moved during the last rebalance.
+	PeakMovingPartitions int32
+	// UnavailableBrokers are the brokers the balancer considers unavailable.
+	UnavailableBrokers []int32
+	// OverDiskLimitBrokers are the brokers whose disk usage is above the balancer's limit.
+	OverDiskLimitBrokers []int32
+}
+
+// ConfigChange contains a cluster configuration change that has not been applied.
+type ConfigChange struct {
+	// Property is the name of the cluster configuration property.
+	Property string
+	// Operation is the way in which the property changes.
+	Operation string
+	// CurrentValue is the JSON encoded current value of the property.
+	CurrentValue string
+	// DesiredValue is the JSON encoded desired value of the property.
+	DesiredValue string
+	// NeedsRestart is true if the change requires the brokers to restart.
+	NeedsRestart bool
+}
+
 // NewClusterStatus creates a cluster status object to be used in reconciliation
 func NewClusterStatus() *ClusterStatus {
 	return &ClusterStatus{
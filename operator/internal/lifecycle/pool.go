@@ -15,9 +15,12 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
 )
 
 // podWithOrdinals is a container for sorting pods
@@ -171,6 +174,7 @@ func (p *PoolTracker) ToScaleUp() []*appsv1.StatefulSet {
 					set.Labels = map[string]string{}
 				}
 				set.Labels[generationLabel] = generation
+				preserveVolumeClaimTemplates(set, existing.set)
 				sets = append(sets, set)
 			}
 		}
@@ -212,6 +216,7 @@ func (p *PoolTracker) RequiresUpdate() []*appsv1.StatefulSet {
 					set.Labels = map[string]string{}
 				}
 				set.Labels[generationLabel] = generation
+				preserveVolumeClaimTemplates(set, existing.set)
 				sets = append(sets, set)
 			}
 		}
@@ -258,6 +263,7 @@ func (p *PoolTracker) ToScaleDown() []*ScaleDownSet {
 					set.Labels = map[string]string{}
 				}
 				set.Labels[generationLabel] = generation
+				preserveVolumeClaimTemplates(set, existing.set)
 				lastPod := existing.pods[len(existing.pods)-1]
 
 				set.Spec.Replicas = ptr.To(existingReplicas - 1)
@@ -296,12 +302,20 @@ func (p *PoolTracker) ToDelete() []*appsv1.StatefulSet {
 
 // PodsToRoll returns a list of pods that need to be rolled
 // because their association ControllerRevision does not match
-// the latest applied to the StatefulSet.
+// the latest applied to the StatefulSet or because a restart
+// was requested through the [labels.PodRestartRequestedKey]
+// annotation.
 func (p *PoolTracker) PodsToRoll() []*corev1.Pod {
 	pods := []*corev1.Pod{}
 
 	for _, existing := range p.existingPools {
 		for _, withOrdinals := range existing.pods {
+			if _, ok := withOrdinals.pod.Annotations[labels.PodRestartRequestedKey]; ok {
+				// the replacement pod won't carry the annotation
+				pods = append(pods, withOrdinals.pod.DeepCopy())
+				continue
+			}
+
 			// the CurrentRevision on the StatefulSet can't be used here due to leveraging onDelete
 			if len(existing.revisions) == 0 {
 				// we have no revisions, just assume this needs to be rolled
@@ -318,6 +332,82 @@ func (p *PoolTracker) PodsToRoll() []*corev1.Pod {
 	return pods
 }
 
+// VolumeClaim pairs a PersistentVolumeClaim mounted in an existing
+// broker pod with the size requested for it by the desired StatefulSet.
+type VolumeClaim struct {
+	// Pod is the pod that mounts the claim.
+	Pod *corev1.Pod
+	// Name is the namespaced name of the PersistentVolumeClaim.
+	Name types.NamespacedName
+	// Template is the name of the volumeClaimTemplate the claim was
+	// created from.
+	Template string
+	// Size is the storage size requested by the desired volumeClaimTemplate.
+	Size resource.Quantity
+}
+
+// VolumeClaims returns the PersistentVolumeClaims of the pods in every
+// existing pool that is still desired, along with the storage size that
+// the desired pool requests for them. Because volumeClaimTemplates are
+// immutable, a size change is never propagated through the StatefulSet
+// and the claims must instead be resized individually.
+func (p *PoolTracker) VolumeClaims() []*VolumeClaim {
+	claims := []*VolumeClaim{}
+
+	for nn, existing := range p.existingPools {
+		desired, ok := p.desiredPools[nn]
+		if !ok {
+			continue
+		}
+
+		for _, withOrdinals := range existing.pods {
+			pod := withOrdinals.pod
+
+			claimNames := map[string]string{}
+			for _, volume := range pod.Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil {
+					claimNames[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+				}
+			}
+
+			for _, template := range desired.set.Spec.VolumeClaimTemplates {
+				claimName, ok := claimNames[template.Name]
+				if !ok {
+					continue
+				}
+
+				size, ok := template.Spec.Resources.Requests[corev1.ResourceStorage]
+				if !ok {
+					continue
+				}
+
+				claims = append(claims, &VolumeClaim{
+					Pod:      pod.DeepCopy(),
+					Name:     types.NamespacedName{Namespace: pod.Namespace, Name: claimName},
+					Template: template.Name,
+					Size:     size.DeepCopy(),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(claims, func(i, j int) bool {
+		return claims[i].Name.String() < claims[j].Name.String()
+	})
+
+	return claims
+}
+
+// preserveVolumeClaimTemplates overwrites the volumeClaimTemplates of a
+// StatefulSet about to be patched with the ones of the existing StatefulSet,
+// since the API server rejects any change to them.
+func preserveVolumeClaimTemplates(set, existing *appsv1.StatefulSet) {
+	set.Spec.VolumeClaimTemplates = nil
+	for i := range existing.Spec.VolumeClaimTemplates {
+		set.Spec.VolumeClaimTemplates = append(set.Spec.VolumeClaimTemplates, *existing.Spec.VolumeClaimTemplates[i].DeepCopy())
+	}
+}
+
 // addExisting poolWithOrdinals to the tracker
 func (p *PoolTracker) addExisting(pools ...*poolWithOrdinals) {
 	for i := range pools {
@@ -16,9 +16,12 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
 )
 
 func objectNames[T client.Object](list []T) []string {
@@ -741,6 +744,35 @@ func TestPoolTrackerPodsToRoll(t *testing.T) {
 				revisions: pool1Revisions,
 			}},
 		},
+		"restart-requested": {
+			expectedPodsToRoll: []string{"pod-2"},
+			existingPools: []*poolWithOrdinals{{
+				pods: []*podsWithOrdinals{{
+					pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-1",
+							Labels: map[string]string{
+								appsv1.StatefulSetRevisionLabel: "b",
+							},
+						},
+					},
+				}, {
+					pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-2",
+							Labels: map[string]string{
+								appsv1.StatefulSetRevisionLabel: "b",
+							},
+							Annotations: map[string]string{
+								labels.PodRestartRequestedKey: "2025-10-19T14:00:00Z",
+							},
+						},
+					},
+				}},
+				set:       pool1,
+				revisions: pool1Revisions,
+			}},
+		},
 		"no-revisions": {
 			expectedPodsToRoll: []string{"pod-1", "pod-2"},
 			existingPools: []*poolWithOrdinals{{
@@ -826,3 +858,123 @@ func TestPoolTrackerPodsToRoll(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolTrackerVolumeClaims(t *testing.T) {
+	volumeClaimTemplate := func(name, size string) corev1.PersistentVolumeClaim {
+		return corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+		}
+	}
+
+	podWithClaims := func(name string, claims ...string) *podsWithOrdinals {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		for _, claim := range claims {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+				Name: claim,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim + "-" + name},
+				},
+			})
+		}
+		return &podsWithOrdinals{pod: pod}
+	}
+
+	for name, tt := range map[string]struct {
+		existingPools  []*poolWithOrdinals
+		desiredPools   []*appsv1.StatefulSet
+		expectedClaims map[string]string
+	}{
+		"no-op": {
+			expectedClaims: map[string]string{},
+		},
+		"pool-removed": {
+			existingPools: []*poolWithOrdinals{{
+				pods: []*podsWithOrdinals{podWithClaims("pool-1-0", "datadir")},
+				set:  &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "pool-1"}},
+			}},
+			expectedClaims: map[string]string{},
+		},
+		"desired-sizes": {
+			existingPools: []*poolWithOrdinals{{
+				pods: []*podsWithOrdinals{
+					podWithClaims("pool-1-0", "datadir", "tiered"),
+					podWithClaims("pool-1-1", "datadir", "tiered"),
+				},
+				set: &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "pool-1"}},
+			}, {
+				pods: []*podsWithOrdinals{podWithClaims("pool-2-0")},
+				set:  &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "pool-2"}},
+			}},
+			desiredPools: []*appsv1.StatefulSet{{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool-1"},
+				Spec: appsv1.StatefulSetSpec{
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						volumeClaimTemplate("datadir", "20Gi"),
+						volumeClaimTemplate("tiered", "5Gi"),
+					},
+				},
+			}, {
+				ObjectMeta: metav1.ObjectMeta{Name: "pool-2"},
+				Spec: appsv1.StatefulSetSpec{
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						volumeClaimTemplate("datadir", "20Gi"),
+					},
+				},
+			}},
+			expectedClaims: map[string]string{
+				"default/datadir-pool-1-0": "20Gi",
+				"default/datadir-pool-1-1": "20Gi",
+				"default/tiered-pool-1-0":  "5Gi",
+				"default/tiered-pool-1-1":  "5Gi",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := NewPoolTracker(0)
+			tracker.addExisting(tt.existingPools...)
+			tracker.addDesired(tt.desiredPools...)
+
+			actual := map[string]string{}
+			for _, claim := range tracker.VolumeClaims() {
+				actual[claim.Name.String()] = claim.Size.String()
+			}
+			require.Equal(t, tt.expectedClaims, actual)
+		})
+	}
+}
+
+func TestPoolTrackerPreservesVolumeClaimTemplates(t *testing.T) {
+	template := func(size string) []corev1.PersistentVolumeClaim {
+		return []corev1.PersistentVolumeClaim{{
+			ObjectMeta: metav1.ObjectMeta{Name: "datadir"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+		}}
+	}
+
+	tracker := NewPoolTracker(1)
+	tracker.addExisting(&poolWithOrdinals{
+		set: &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-1", Labels: map[string]string{generationLabel: "0"}},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(int32(1)), VolumeClaimTemplates: template("10Gi")},
+		},
+	})
+	tracker.addDesired(&appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool-1"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(int32(1)), VolumeClaimTemplates: template("20Gi")},
+	})
+
+	sets := tracker.RequiresUpdate()
+	require.Len(t, sets, 1)
+	require.Equal(t, template("10Gi"), sets[0].Spec.VolumeClaimTemplates)
+}
@@ -9,7 +9,11 @@
 
 package lifecycle
 
-import "fmt"
+import (
+	"fmt"
+
+	pkgsecrets "github.com/redpanda-data/redpanda-operator/operator/pkg/secrets"
+)
 
 // CloudSecretsFlags contains the flags required to generate a default set of
 // CLI arguments to the configurator / bootstrap templater to correctly instantiate
@@ -22,6 +26,8 @@ type CloudSecretsFlags struct {
 	CloudSecretsAWSRoleARN       string
 	CloudSecretsGCPProjectID     string
 	CloudSecretsAzureKeyVaultURI string
+	CloudSecretsVault            pkgsecrets.VaultConfiguration
+	CloudSecretsK8sNamespace     string
 }
 
 // AdditionalConfiguratorArgs constructs a "standard" set of arguments to pass to a
@@ -43,6 +49,10 @@ func (c CloudSecretsFlags) AdditionalConfiguratorArgs() []string {
 		if c.CloudSecretsAzureKeyVaultURI != "" {
 			result = append(result, fmt.Sprintf("--cloud-secrets-azure-key-vault-uri=%s", c.CloudSecretsAzureKeyVaultURI))
 		}
+		result = append(result, c.CloudSecretsVault.ConfiguratorArgs()...)
+		if c.CloudSecretsK8sNamespace != "" {
+			result = append(result, fmt.Sprintf("--cloud-secrets-kubernetes-namespace=%s", c.CloudSecretsK8sNamespace))
+		}
 	}
 	return result
 }
@@ -10,6 +10,8 @@
 package lifecycle
 
 import (
+	"k8s.io/apimachinery/pkg/api/equality"
+
 	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
 )
 
@@ -38,9 +40,107 @@ func (m *V2ClusterStatusUpdater) Update(cluster *ClusterWithPools, status *Clust
 		dirty = true
 	}
 
+	if status.Volumes != nil && setAndDirtyCheckVolumes(&cluster.Status.Volumes, status.Volumes) {
+		dirty = true
+	}
+
+	if status.Recovery != nil && setAndDirtyCheckRecovery(&cluster.Status.Recovery, status.Recovery) {
+		dirty = true
+	}
+
+	if status.Rebalance != nil && setAndDirtyCheckRebalance(&cluster.Status.Rebalance, status.Rebalance) {
+		dirty = true
+	}
+
+	if status.PendingConfigChanges != nil && setAndDirtyCheckPendingConfigChanges(&cluster.Status.PendingConfigChanges, status.PendingConfigChanges) {
+		dirty = true
+	}
+
 	return dirty
 }
 
+func setAndDirtyCheckRecovery(recovery **redpandav1alpha2.ClusterRecoveryStatus, updated *RecoveryStatus) bool {
+	converted := &redpandav1alpha2.ClusterRecoveryStatus{
+		State:               updated.State,
+		StartTime:           updated.StartTime,
+		CompletionTime:      updated.CompletionTime,
+		PendingDownloads:    updated.PendingDownloads,
+		SuccessfulDownloads: updated.SuccessfulDownloads,
+		FailedDownloads:     updated.FailedDownloads,
+	}
+
+	if equality.Semantic.DeepEqual(*recovery, converted) {
+		return false
+	}
+
+	*recovery = converted
+	return true
+}
+
+func setAndDirtyCheckVolumes(volumes *[]redpandav1alpha2.VolumeStatus, updated []VolumeStatus) bool {
+	converted := []redpandav1alpha2.VolumeStatus{}
+	for _, volume := range updated {
+		converted = append(converted, redpandav1alpha2.VolumeStatus{
+			Name:                   volume.Name,
+			Pod:                    volume.Pod,
+			RequestedSize:          volume.RequestedSize,
+			Capacity:               volume.Capacity,
+			UsagePercent:           volume.UsagePercent,
+			LastExpansionTime:      volume.LastExpansionTime,
+			ExpansionBlockedReason: volume.ExpansionBlockedReason,
+		})
+	}
+
+	if equality.Semantic.DeepEqual(*volumes, converted) {
+		return false
+	}
+
+	*volumes = converted
+	return true
+}
+
+func setAndDirtyCheckRebalance(rebalance **redpandav1alpha2.PartitionRebalanceStatus, updated *RebalanceStatus) bool {
+	converted := &redpandav1alpha2.PartitionRebalanceStatus{
+		Trigger:              updated.Trigger,
+		Request:              updated.Request,
+		Brokers:              updated.Brokers,
+		StartTime:            updated.StartTime,
+		CompletionTime:       updated.CompletionTime,
+		BalancerStatus:       updated.BalancerStatus,
+		MovingPartitions:     updated.MovingPartitions,
+		PeakMovingPartitions: updated.PeakMovingPartitions,
+		UnavailableBrokers:   updated.UnavailableBrokers,
+		OverDiskLimitBrokers: updated.OverDiskLimitBrokers,
+	}
+
+	if equality.Semantic.DeepEqual(*rebalance, converted) {
+		return false
+	}
+
+	*rebalance = converted
+	return true
+}
+
+func setAndDirtyCheckPendingConfigChanges(changes *[]redpandav1alpha2.ClusterConfigChange, updated []ConfigChange) bool {
+	var converted []redpandav1alpha2.ClusterConfigChange
+	for _, change := range updated {
+		converted = append(converted, redpandav1alpha2.ClusterConfigChange{
+			Property:     change.Property,
+			Operation:    change.Operation,
+			CurrentValue: change.CurrentValue,
+			DesiredValue: change.DesiredValue,
+			NeedsRestart: change.NeedsRestart,
+		})
+	}
+
+	if equality.Semantic.DeepEqual(*changes, converted) {
+		return false
+	}
+
+	*changes = converted
+	return true
+}
+
 func setAndDirtyCheckPools(pools *[]redpandav1alpha2.NodePoolStatus, updated PoolStatus) bool {
 	dirty := false
 	for i, existing := range *pools {
@@ -64,90 +64,22 @@ func NewProber(factory internalclient.ClientFactory, configPath string, options
 // the cluster health overview endpoint. This had the unfortunate effect of marking all brokers
 // as "unready" when a single broker was marked as a downed node due to being a reflection of
 // the overall cluster health rather than an individual broker's health.
+//
+// If the cluster isn't healthy, it falls back to checking that:
+//
+// 1. The broker is marked as active and is not in maintenance mode.
+// 2. The local summary of partitions for the broker has no leaderless or underreplicated partitions.
+// 3. The broker is part of the quorum by the cluster having a controller id set.
+//
+// These allow us to ensure we aren't fully coupled to the overall cluster health status,
+// which previously caused brokers to be marked as "not ready" when *any* broker in the cluster
+// was unhealthy. See [Prober.Report] for the individual checks.
 func (p *Prober) IsClusterBrokerHealthy(ctx context.Context, brokerURL string) (bool, error) {
-	client, brokerID, err := p.getClient(ctx, brokerURL)
-	if err != nil {
-		return false, fmt.Errorf("initializing client to check broker health: %w", err)
-	}
-	defer client.Close()
-
-	// This is essentially the old health check of our broker nodes, it is here
-	// to act as a fall back for certain 24.x and 23.x clusters that have a broken
-	// /v1/partitions/local_summary endpoint that doesn't properly report on under
-	// replicated partitions (in testing, it generally shows under-replication when
-	// there is none). By placing this here we essentially say, "if the strict cluster
-	// overview health check passes, then don't run the relaxed health check". This has
-	// the benefit of basically acting like our old health check in clusters that are
-	// otherwise broken.
-	//
-	// Reference: https://github.com/redpanda-data/redpanda/pull/24837
-	healthOverview, err := client.GetHealthOverview(ctx)
-	if err != nil {
-		return false, fmt.Errorf("fetching cluser health: %w", err)
-	}
-
-	if healthOverview.IsHealthy {
+	report := p.runChecks(ctx, brokerURL, func(string) bool { return true })
+	if report.Healthy {
 		return true, nil
 	}
-
-	// This check is a more relaxed version of our previous "readiness" probe that was
-	// based solely off of the cluster health. It works via doing the following:
-	//
-	// 1. Gets the broker status, making sure it's marked as active and is not in maintenance mode.
-	// 2. Lists the local summary of partitions for the broker, making sure there are no leaderless or
-	//    underreplicated partitions.
-	// 3. Gets the cluster health and makes sure that the broker is part of the quorum by having a
-	//    controller id set.
-	//
-	// These allow us to ensure we aren't fully coupled to the overall cluster health status,
-	// which previously caused brokers to be marked as "not ready" when *any* broker in the cluster
-	// was unhealthy.
-
-	broker, err := client.Broker(ctx, brokerID)
-	if err != nil {
-		return false, fmt.Errorf("fetching broker status: %w", err)
-	}
-
-	// is the broker marked as active and not being decommissioned
-	if broker.MembershipStatus != rpadmin.MembershipStatusActive {
-		p.logger.Info("broker membership not active")
-		return false, nil
-	}
-
-	status, err := client.MaintenanceStatus(ctx)
-	if err != nil {
-		return false, fmt.Errorf("fetching broker maintenance status: %w", err)
-	}
-
-	// is the broker in maintenance mode and currently draining
-	if status.Draining {
-		p.logger.Info("broker is draining")
-		return false, nil
-	}
-
-	summary, err := client.GetLocalPartitionsSummary(ctx)
-	if err != nil {
-		return false, fmt.Errorf("fetching broker partitions: %w", err)
-	}
-
-	// do we have any leaderless or under-replicated nodes?
-	if summary.Leaderless != 0 || summary.UnderReplicated != 0 {
-		p.logger.Info("broker has leaderless or under-replicated partitions", "leaderless", summary.Leaderless, "under-replicated", summary.UnderReplicated)
-		return false, nil
-	}
-
-	clusterHealth, err := client.GetHealthOverview(ctx)
-	if err != nil {
-		return false, fmt.Errorf("fetching cluster health: %w", err)
-	}
-
-	// do we have an elected cluster leader (i.e. are we part of quorum)
-	if clusterHealth.ControllerID < 0 {
-		p.logger.Info("broker has no elected cluster leader")
-		return false, nil
-	}
-
-	return true, nil
+	return false, report.Err()
 }
 
 // IsClusterBrokerReady checks if a cluster broker should be servicing requests. It does
@@ -159,36 +91,15 @@ func (p *Prober) IsClusterBrokerHealthy(ctx context.Context, brokerURL string) (
 // 2. The Admin API is up and servicing requests (through receiving a valid request).
 // 3. We aren't currently draining in maintenance mode.
 func (p *Prober) IsClusterBrokerReady(ctx context.Context, brokerURL string) (bool, error) {
-	client, brokerID, err := p.getClient(ctx, brokerURL)
-	if err != nil {
-		return false, fmt.Errorf("initializing client to check broker readiness: %w", err)
-	}
-	defer client.Close()
-
-	// unlike the above check which includes cluster quorum and partition status, this just
-	// checks that a broker is up and not in maintenance mode
-
-	broker, err := client.Broker(ctx, brokerID)
-	if err != nil {
-		return false, fmt.Errorf("fetching broker status: %w", err)
-	}
-
-	// is the broker marked as active and not being decommissioned
-	if broker.MembershipStatus != rpadmin.MembershipStatusActive {
-		return false, nil
-	}
-
-	status, err := client.MaintenanceStatus(ctx)
-	if err != nil {
-		return false, fmt.Errorf("fetching broker maintenance status: %w", err)
-	}
-
-	// is the broker in maintenance mode and currently draining
-	if status.Draining {
-		return false, nil
+	// unlike the health check which includes cluster quorum and partition
+	// status, this just checks that a broker is up and not in maintenance mode
+	report := p.runChecks(ctx, brokerURL, func(name string) bool {
+		return name == CheckMembership || name == CheckMaintenance
+	})
+	if report.Ready {
+		return true, nil
 	}
-
-	return true, nil
+	return false, report.Err()
 }
 
 func (p *Prober) getClient(ctx context.Context, brokerURL string) (*rpadmin.AdminAPI, int, error) {
@@ -0,0 +1,277 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package probes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The checks performed by [Prober.Report]. [Prober.IsClusterBrokerHealthy]
+// performs all of them as well and [Prober.IsClusterBrokerReady] those that
+// readiness is derived from.
+const (
+	CheckAdminAPI                = "admin_api"
+	CheckClusterHealth           = "cluster_health"
+	CheckMembership              = "membership"
+	CheckMaintenance             = "maintenance"
+	CheckLocalPartitions         = "local_partitions"
+	CheckUnderReplicated         = "under_replicated_partitions"
+	CheckLeaderless              = "leaderless_partitions"
+	CheckClusterControllerQuorum = "controller_quorum"
+)
+
+var (
+	checkPassing = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "redpanda_broker_probe_check_passing",
+			Help: "Whether a check of the broker probe passed (1) or not (0) when it last ran",
+		}, []string{"check"},
+	)
+	checkTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "redpanda_broker_probe_check_timestamp_seconds",
+			Help: "Unix timestamp at which a check of the broker probe last ran",
+		}, []string{"check"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(checkPassing, checkTimestamp)
+}
+
+// recordCheck exports the outcome of a check performed at the given time.
+func recordCheck(name string, passed bool, at time.Time) {
+	passing := 0.0
+	if passed {
+		passing = 1
+	}
+	checkPassing.WithLabelValues(name).Set(passing)
+	checkTimestamp.WithLabelValues(name).Set(float64(at.Unix()))
+}
+
+// CheckResult is the outcome of a single check of a broker.
+type CheckResult struct {
+	// Name identifies the check, see the Check* constants.
+	Name string `json:"name"`
+	// Passed reports whether the broker passed the check.
+	Passed bool `json:"passed"`
+	// Detail describes what was observed, if the check ran.
+	Detail string `json:"detail,omitempty"`
+	// Error is set if the check couldn't be performed.
+	Error string `json:"error,omitempty"`
+	// Time is when the check was performed.
+	Time time.Time `json:"time"`
+}
+
+// Report holds the individual checks that the health and readiness of a
+// broker are derived from.
+type Report struct {
+	// BrokerID is the ID of the probed broker, if the Admin API could be
+	// reached.
+	BrokerID *int `json:"brokerID,omitempty"`
+	// Healthy is what [Prober.IsClusterBrokerHealthy] reports.
+	Healthy bool `json:"healthy"`
+	// Ready is what [Prober.IsClusterBrokerReady] reports.
+	Ready  bool          `json:"ready"`
+	Time   time.Time     `json:"time"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Passed reports whether the check of the given name passed.
+func (r *Report) Passed(name string) bool {
+	for _, check := range r.Checks {
+		if check.Name == name {
+			return check.Passed
+		}
+	}
+	return false
+}
+
+// Err returns the error of the first check that couldn't be performed, if
+// any.
+func (r *Report) Err() error {
+	for _, check := range r.Checks {
+		if check.Error != "" {
+			return fmt.Errorf("%s: %s", check.Name, check.Error)
+		}
+	}
+	return nil
+}
+
+// evaluate derives the health and readiness of the broker from its checks.
+func (r *Report) evaluate() {
+	r.Ready = r.Passed(CheckMembership) && r.Passed(CheckMaintenance)
+	// a healthy cluster short-circuits the relaxed checks of the broker
+	// itself, which also covers 23.x and 24.x clusters whose local partition
+	// summary misreports under-replicated partitions, see
+	// https://github.com/redpanda-data/redpanda/pull/24837
+	r.Healthy = r.Passed(CheckClusterHealth) || (r.Ready &&
+		r.Passed(CheckUnderReplicated) &&
+		r.Passed(CheckLeaderless) &&
+		r.Passed(CheckClusterControllerQuorum))
+}
+
+// brokerState holds the responses of the Admin API of a broker that checks
+// are performed against, each fetched at most once per run.
+type brokerState struct {
+	client   *rpadmin.AdminAPI
+	brokerID int
+
+	health     *rpadmin.ClusterHealthOverview
+	healthErr  error
+	summary    *rpadmin.LocalPartitionSummary
+	summaryErr error
+}
+
+func (b *brokerState) healthOverview(ctx context.Context) (rpadmin.ClusterHealthOverview, error) {
+	if b.health == nil && b.healthErr == nil {
+		health, err := b.client.GetHealthOverview(ctx)
+		b.health, b.healthErr = &health, err
+		if err != nil {
+			b.healthErr = fmt.Errorf("fetching cluster health: %w", err)
+		}
+	}
+	return *b.health, b.healthErr
+}
+
+func (b *brokerState) partitionsSummary(ctx context.Context) (rpadmin.LocalPartitionSummary, error) {
+	if b.summary == nil && b.summaryErr == nil {
+		summary, err := b.client.GetLocalPartitionsSummary(ctx)
+		b.summary, b.summaryErr = &summary, err
+		if err != nil {
+			b.summaryErr = fmt.Errorf("fetching broker partitions: %w", err)
+		}
+	}
+	return *b.summary, b.summaryErr
+}
+
+// brokerCheck is a check performed once the Admin API of a broker has been
+// reached.
+type brokerCheck struct {
+	name string
+	run  func(ctx context.Context, b *brokerState) (passed bool, detail string, err error)
+}
+
+// brokerChecks are the checks that follow [CheckAdminAPI], in the order that
+// they're performed in.
+var brokerChecks = []brokerCheck{
+	{CheckClusterHealth, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		health, err := b.healthOverview(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		if !health.IsHealthy {
+			return false, "cluster is unhealthy: " + strings.Join(health.UnhealthyReasons, ", "), nil
+		}
+		return true, "cluster is healthy", nil
+	}},
+	{CheckMembership, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		broker, err := b.client.Broker(ctx, b.brokerID)
+		if err != nil {
+			return false, "", fmt.Errorf("fetching broker status: %w", err)
+		}
+		return broker.MembershipStatus == rpadmin.MembershipStatusActive, fmt.Sprintf("membership status is %q", broker.MembershipStatus), nil
+	}},
+	{CheckMaintenance, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		status, err := b.client.MaintenanceStatus(ctx)
+		if err != nil {
+			return false, "", fmt.Errorf("fetching broker maintenance status: %w", err)
+		}
+		return !status.Draining, fmt.Sprintf("draining: %t", status.Draining), nil
+	}},
+	{CheckLocalPartitions, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		summary, err := b.partitionsSummary(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		return true, fmt.Sprintf("%d partitions", summary.Count), nil
+	}},
+	{CheckUnderReplicated, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		summary, err := b.partitionsSummary(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		return summary.UnderReplicated == 0, fmt.Sprintf("%d under-replicated partitions", summary.UnderReplicated), nil
+	}},
+	{CheckLeaderless, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		summary, err := b.partitionsSummary(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		return summary.Leaderless == 0, fmt.Sprintf("%d leaderless partitions", summary.Leaderless), nil
+	}},
+	{CheckClusterControllerQuorum, func(ctx context.Context, b *brokerState) (bool, string, error) {
+		health, err := b.healthOverview(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		return health.ControllerID >= 0, fmt.Sprintf("controller is broker %d", health.ControllerID), nil
+	}},
+}
+
+// Report runs all of the checks that [Prober.IsClusterBrokerHealthy] and
+// [Prober.IsClusterBrokerReady] are derived from against a broker, so that
+// the report explains every reason for a broker not being healthy.
+func (p *Prober) Report(ctx context.Context, brokerURL string) *Report {
+	return p.runChecks(ctx, brokerURL, func(string) bool { return true })
+}
+
+// runChecks performs the checks selected by include, after [CheckAdminAPI],
+// against a broker. Their outcomes are exported as metrics and the health and
+// readiness of the broker are derived from them.
+func (p *Prober) runChecks(ctx context.Context, brokerURL string, include func(name string) bool) *Report {
+	report := &Report{Time: time.Now()}
+
+	record := func(name string, passed bool, detail string, err error) {
+		result := CheckResult{Name: name, Passed: passed, Detail: detail, Time: time.Now()}
+		if err != nil {
+			result = CheckResult{Name: name, Error: err.Error(), Time: result.Time}
+		} else if !passed {
+			p.logger.Info("broker check failed", "check", name, "detail", detail)
+		}
+		report.Checks = append(report.Checks, result)
+		recordCheck(result.Name, result.Passed, result.Time)
+	}
+	defer report.evaluate()
+
+	client, brokerID, err := p.getClient(ctx, brokerURL)
+	if err != nil {
+		record(CheckAdminAPI, false, "", err)
+		// nothing else can be checked without the Admin API
+		skipped := fmt.Errorf("skipped: the Admin API of broker %q is unreachable", brokerURL)
+		for _, check := range brokerChecks {
+			if include(check.name) {
+				record(check.name, false, "", skipped)
+			}
+		}
+		return report
+	}
+	defer client.Close()
+
+	report.BrokerID = &brokerID
+	record(CheckAdminAPI, true, fmt.Sprintf("broker %d", brokerID), nil)
+
+	state := &brokerState{client: client, brokerID: brokerID}
+	for _, check := range brokerChecks {
+		if include(check.name) {
+			passed, detail, err := check.run(ctx, state)
+			record(check.name, passed, detail, err)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package probes_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/redpanda-data/redpanda-operator/operator/internal/probes"
+	internalclient "github.com/redpanda-data/redpanda-operator/operator/pkg/client"
+)
+
+func TestReport(t *testing.T) {
+	health := rpadmin.ClusterHealthOverview{IsHealthy: true, ControllerID: 0}
+	summary := rpadmin.LocalPartitionSummary{Count: 10}
+
+	mux := http.NewServeMux()
+	respond := func(path string, value func() any) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			require.NoError(t, json.NewEncoder(w).Encode(value()))
+		})
+	}
+	respond("/v1/node_config", func() any { return rpadmin.NodeConfig{NodeID: 1} })
+	respond("/v1/cluster/health_overview", func() any { return health })
+	respond("/v1/brokers/1", func() any { return rpadmin.Broker{NodeID: 1, MembershipStatus: rpadmin.MembershipStatusActive} })
+	respond("/v1/maintenance", func() any { return rpadmin.MaintenanceStatus{} })
+	respond("/v1/partitions/local_summary", func() any { return summary })
+
+	admin := httptest.NewServer(mux)
+	defer admin.Close()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/etc/redpanda/redpanda.yaml", []byte(fmt.Sprintf("rpk:\n  admin_api:\n    addresses: [%q]\n", admin.URL)), 0o644))
+
+	prober := probes.NewProber(internalclient.NewFactory(&rest.Config{}, nil), "/etc/redpanda/redpanda.yaml", probes.WithFS(fs))
+	ctx := context.Background()
+
+	report := prober.Report(ctx, admin.URL)
+	require.True(t, report.Healthy)
+	require.True(t, report.Ready)
+	require.Equal(t, 1, *report.BrokerID)
+	require.Len(t, report.Checks, 8)
+	for _, check := range report.Checks {
+		require.True(t, check.Passed, check.Name)
+		require.Empty(t, check.Error, check.Name)
+	}
+
+	// an unhealthy cluster falls back to the checks of the broker itself,
+	// all of which are reported rather than only the first failing one
+	health = rpadmin.ClusterHealthOverview{IsHealthy: false, UnhealthyReasons: []string{"nodes_down"}, ControllerID: -1}
+	summary = rpadmin.LocalPartitionSummary{Count: 10, UnderReplicated: 2}
+
+	report = prober.Report(ctx, admin.URL)
+	require.False(t, report.Healthy)
+	require.True(t, report.Ready)
+	for name, passed := range map[string]bool{
+		probes.CheckClusterHealth:           false,
+		probes.CheckUnderReplicated:         false,
+		probes.CheckLeaderless:              true,
+		probes.CheckClusterControllerQuorum: false,
+	} {
+		require.Equal(t, passed, report.Passed(name), name)
+	}
+	require.Equal(t, "cluster is unhealthy: nodes_down", report.Checks[1].Detail)
+
+	// without the Admin API there's nothing to check
+	report = prober.Report(ctx, "http://127.0.0.1:1")
+	require.False(t, report.Healthy)
+	require.Nil(t, report.BrokerID)
+	require.Len(t, report.Checks, 8)
+	for _, check := range report.Checks {
+		require.NotEmpty(t, check.Error, check.Name)
+	}
+
+	server, err := probes.NewServer(probes.Config{Prober: prober, URL: admin.URL})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	server.HandleStatus(w, httptest.NewRequest(http.MethodGet, "/statusz", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var decoded probes.Report
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &decoded))
+	require.Len(t, decoded.Checks, 8)
+	require.False(t, decoded.Healthy)
+}
+
+func TestProbeMetrics(t *testing.T) {
+	health := rpadmin.ClusterHealthOverview{IsHealthy: true, ControllerID: 0}
+	summary := rpadmin.LocalPartitionSummary{Count: 10}
+	membership := rpadmin.MembershipStatusActive
+
+	mux := http.NewServeMux()
+	respond := func(path string, value func() any) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			require.NoError(t, json.NewEncoder(w).Encode(value()))
+		})
+	}
+	respond("/v1/node_config", func() any { return rpadmin.NodeConfig{NodeID: 1} })
+	respond("/v1/cluster/health_overview", func() any { return health })
+	respond("/v1/brokers/1", func() any { return rpadmin.Broker{NodeID: 1, MembershipStatus: membership} })
+	respond("/v1/maintenance", func() any { return rpadmin.MaintenanceStatus{} })
+	respond("/v1/partitions/local_summary", func() any { return summary })
+
+	admin := httptest.NewServer(mux)
+	defer admin.Close()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/etc/redpanda/redpanda.yaml", []byte(fmt.Sprintf("rpk:\n  admin_api:\n    addresses: [%q]\n", admin.URL)), 0o644))
+
+	prober := probes.NewProber(internalclient.NewFactory(&rest.Config{}, nil), "/etc/redpanda/redpanda.yaml", probes.WithFS(fs))
+	server, err := probes.NewServer(probes.Config{Prober: prober, URL: admin.URL})
+	require.NoError(t, err)
+
+	probe := func(handler http.HandlerFunc, path string) int {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Code
+	}
+
+	start := time.Now().Unix()
+
+	// the health probe runs the same checks as the status report
+	require.Equal(t, http.StatusOK, probe(server.HandleHealthyCheck, "/healthz"))
+	passing, timestamps := gatherCheckMetrics(t)
+	for _, check := range prober.Report(context.Background(), admin.URL).Checks {
+		require.Equal(t, 1.0, passing[check.Name], check.Name)
+		require.GreaterOrEqual(t, timestamps[check.Name], float64(start), check.Name)
+	}
+
+	// a healthy cluster short-circuits the checks of the broker itself
+	summary = rpadmin.LocalPartitionSummary{Count: 10, UnderReplicated: 2}
+	require.Equal(t, http.StatusOK, probe(server.HandleHealthyCheck, "/healthz"))
+	passing, _ = gatherCheckMetrics(t)
+	require.Equal(t, 0.0, passing[probes.CheckUnderReplicated])
+
+	health = rpadmin.ClusterHealthOverview{IsHealthy: false, ControllerID: 0}
+
+	require.Equal(t, http.StatusBadRequest, probe(server.HandleHealthyCheck, "/healthz"))
+	passing, timestamps = gatherCheckMetrics(t)
+	for name, expected := range map[string]float64{
+		probes.CheckClusterHealth:           0,
+		probes.CheckMembership:              1,
+		probes.CheckMaintenance:             1,
+		probes.CheckLocalPartitions:         1,
+		probes.CheckUnderReplicated:         0,
+		probes.CheckLeaderless:              1,
+		probes.CheckClusterControllerQuorum: 1,
+	} {
+		require.Equal(t, expected, passing[name], name)
+		require.GreaterOrEqual(t, timestamps[name], float64(start), name)
+	}
+
+	membership = rpadmin.MembershipStatusDraining
+
+	require.Equal(t, http.StatusBadRequest, probe(server.HandleReadyCheck, "/readyz"))
+	passing, _ = gatherCheckMetrics(t)
+	require.Equal(t, 1.0, passing[probes.CheckAdminAPI])
+	require.Equal(t, 0.0, passing[probes.CheckMembership])
+
+	// probes only fail with an error if a check couldn't be performed
+	ready, err := prober.IsClusterBrokerReady(context.Background(), admin.URL)
+	require.False(t, ready)
+	require.NoError(t, err)
+	healthy, err := prober.IsClusterBrokerHealthy(context.Background(), "http://127.0.0.1:1")
+	require.False(t, healthy)
+	require.ErrorContains(t, err, probes.CheckAdminAPI)
+}
+
+// gatherCheckMetrics returns the values of the passing and timestamp gauges
+// of the broker probe checks, by check.
+func gatherCheckMetrics(t *testing.T) (passing, timestamps map[string]float64) {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+
+	passing, timestamps = map[string]float64{}, map[string]float64{}
+	for _, family := range families {
+		var values map[string]float64
+		switch family.GetName() {
+		case "redpanda_broker_probe_check_passing":
+			values = passing
+		case "redpanda_broker_probe_check_timestamp_seconds":
+			values = timestamps
+		default:
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "check" {
+					values[label.GetValue()] = metric.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return passing, timestamps
+}
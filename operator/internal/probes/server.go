@@ -11,6 +11,7 @@ package probes
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -68,6 +69,7 @@ func NewServer(config Config) (*Server, error) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", server.HandleHealthyCheck)
 	mux.HandleFunc("/readyz", server.HandleReadyCheck)
+	mux.HandleFunc("/statusz", server.HandleStatus)
 
 	server.server = &http.Server{
 		Addr:    address,
@@ -112,6 +114,18 @@ func (s *Server) HandleReadyCheck(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusBadRequest)
 }
 
+// HandleStatus responds with a JSON [Report] of the individual checks that
+// the health and readiness of the broker are derived from. It always responds
+// with 200 as the report itself carries the outcome.
+func (s *Server) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	report := s.prober.Report(r.Context(), s.url)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		s.logger.Error(err, "error writing status report")
+	}
+}
+
 func (s *Server) NeedLeaderElection() bool {
 	// explicitly elect this as not needing leadership election
 	return false
@@ -61,6 +61,22 @@ type ClusterResourcesSyncedCondition string
 // be set by a controller when it subsequently reconciles a cluster.
 type ClusterConfigurationAppliedCondition string
 
+// ClusterRecoveredCondition - This condition indicates whether a cluster that
+// has `spec.clusterRecovery` enabled has been recovered from Tiered Storage.
+//
+// This condition is only set on clusters that have cluster recovery enabled.
+// Until it is set to either True or a reason other than "InProgress", Topics,
+// Users and Schemas referencing the cluster are not synced.
+type ClusterRecoveredCondition string
+
+// ClusterLicenseExpiringSoonCondition - This condition indicates whether the
+// license loaded into a cluster expires within one of the operator's
+// `--license-expiry-warning-thresholds`.
+//
+// The condition's message names the smallest threshold that has been crossed, a
+// Warning event is emitted whenever it changes while the condition is True.
+type ClusterLicenseExpiringSoonCondition string
+
 // ClusterQuiescedCondition - This condition is used as to indicate that the
 // cluster is no longer reconciling due to it being in a finalized state for the
 // current generation.
@@ -207,6 +223,78 @@ const (
 	// occurs, the "Quiesced" status should be set to True.
 	ClusterConfigurationAppliedReasonTerminalError ClusterConfigurationAppliedCondition = "TerminalError"
 
+	// ClusterRecovered - This condition indicates whether a cluster that has
+	// `spec.clusterRecovery` enabled has been recovered from Tiered Storage.
+	//
+	// This condition is only set on clusters that have cluster recovery enabled.
+	// Until it is set to either True or a reason other than "InProgress", Topics,
+	// Users and Schemas referencing the cluster are not synced.
+	ClusterRecovered = "Recovered"
+	// ClusterRecoveredReasonRecovered - This reason is used with the "Recovered"
+	// condition when it evaluates to True because the cluster recovery finished
+	// successfully.
+	ClusterRecoveredReasonRecovered ClusterRecoveredCondition = "Recovered"
+	// ClusterRecoveredReasonInProgress - This reason is used with the "Recovered"
+	// condition when it evaluates to False because the cluster recovery has been
+	// started and has not yet finished.
+	ClusterRecoveredReasonInProgress ClusterRecoveredCondition = "InProgress"
+	// ClusterRecoveredReasonFailed - This reason is used with the "Recovered"
+	// condition when it evaluates to False because the cluster recovery failed or
+	// did not start in time.
+	ClusterRecoveredReasonFailed ClusterRecoveredCondition = "Failed"
+	// ClusterRecoveredReasonNotFresh - This reason is used with the "Recovered"
+	// condition when it evaluates to False because the cluster had already been
+	// configured by the operator, so no recovery was attempted.
+	ClusterRecoveredReasonNotFresh ClusterRecoveredCondition = "NotFresh"
+	// ClusterRecoveredReasonError - This reason is used when a cluster has only
+	// been partially reconciled and we have early returned due to a retryable error
+	// occurring prior to applying the desired cluster state. If it is set on any
+	// non-final condition, then the condition "Quiesced" will be False with a
+	// reason of "SillReconciling".
+	ClusterRecoveredReasonError ClusterRecoveredCondition = "Error"
+	// ClusterRecoveredReasonTerminalError - This reason is used when a cluster has
+	// only been partially reconciled and we have early returned due to a known
+	// terminal error occurring prior to applying the desired cluster state. Because
+	// the cluster should no longer be reconciled when a terminal error occurs, the
+	// "Quiesced" status should be set to True.
+	ClusterRecoveredReasonTerminalError ClusterRecoveredCondition = "TerminalError"
+
+	// ClusterLicenseExpiringSoon - This condition indicates whether the license
+	// loaded into a cluster expires within one of the operator's
+	// `--license-expiry-warning-thresholds`.
+	//
+	// The condition's message names the smallest threshold that has been crossed, a
+	// Warning event is emitted whenever it changes while the condition is True.
+	ClusterLicenseExpiringSoon = "LicenseExpiringSoon"
+	// ClusterLicenseExpiringSoonReasonExpiringSoon - This reason is used with the
+	// "LicenseExpiringSoon" condition when it evaluates to True because the license
+	// expires within one of the warning thresholds.
+	ClusterLicenseExpiringSoonReasonExpiringSoon ClusterLicenseExpiringSoonCondition = "ExpiringSoon"
+	// ClusterLicenseExpiringSoonReasonNotExpiringSoon - This reason is used with
+	// the "LicenseExpiringSoon" condition when it evaluates to False because the
+	// license expires after the largest warning threshold.
+	ClusterLicenseExpiringSoonReasonNotExpiringSoon ClusterLicenseExpiringSoonCondition = "NotExpiringSoon"
+	// ClusterLicenseExpiringSoonReasonExpired - This reason is used with the
+	// "LicenseExpiringSoon" condition when it evaluates to False because the
+	// license has already expired.
+	ClusterLicenseExpiringSoonReasonExpired ClusterLicenseExpiringSoonCondition = "Expired"
+	// ClusterLicenseExpiringSoonReasonNotPresent - This reason is used with the
+	// "LicenseExpiringSoon" condition when it evaluates to False because the
+	// cluster has no license with an expiration loaded.
+	ClusterLicenseExpiringSoonReasonNotPresent ClusterLicenseExpiringSoonCondition = "NotPresent"
+	// ClusterLicenseExpiringSoonReasonError - This reason is used when a cluster
+	// has only been partially reconciled and we have early returned due to a
+	// retryable error occurring prior to applying the desired cluster state. If it
+	// is set on any non-final condition, then the condition "Quiesced" will be
+	// False with a reason of "SillReconciling".
+	ClusterLicenseExpiringSoonReasonError ClusterLicenseExpiringSoonCondition = "Error"
+	// ClusterLicenseExpiringSoonReasonTerminalError - This reason is used when a
+	// cluster has only been partially reconciled and we have early returned due to
+	// a known terminal error occurring prior to applying the desired cluster state.
+	// Because the cluster should no longer be reconciled when a terminal error
+	// occurs, the "Quiesced" status should be set to True.
+	ClusterLicenseExpiringSoonReasonTerminalError ClusterLicenseExpiringSoonCondition = "TerminalError"
+
 	// ClusterQuiesced - This condition is used as to indicate that the cluster is
 	// no longer reconciling due to it being in a finalized state for the current
 	// generation.
@@ -255,6 +343,10 @@ type ClusterStatus struct {
 	isResourcesSyncedTransientError      bool
 	isConfigurationAppliedSet            bool
 	isConfigurationAppliedTransientError bool
+	isRecoveredSet                       bool
+	isRecoveredTransientError            bool
+	isLicenseExpiringSoonSet             bool
+	isLicenseExpiringSoonTransientError  bool
 }
 
 // NewCluster() returns a new ClusterStatus
@@ -568,8 +660,124 @@ func (s *ClusterStatus) SetConfigurationApplied(reason ClusterConfigurationAppli
 	})
 }
 
+// SetRecoveredFromCurrent sets the underlying condition based on an existing object.
+func (s *ClusterStatus) SetRecoveredFromCurrent(o client.Object) {
+	condition := apimeta.FindStatusCondition(GetConditions(o), ClusterRecovered)
+	if condition == nil {
+		return
+	}
+
+	s.SetRecovered(ClusterRecoveredCondition(condition.Reason), condition.Message)
+}
+
+// SetRecovered sets the underlying condition to the given reason.
+func (s *ClusterStatus) SetRecovered(reason ClusterRecoveredCondition, messages ...string) {
+	if s.isRecoveredSet {
+		panic("you should only ever set a condition once, doing so more than once is a programming error")
+	}
+
+	var status metav1.ConditionStatus
+
+	s.isRecoveredSet = true
+	message := strings.Join(messages, "; ")
+
+	switch reason {
+	case ClusterRecoveredReasonRecovered:
+		if message == "" {
+			message = "Cluster recovered from tiered storage"
+		}
+		status = metav1.ConditionTrue
+	case ClusterRecoveredReasonInProgress:
+		status = metav1.ConditionFalse
+	case ClusterRecoveredReasonFailed:
+		status = metav1.ConditionFalse
+	case ClusterRecoveredReasonNotFresh:
+		if message == "" {
+			message = "Cluster recovery is only started on freshly bootstrapped clusters"
+		}
+		status = metav1.ConditionFalse
+	case ClusterRecoveredReasonError:
+		s.isRecoveredTransientError = true
+		status = metav1.ConditionFalse
+	case ClusterRecoveredReasonTerminalError:
+		s.hasTerminalError = true
+		status = metav1.ConditionFalse
+	default:
+		panic("unhandled reason type")
+	}
+
+	if message == "" {
+		panic("message must be set")
+	}
+
+	s.conditions = append(s.conditions, metav1.Condition{
+		Type:    ClusterRecovered,
+		Status:  status,
+		Reason:  string(reason),
+		Message: message,
+	})
+}
+
+// SetLicenseExpiringSoonFromCurrent sets the underlying condition based on an existing object.
+func (s *ClusterStatus) SetLicenseExpiringSoonFromCurrent(o client.Object) {
+	condition := apimeta.FindStatusCondition(GetConditions(o), ClusterLicenseExpiringSoon)
+	if condition == nil {
+		return
+	}
+
+	s.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonCondition(condition.Reason), condition.Message)
+}
+
+// SetLicenseExpiringSoon sets the underlying condition to the given reason.
+func (s *ClusterStatus) SetLicenseExpiringSoon(reason ClusterLicenseExpiringSoonCondition, messages ...string) {
+	if s.isLicenseExpiringSoonSet {
+		panic("you should only ever set a condition once, doing so more than once is a programming error")
+	}
+
+	var status metav1.ConditionStatus
+
+	s.isLicenseExpiringSoonSet = true
+	message := strings.Join(messages, "; ")
+
+	switch reason {
+	case ClusterLicenseExpiringSoonReasonExpiringSoon:
+		status = metav1.ConditionTrue
+	case ClusterLicenseExpiringSoonReasonNotExpiringSoon:
+		status = metav1.ConditionFalse
+	case ClusterLicenseExpiringSoonReasonExpired:
+		if message == "" {
+			message = "License has expired"
+		}
+		status = metav1.ConditionFalse
+	case ClusterLicenseExpiringSoonReasonNotPresent:
+		if message == "" {
+			message = "No license with an expiration is loaded"
+		}
+		status = metav1.ConditionFalse
+	case ClusterLicenseExpiringSoonReasonError:
+		s.isLicenseExpiringSoonTransientError = true
+		status = metav1.ConditionFalse
+	case ClusterLicenseExpiringSoonReasonTerminalError:
+		s.hasTerminalError = true
+		status = metav1.ConditionFalse
+	default:
+		panic("unhandled reason type")
+	}
+
+	if message == "" {
+		panic("message must be set")
+	}
+
+	s.conditions = append(s.conditions, metav1.Condition{
+		Type:    ClusterLicenseExpiringSoon,
+		Status:  status,
+		Reason:  string(reason),
+		Message: message,
+	})
+}
+
 func (s *ClusterStatus) getQuiesced() metav1.Condition {
-	transientErrorConditionsSet := s.isReadyTransientError || s.isHealthyTransientError || s.isLicenseValidTransientError || s.isResourcesSyncedTransientError || s.isConfigurationAppliedTransientError
+	transientErrorConditionsSet := s.isReadyTransientError || s.isHealthyTransientError || s.isLicenseValidTransientError || s.isResourcesSyncedTransientError || s.isConfigurationAppliedTransientError || s.isRecoveredTransientError || s.isLicenseExpiringSoonTransientError
 	allConditionsSet := s.isReadySet && s.isHealthySet && s.isLicenseValidSet && s.isResourcesSyncedSet && s.isConfigurationAppliedSet
 
 	if (allConditionsSet || s.hasTerminalError) && !transientErrorConditionsSet {
@@ -175,6 +175,90 @@ func TestCluster(t *testing.T) {
 				status.SetConfigurationApplied(ClusterConfigurationAppliedReasonTerminalError, "reason")
 			},
 		},
+		"Recovered/Recovered": {
+			condition: ClusterRecovered,
+			reason:    string(ClusterRecoveredReasonRecovered),
+			expected:  metav1.ConditionTrue,
+			setFn:     func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+		},
+		"Recovered/InProgress": {
+			condition: ClusterRecovered,
+			reason:    string(ClusterRecoveredReasonInProgress),
+			expected:  metav1.ConditionFalse,
+			setFn:     func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonInProgress, "reason") },
+		},
+		"Recovered/Failed": {
+			condition: ClusterRecovered,
+			reason:    string(ClusterRecoveredReasonFailed),
+			expected:  metav1.ConditionFalse,
+			setFn:     func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonFailed, "reason") },
+		},
+		"Recovered/NotFresh": {
+			condition: ClusterRecovered,
+			reason:    string(ClusterRecoveredReasonNotFresh),
+			expected:  metav1.ConditionFalse,
+			setFn:     func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonNotFresh, "reason") },
+		},
+		"Recovered/Error": {
+			condition: ClusterRecovered,
+			reason:    string(ClusterRecoveredReasonError),
+			expected:  metav1.ConditionFalse,
+			setFn:     func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonError, "reason") },
+		},
+		"Recovered/TerminalError": {
+			condition: ClusterRecovered,
+			reason:    string(ClusterRecoveredReasonTerminalError),
+			expected:  metav1.ConditionFalse,
+			setFn:     func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonTerminalError, "reason") },
+		},
+		"LicenseExpiringSoon/ExpiringSoon": {
+			condition: ClusterLicenseExpiringSoon,
+			reason:    string(ClusterLicenseExpiringSoonReasonExpiringSoon),
+			expected:  metav1.ConditionTrue,
+			setFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+			},
+		},
+		"LicenseExpiringSoon/NotExpiringSoon": {
+			condition: ClusterLicenseExpiringSoon,
+			reason:    string(ClusterLicenseExpiringSoonReasonNotExpiringSoon),
+			expected:  metav1.ConditionFalse,
+			setFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonNotExpiringSoon, "reason")
+			},
+		},
+		"LicenseExpiringSoon/Expired": {
+			condition: ClusterLicenseExpiringSoon,
+			reason:    string(ClusterLicenseExpiringSoonReasonExpired),
+			expected:  metav1.ConditionFalse,
+			setFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpired, "reason")
+			},
+		},
+		"LicenseExpiringSoon/NotPresent": {
+			condition: ClusterLicenseExpiringSoon,
+			reason:    string(ClusterLicenseExpiringSoonReasonNotPresent),
+			expected:  metav1.ConditionFalse,
+			setFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonNotPresent, "reason")
+			},
+		},
+		"LicenseExpiringSoon/Error": {
+			condition: ClusterLicenseExpiringSoon,
+			reason:    string(ClusterLicenseExpiringSoonReasonError),
+			expected:  metav1.ConditionFalse,
+			setFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonError, "reason")
+			},
+		},
+		"LicenseExpiringSoon/TerminalError": {
+			condition: ClusterLicenseExpiringSoon,
+			reason:    string(ClusterLicenseExpiringSoonReasonTerminalError),
+			expected:  metav1.ConditionFalse,
+			setFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonTerminalError, "reason")
+			},
+		},
 	} {
 		tt := tt
 		t.Run(name, func(t *testing.T) {
@@ -206,7 +290,7 @@ func TestCluster(t *testing.T) {
 
 			status := NewCluster()
 
-			// attempt to set all conditions one by one until they are all set
+			// attempt to set all required conditions one by one until they are all set
 			assertConditionStatusReason(t, conditionReason.condition, metav1.ConditionFalse, conditionReason.falseReason, status.getConditions(0))
 
 			status.SetReady(ClusterReadyReasonReady, "reason")
@@ -240,6 +324,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Transient Error: Error, Condition: Healthy": {
@@ -251,6 +339,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Transient Error: Error, Condition: LicenseValid": {
@@ -262,6 +354,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Transient Error: Error, Condition: ResourcesSynced": {
@@ -273,6 +369,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Transient Error: Error, Condition: ConfigurationApplied": {
@@ -284,6 +384,40 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) { status.SetHealthy(ClusterHealthyReasonHealthy, "reason") },
 				func(status *ClusterStatus) { status.SetLicenseValid(ClusterLicenseValidReasonValid, "reason") },
 				func(status *ClusterStatus) { status.SetResourcesSynced(ClusterResourcesSyncedReasonSynced, "reason") },
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
+			},
+		},
+		"Transient Error: Error, Condition: Recovered": {
+			setTransientErrFn: func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonError, "reason") },
+			setConditionReasons: []setClusterFunc{
+				func(status *ClusterStatus) { status.SetReady(ClusterReadyReasonReady, "reason") },
+				func(status *ClusterStatus) { status.SetHealthy(ClusterHealthyReasonHealthy, "reason") },
+				func(status *ClusterStatus) { status.SetLicenseValid(ClusterLicenseValidReasonValid, "reason") },
+				func(status *ClusterStatus) { status.SetResourcesSynced(ClusterResourcesSyncedReasonSynced, "reason") },
+				func(status *ClusterStatus) {
+					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
+				},
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
+			},
+		},
+		"Transient Error: Error, Condition: LicenseExpiringSoon": {
+			setTransientErrFn: func(status *ClusterStatus) {
+				status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonError, "reason")
+			},
+			setConditionReasons: []setClusterFunc{
+				func(status *ClusterStatus) { status.SetReady(ClusterReadyReasonReady, "reason") },
+				func(status *ClusterStatus) { status.SetHealthy(ClusterHealthyReasonHealthy, "reason") },
+				func(status *ClusterStatus) { status.SetLicenseValid(ClusterLicenseValidReasonValid, "reason") },
+				func(status *ClusterStatus) { status.SetResourcesSynced(ClusterResourcesSyncedReasonSynced, "reason") },
+				func(status *ClusterStatus) {
+					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
+				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
 			},
 		},
 	} {
@@ -315,6 +449,10 @@ func TestCluster(t *testing.T) {
 		"Terminal Error: TerminalError, Condition: ConfigurationApplied": func(status *ClusterStatus) {
 			status.SetConfigurationApplied(ClusterConfigurationAppliedReasonTerminalError, "reason")
 		},
+		"Terminal Error: TerminalError, Condition: Recovered": func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonTerminalError, "reason") },
+		"Terminal Error: TerminalError, Condition: LicenseExpiringSoon": func(status *ClusterStatus) {
+			status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonTerminalError, "reason")
+		},
 	} {
 		setFn := setFn
 		t.Run(name, func(t *testing.T) {
@@ -350,6 +488,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Rollup Conditions: Stable, False Condition: Ready": {
@@ -364,6 +506,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Rollup Conditions: Stable, False Condition: ResourcesSynced": {
@@ -380,6 +526,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) {
 					status.SetConfigurationApplied(ClusterConfigurationAppliedReasonApplied, "reason")
 				},
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 		"Rollup Conditions: Stable, False Condition: ConfigurationApplied": {
@@ -394,6 +544,10 @@ func TestCluster(t *testing.T) {
 				func(status *ClusterStatus) { status.SetHealthy(ClusterHealthyReasonHealthy, "reason") },
 				func(status *ClusterStatus) { status.SetLicenseValid(ClusterLicenseValidReasonValid, "reason") },
 				func(status *ClusterStatus) { status.SetResourcesSynced(ClusterResourcesSyncedReasonSynced, "reason") },
+				func(status *ClusterStatus) { status.SetRecovered(ClusterRecoveredReasonRecovered, "reason") },
+				func(status *ClusterStatus) {
+					status.SetLicenseExpiringSoon(ClusterLicenseExpiringSoonReasonExpiringSoon, "reason")
+				},
 			},
 		},
 	} {
@@ -0,0 +1,516 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package backup
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sr"
+
+	"github.com/redpanda-data/redpanda-operator/pkg/otelutil/log"
+)
+
+// excludedClusterConfig contains the cluster configuration properties that
+// identify a specific cluster and must therefore never be restored into
+// another one.
+var excludedClusterConfig = []string{
+	"cluster_id",
+}
+
+// redactedValue is the placeholder returned by the Admin API in place of the
+// values of secret cluster configuration properties.
+const redactedValue = "[secret]"
+
+// Client exports and restores the logical metadata of a cluster.
+type Client struct {
+	kafkaClient          *kgo.Client
+	kafkaAdminClient     *kadm.Client
+	adminClient          *rpadmin.AdminAPI
+	schemaRegistryClient *sr.Client
+}
+
+// NewClient initializes a Client. schemaRegistryClient may be nil if the
+// cluster does not expose a Schema Registry, in which case subjects are
+// neither exported nor restored.
+func NewClient(kafkaClient *kgo.Client, adminClient *rpadmin.AdminAPI, schemaRegistryClient *sr.Client) *Client {
+	return &Client{
+		kafkaClient:          kafkaClient,
+		kafkaAdminClient:     kadm.NewClient(kafkaClient),
+		adminClient:          adminClient,
+		schemaRegistryClient: schemaRegistryClient,
+	}
+}
+
+// Close closes the underlying connections.
+func (c *Client) Close() {
+	c.kafkaClient.Close()
+	c.adminClient.Close()
+}
+
+// Credentials are the credentials of a user to restore.
+type Credentials struct {
+	Password string
+	// Mechanism is the SCRAM mechanism to create the user with. If empty,
+	// the mechanism recorded in the backup is used, falling back to
+	// SCRAM-SHA-256.
+	Mechanism string
+}
+
+// Export takes a snapshot of the logical metadata of the cluster.
+func (c *Client) Export(ctx context.Context) (*Document, error) {
+	document := &Document{
+		Version:   Version,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	var err error
+
+	if document.ClusterConfig, err = c.exportClusterConfig(ctx); err != nil {
+		return nil, errors.Wrap(err, "exporting cluster configuration")
+	}
+
+	if document.Topics, err = c.exportTopics(ctx); err != nil {
+		return nil, errors.Wrap(err, "exporting topics")
+	}
+
+	if document.Users, err = c.exportUsers(ctx); err != nil {
+		return nil, errors.Wrap(err, "exporting users")
+	}
+
+	if document.ACLs, err = c.exportACLs(ctx); err != nil {
+		return nil, errors.Wrap(err, "exporting ACLs")
+	}
+
+	if document.Subjects, err = c.exportSubjects(ctx); err != nil {
+		return nil, errors.Wrap(err, "exporting subjects")
+	}
+
+	return document, nil
+}
+
+// Restore replays the given document into the cluster. Restoring is
+// idempotent: existing topics and users are left in place, topic
+// configurations are overwritten, and ACLs and schemas that already exist
+// are not duplicated.
+//
+// As passwords are never part of a backup, users are only created if
+// credentials for them are present in credentials and are skipped otherwise.
+// Likewise, secret cluster configuration properties are never part of a
+// backup and must be restored manually.
+func (c *Client) Restore(ctx context.Context, document *Document, credentials map[string]Credentials) error {
+	if err := c.restoreClusterConfig(ctx, document.ClusterConfig); err != nil {
+		return errors.Wrap(err, "restoring cluster configuration")
+	}
+
+	if err := c.restoreTopics(ctx, document.Topics); err != nil {
+		return errors.Wrap(err, "restoring topics")
+	}
+
+	if err := c.restoreUsers(ctx, document.Users, credentials); err != nil {
+		return errors.Wrap(err, "restoring users")
+	}
+
+	if err := c.restoreACLs(ctx, document.ACLs); err != nil {
+		return errors.Wrap(err, "restoring ACLs")
+	}
+
+	if err := c.restoreSubjects(ctx, document); err != nil {
+		return errors.Wrap(err, "restoring subjects")
+	}
+
+	return nil
+}
+
+func (c *Client) exportClusterConfig(ctx context.Context) (map[string]any, error) {
+	logger := log.FromContext(ctx)
+
+	config, err := c.adminClient.Config(ctx, false)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	schema, err := c.adminClient.ClusterConfigSchema(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for key := range config {
+		if slices.Contains(excludedClusterConfig, key) {
+			delete(config, key)
+			continue
+		}
+
+		// The Admin API redacts the values of secret properties. Exporting
+		// them would result in the placeholder overwriting the actual secret
+		// upon restoring.
+		if schema[key].IsSecret {
+			logger.Info("skipping secret cluster configuration property, it must be restored manually", "property", key)
+			delete(config, key)
+		}
+	}
+
+	return config, nil
+}
+
+func (c *Client) restoreClusterConfig(ctx context.Context, config map[string]any) error {
+	logger := log.FromContext(ctx)
+
+	if len(config) == 0 {
+		return nil
+	}
+
+	schema, err := c.adminClient.ClusterConfigSchema(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	upsert := map[string]any{}
+	for key, value := range config {
+		if slices.Contains(excludedClusterConfig, key) {
+			continue
+		}
+
+		// Backups never contain the values of secret properties but might
+		// have been edited by hand or been taken by an older version.
+		if schema[key].IsSecret || value == redactedValue {
+			logger.Info("skipping secret cluster configuration property, it must be restored manually", "property", key)
+			continue
+		}
+
+		upsert[key] = value
+	}
+
+	// NB: remove must be an empty slice NOT nil.
+	result, err := c.adminClient.PatchClusterConfig(ctx, upsert, []string{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	logger.Info("restored cluster configuration", "config_version", result.ConfigVersion)
+
+	return nil
+}
+
+func (c *Client) exportTopics(ctx context.Context) ([]Topic, error) {
+	details, err := c.kafkaAdminClient.ListTopics(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := details.Error(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	names := details.Names()
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	configs, err := c.kafkaAdminClient.DescribeTopicConfigs(ctx, names...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	topics := []Topic{}
+	for _, detail := range details.Sorted() {
+		config, err := configs.On(detail.Topic, nil)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if config.Err != nil {
+			return nil, errors.Wrapf(config.Err, "describing topic %q", detail.Topic)
+		}
+
+		topic := Topic{
+			Name:              detail.Topic,
+			Partitions:        int32(len(detail.Partitions)),
+			ReplicationFactor: int16(detail.Partitions.NumReplicas()),
+			Configs:           map[string]*string{},
+		}
+
+		for _, entry := range config.Configs {
+			if entry.Source == kmsg.ConfigSourceDynamicTopicConfig && !entry.Sensitive {
+				topic.Configs[entry.Key] = entry.Value
+			}
+		}
+
+		topics = append(topics, topic)
+	}
+
+	return topics, nil
+}
+
+func (c *Client) restoreTopics(ctx context.Context, topics []Topic) error {
+	logger := log.FromContext(ctx)
+
+	existing, err := c.kafkaAdminClient.ListTopics(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, topic := range topics {
+		if !existing.Has(topic.Name) {
+			if _, err := c.kafkaAdminClient.CreateTopic(ctx, topic.Partitions, topic.ReplicationFactor, topic.Configs, topic.Name); err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+				return errors.Wrapf(err, "creating topic %q", topic.Name)
+			}
+			logger.Info("restored topic", "topic", topic.Name)
+			continue
+		}
+
+		if len(topic.Configs) == 0 {
+			continue
+		}
+
+		alterations := []kadm.AlterConfig{}
+		for key, value := range topic.Configs {
+			alterations = append(alterations, kadm.AlterConfig{Op: kadm.SetConfig, Name: key, Value: value})
+		}
+
+		responses, err := c.kafkaAdminClient.AlterTopicConfigs(ctx, alterations, topic.Name)
+		if err != nil {
+			return errors.Wrapf(err, "configuring topic %q", topic.Name)
+		}
+		for _, response := range responses {
+			if response.Err != nil {
+				return errors.Wrapf(response.Err, "configuring topic %q", topic.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) exportUsers(ctx context.Context) ([]User, error) {
+	names, err := c.adminClient.ListUsers(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Mechanisms are only informational, so failing to fetch them (for
+	// instance because the cluster predates the SCRAM APIs) isn't fatal.
+	mechanisms := map[string]string{}
+	if described, err := c.kafkaAdminClient.DescribeUserSCRAMs(ctx, names...); err == nil {
+		for user, scram := range described {
+			if scram.Err == nil && len(scram.CredInfos) > 0 {
+				mechanisms[user] = scram.CredInfos[0].Mechanism.String()
+			}
+		}
+	} else {
+		log.FromContext(ctx).V(log.DebugLevel).Info("unable to describe SCRAM users", "error", err.Error())
+	}
+
+	sort.Strings(names)
+
+	users := []User{}
+	for _, name := range names {
+		users = append(users, User{Name: name, Mechanism: mechanisms[name]})
+	}
+
+	return users, nil
+}
+
+func (c *Client) restoreUsers(ctx context.Context, users []User, credentials map[string]Credentials) error {
+	logger := log.FromContext(ctx)
+
+	if len(users) == 0 {
+		return nil
+	}
+
+	existing, err := c.adminClient.ListUsers(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, user := range users {
+		if slices.Contains(existing, user.Name) {
+			continue
+		}
+
+		creds, ok := credentials[user.Name]
+		if !ok {
+			logger.Info("skipping user without a password", "user", user.Name)
+			continue
+		}
+
+		mechanism := cmp.Or(creds.Mechanism, user.Mechanism, rpadmin.ScramSha256)
+
+		if err := c.adminClient.CreateUser(ctx, user.Name, creds.Password, mechanism); err != nil {
+			return errors.Wrapf(err, "creating user %q", user.Name)
+		}
+
+		logger.Info("restored user", "user", user.Name)
+	}
+
+	return nil
+}
+
+func (c *Client) exportACLs(ctx context.Context) ([]ACL, error) {
+	req := kmsg.NewPtrDescribeACLsRequest()
+	req.PermissionType = kmsg.ACLPermissionTypeAny
+	req.ResourceType = kmsg.ACLResourceTypeAny
+	req.Operation = kmsg.ACLOperationAny
+	req.ResourcePatternType = kmsg.ACLResourcePatternTypeAny
+
+	response, err := req.RequestWith(ctx, c.kafkaClient)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkError(response.ErrorMessage, response.ErrorCode); err != nil {
+		return nil, err
+	}
+
+	acls := []ACL{}
+	for _, resource := range response.Resources {
+		for _, acl := range resource.ACLs {
+			acls = append(acls, ACL{
+				ResourceType:        resource.ResourceType,
+				ResourceName:        resource.ResourceName,
+				ResourcePatternType: resource.ResourcePatternType,
+				Principal:           acl.Principal,
+				Host:                acl.Host,
+				Operation:           acl.Operation,
+				PermissionType:      acl.PermissionType,
+			})
+		}
+	}
+
+	return acls, nil
+}
+
+func (c *Client) restoreACLs(ctx context.Context, acls []ACL) error {
+	if len(acls) == 0 {
+		return nil
+	}
+
+	// Creating an ACL that already exists is a no-op, so there's no need to
+	// check for existing ACLs first.
+	req := kmsg.NewPtrCreateACLsRequest()
+	for _, acl := range acls {
+		req.Creations = append(req.Creations, kmsg.CreateACLsRequestCreation{
+			ResourceType:        acl.ResourceType,
+			ResourceName:        acl.ResourceName,
+			ResourcePatternType: acl.ResourcePatternType,
+			Principal:           acl.Principal,
+			Host:                acl.Host,
+			Operation:           acl.Operation,
+			PermissionType:      acl.PermissionType,
+		})
+	}
+
+	response, err := req.RequestWith(ctx, c.kafkaClient)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, result := range response.Results {
+		if err := checkError(result.ErrorMessage, result.ErrorCode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) exportSubjects(ctx context.Context) ([]Subject, error) {
+	if c.schemaRegistryClient == nil {
+		return nil, nil
+	}
+
+	names, err := c.schemaRegistryClient.Subjects(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sort.Strings(names)
+
+	compatibilities := map[string]sr.CompatibilityLevel{}
+	if len(names) > 0 {
+		// Subjects without their own compatibility level return an error,
+		// which means that they inherit the global one.
+		for _, result := range c.schemaRegistryClient.Compatibility(ctx, names...) {
+			if result.Err == nil {
+				compatibilities[result.Subject] = result.Level
+			}
+		}
+	}
+
+	subjects := []Subject{}
+	for _, name := range names {
+		versions, err := c.schemaRegistryClient.Schemas(ctx, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fetching versions of subject %q", name)
+		}
+
+		subject := Subject{Name: name, Versions: versions}
+		if level, ok := compatibilities[name]; ok {
+			subject.Compatibility = &level
+		}
+
+		subjects = append(subjects, subject)
+	}
+
+	return subjects, nil
+}
+
+func (c *Client) restoreSubjects(ctx context.Context, document *Document) error {
+	if len(document.Subjects) == 0 {
+		return nil
+	}
+
+	if c.schemaRegistryClient == nil {
+		log.FromContext(ctx).Info("skipping subjects as no schema registry is configured", "subjects", len(document.Subjects))
+		return nil
+	}
+
+	// Registering a schema that is identical to an existing version of the
+	// subject returns the existing version rather than creating a new one.
+	for _, schema := range document.schemasInRegistrationOrder() {
+		if _, err := c.schemaRegistryClient.CreateSchema(ctx, schema.Subject, schema.Schema); err != nil {
+			return errors.Wrapf(err, "creating version %d of subject %q", schema.Version, schema.Subject)
+		}
+	}
+
+	for _, subject := range document.Subjects {
+		if subject.Compatibility == nil {
+			continue
+		}
+
+		for _, result := range c.schemaRegistryClient.SetCompatibility(ctx, sr.SetCompatibility{Level: *subject.Compatibility}, subject.Name) {
+			if result.Err != nil {
+				return errors.Wrapf(result.Err, "setting compatibility of subject %q", subject.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkError(message *string, code int16) error {
+	var errMessage string
+	if message != nil {
+		errMessage = "Error: " + *message + "; "
+	}
+
+	if code != 0 {
+		return errors.Newf("%s%w", errMessage, kerr.ErrorForCode(code))
+	}
+
+	return nil
+}
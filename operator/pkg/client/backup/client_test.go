@@ -0,0 +1,511 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package backup
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"k8s.io/utils/ptr"
+)
+
+var (
+	testSchema = rpadmin.ConfigSchema{
+		"auto_create_topics_enabled": {},
+		"cloud_storage_secret_key":   {IsSecret: true},
+		"cluster_id":                 {},
+	}
+
+	testACL = ACL{
+		ResourceType:        kmsg.ACLResourceTypeTopic,
+		ResourceName:        "orders",
+		ResourcePatternType: kmsg.ACLResourcePatternTypeLiteral,
+		Principal:           "User:alice",
+		Host:                "*",
+		Operation:           kmsg.ACLOperationRead,
+		PermissionType:      kmsg.ACLPermissionTypeAllow,
+	}
+)
+
+func TestExport(t *testing.T) {
+	admin := &fakeAdmin{
+		config: map[string]any{
+			"auto_create_topics_enabled": true,
+			"cloud_storage_secret_key":   "[secret]",
+			"cluster_id":                 "c1",
+		},
+		users: map[string]string{"alice": rpadmin.ScramSha512, "bob": rpadmin.ScramSha256},
+	}
+
+	kafka := newFakeKafka(t)
+	kafka.topics["orders"] = &Topic{Name: "orders", Partitions: 3, ReplicationFactor: 3, Configs: map[string]*string{"cleanup.policy": ptr.To("compact")}}
+	kafka.acls = []ACL{testACL}
+	kafka.scram = map[string]int8{"alice": 2, "bob": 1}
+
+	client := newTestClient(t, admin, kafka)
+
+	document, err := client.Export(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, Version, document.Version)
+	// Neither the identity of the cluster nor the redacted secrets are exported.
+	require.Equal(t, map[string]any{"auto_create_topics_enabled": true}, document.ClusterConfig)
+	// Only dynamically set topic configurations are exported.
+	require.Equal(t, []Topic{{Name: "orders", Partitions: 3, ReplicationFactor: 3, Configs: map[string]*string{"cleanup.policy": ptr.To("compact")}}}, document.Topics)
+	require.Equal(t, []User{{Name: "alice", Mechanism: rpadmin.ScramSha512}, {Name: "bob", Mechanism: rpadmin.ScramSha256}}, document.Users)
+	require.Equal(t, []ACL{testACL}, document.ACLs)
+	require.Empty(t, document.Subjects)
+}
+
+func TestRestore(t *testing.T) {
+	admin := &fakeAdmin{
+		config: map[string]any{},
+		users:  map[string]string{"carol": rpadmin.ScramSha256},
+	}
+
+	kafka := newFakeKafka(t)
+	kafka.topics["existing"] = &Topic{Name: "existing", Partitions: 1, ReplicationFactor: 1, Configs: map[string]*string{}}
+
+	client := newTestClient(t, admin, kafka)
+
+	document := &Document{
+		Version: Version,
+		ClusterConfig: map[string]any{
+			"auto_create_topics_enabled": true,
+			"cloud_storage_secret_key":   "[secret]",
+			"cluster_id":                 "c1",
+		},
+		Topics: []Topic{
+			{Name: "orders", Partitions: 3, ReplicationFactor: 3, Configs: map[string]*string{"cleanup.policy": ptr.To("compact")}},
+			{Name: "existing", Partitions: 1, ReplicationFactor: 1, Configs: map[string]*string{"retention.ms": ptr.To("1000")}},
+		},
+		Users: []User{
+			{Name: "alice", Mechanism: rpadmin.ScramSha512},
+			{Name: "bob"},
+			{Name: "carol"},
+			{Name: "dave"},
+		},
+		ACLs: []ACL{testACL},
+		// Without a Schema Registry client, subjects are skipped.
+		Subjects: []Subject{{Name: "orders-value"}},
+	}
+
+	credentials := map[string]Credentials{
+		"alice": {Password: "alice-password"},
+		"bob":   {Password: "bob-password", Mechanism: rpadmin.ScramSha512},
+		"carol": {Password: "carol-password"},
+	}
+
+	// Restoring is idempotent.
+	for range 2 {
+		require.NoError(t, client.Restore(context.Background(), document, credentials))
+
+		// Secrets and the identity of the cluster are never restored.
+		require.Equal(t, map[string]any{"auto_create_topics_enabled": true}, admin.config)
+
+		require.Equal(t, map[string]*Topic{
+			"orders":   {Name: "orders", Partitions: 3, ReplicationFactor: 3, Configs: map[string]*string{"cleanup.policy": ptr.To("compact")}},
+			"existing": {Name: "existing", Partitions: 1, ReplicationFactor: 1, Configs: map[string]*string{"retention.ms": ptr.To("1000")}},
+		}, kafka.topics)
+
+		// Users without credentials are skipped and existing ones are left
+		// untouched. Mechanisms provided alongside the credentials take
+		// precedence over the one recorded in the backup.
+		require.Equal(t, map[string]string{
+			"alice": rpadmin.ScramSha512,
+			"bob":   rpadmin.ScramSha512,
+			"carol": rpadmin.ScramSha256,
+		}, admin.users)
+		require.Equal(t, map[string]string{
+			"alice": "alice-password",
+			"bob":   "bob-password",
+		}, admin.passwords)
+
+		require.Equal(t, []ACL{testACL}, kafka.acls)
+	}
+}
+
+func newTestClient(t *testing.T, admin *fakeAdmin, kafka *fakeKafka) *Client {
+	server := httptest.NewServer(admin)
+	t.Cleanup(server.Close)
+
+	adminClient, err := rpadmin.NewAdminAPI([]string{server.URL}, &rpadmin.NopAuth{}, nil)
+	require.NoError(t, err)
+
+	kafkaClient, err := kgo.NewClient(kgo.SeedBrokers(kafka.listener.Addr().String()))
+	require.NoError(t, err)
+
+	client := NewClient(kafkaClient, adminClient, nil)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// fakeAdmin implements the subset of the Admin API used by Client.
+type fakeAdmin struct {
+	mu        sync.Mutex
+	config    map[string]any
+	users     map[string]string
+	passwords map[string]string
+}
+
+func (f *fakeAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var response any
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/cluster_config":
+		response = f.config
+
+	case "GET /v1/cluster_config/schema":
+		response = rpadmin.ConfigSchemaResponse{Properties: testSchema}
+
+	case "PUT /v1/cluster_config":
+		var patch struct {
+			Upsert map[string]any `json:"upsert"`
+			Remove []string       `json:"remove"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		maps.Copy(f.config, patch.Upsert)
+		response = rpadmin.ClusterConfigWriteResult{ConfigVersion: 1}
+
+	case "GET /v1/security/users":
+		response = slices.Sorted(maps.Keys(f.users))
+
+	case "POST /v1/security/users":
+		var user struct {
+			Username  string `json:"username"`
+			Password  string `json:"password"`
+			Algorithm string `json:"algorithm"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := f.users[user.Username]; ok {
+			http.Error(w, "user already exists", http.StatusBadRequest)
+			return
+		}
+		if f.passwords == nil {
+			f.passwords = map[string]string{}
+		}
+		f.users[user.Username] = user.Algorithm
+		f.passwords[user.Username] = user.Password
+
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// fakeKafka is a single broker implementation of the subset of the Kafka
+// protocol used by Client.
+type fakeKafka struct {
+	mu       sync.Mutex
+	listener net.Listener
+	topics   map[string]*Topic
+	acls     []ACL
+	scram    map[string]int8
+}
+
+func newFakeKafka(t *testing.T) *fakeKafka {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	f := &fakeKafka{listener: listener, topics: map[string]*Topic{}}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+
+	return f
+}
+
+func (f *fakeKafka) serve(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+
+		data := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(conn, data); err != nil {
+			return
+		}
+
+		// Request header: api key, api version, correlation id and client id.
+		key := int16(binary.BigEndian.Uint16(data[0:]))
+		version := int16(binary.BigEndian.Uint16(data[2:]))
+		correlationID := data[4:8]
+		data = data[10+max(int16(binary.BigEndian.Uint16(data[8:])), 0):]
+
+		req := kmsg.RequestForKey(key)
+		if req == nil {
+			return
+		}
+		req.SetVersion(version)
+
+		if req.IsFlexible() {
+			// Skip the (empty) tagged fields of the request header.
+			_, n := binary.Uvarint(data)
+			data = data[n:]
+		}
+
+		if err := req.ReadFrom(data); err != nil {
+			return
+		}
+
+		f.mu.Lock()
+		resp := f.handle(req)
+		f.mu.Unlock()
+		resp.SetVersion(version)
+
+		out := append(make([]byte, 4), correlationID...)
+		// ApiVersions responses always use the non-flexible header.
+		if resp.IsFlexible() && kmsg.Key(key) != kmsg.ApiVersions {
+			out = append(out, 0)
+		}
+		out = resp.AppendTo(out)
+		binary.BigEndian.PutUint32(out, uint32(len(out)-4))
+
+		if _, err := conn.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeKafka) handle(req kmsg.Request) kmsg.Response {
+	switch req := req.(type) {
+	case *kmsg.ApiVersionsRequest:
+		resp := kmsg.NewPtrApiVersionsResponse()
+		for key := int16(0); key <= kmsg.MaxKey; key++ {
+			if supported := kmsg.RequestForKey(key); supported != nil {
+				resp.ApiKeys = append(resp.ApiKeys, kmsg.ApiVersionsResponseApiKey{ApiKey: key, MaxVersion: supported.MaxVersion()})
+			}
+		}
+		return resp
+
+	case *kmsg.MetadataRequest:
+		host, port, _ := net.SplitHostPort(f.listener.Addr().String())
+		portNum, _ := strconv.Atoi(port)
+
+		resp := kmsg.NewPtrMetadataResponse()
+		resp.Brokers = []kmsg.MetadataResponseBroker{{NodeID: 0, Host: host, Port: int32(portNum)}}
+		resp.ControllerID = 0
+
+		names := slices.Sorted(maps.Keys(f.topics))
+		if req.Topics != nil {
+			names = nil
+			for _, topic := range req.Topics {
+				names = append(names, ptr.Deref(topic.Topic, ""))
+			}
+		}
+
+		for _, name := range names {
+			topic := kmsg.NewMetadataResponseTopic()
+			topic.Topic = ptr.To(name)
+
+			existing, ok := f.topics[name]
+			if !ok {
+				topic.ErrorCode = kerr.UnknownTopicOrPartition.Code
+				resp.Topics = append(resp.Topics, topic)
+				continue
+			}
+
+			replicas := []int32{}
+			for i := range int32(existing.ReplicationFactor) {
+				replicas = append(replicas, i)
+			}
+
+			for i := range existing.Partitions {
+				partition := kmsg.NewMetadataResponseTopicPartition()
+				partition.Partition = i
+				partition.Replicas = replicas
+				partition.ISR = replicas
+				topic.Partitions = append(topic.Partitions, partition)
+			}
+
+			resp.Topics = append(resp.Topics, topic)
+		}
+		return resp
+
+	case *kmsg.DescribeConfigsRequest:
+		resp := kmsg.NewPtrDescribeConfigsResponse()
+		for _, resource := range req.Resources {
+			described := kmsg.NewDescribeConfigsResponseResource()
+			described.ResourceType = resource.ResourceType
+			described.ResourceName = resource.ResourceName
+
+			topic, ok := f.topics[resource.ResourceName]
+			if !ok {
+				described.ErrorCode = kerr.UnknownTopicOrPartition.Code
+				resp.Resources = append(resp.Resources, described)
+				continue
+			}
+
+			for _, name := range slices.Sorted(maps.Keys(topic.Configs)) {
+				described.Configs = append(described.Configs, kmsg.DescribeConfigsResponseResourceConfig{
+					Name:   name,
+					Value:  topic.Configs[name],
+					Source: kmsg.ConfigSourceDynamicTopicConfig,
+				})
+			}
+
+			described.Configs = append(described.Configs, kmsg.DescribeConfigsResponseResourceConfig{
+				Name:      "retention.bytes",
+				Value:     ptr.To("-1"),
+				IsDefault: true,
+				Source:    kmsg.ConfigSourceDefaultConfig,
+			})
+
+			resp.Resources = append(resp.Resources, described)
+		}
+		return resp
+
+	case *kmsg.CreateTopicsRequest:
+		resp := kmsg.NewPtrCreateTopicsResponse()
+		for _, topic := range req.Topics {
+			created := kmsg.NewCreateTopicsResponseTopic()
+			created.Topic = topic.Topic
+			created.NumPartitions = topic.NumPartitions
+			created.ReplicationFactor = topic.ReplicationFactor
+
+			if _, ok := f.topics[topic.Topic]; ok {
+				created.ErrorCode = kerr.TopicAlreadyExists.Code
+				resp.Topics = append(resp.Topics, created)
+				continue
+			}
+
+			configs := map[string]*string{}
+			for _, config := range topic.Configs {
+				configs[config.Name] = config.Value
+			}
+
+			f.topics[topic.Topic] = &Topic{Name: topic.Topic, Partitions: topic.NumPartitions, ReplicationFactor: topic.ReplicationFactor, Configs: configs}
+			resp.Topics = append(resp.Topics, created)
+		}
+		return resp
+
+	case *kmsg.IncrementalAlterConfigsRequest:
+		resp := kmsg.NewPtrIncrementalAlterConfigsResponse()
+		for _, resource := range req.Resources {
+			altered := kmsg.NewIncrementalAlterConfigsResponseResource()
+			altered.ResourceType = resource.ResourceType
+			altered.ResourceName = resource.ResourceName
+
+			topic, ok := f.topics[resource.ResourceName]
+			if !ok {
+				altered.ErrorCode = kerr.UnknownTopicOrPartition.Code
+				resp.Resources = append(resp.Resources, altered)
+				continue
+			}
+
+			for _, config := range resource.Configs {
+				switch config.Op {
+				case kmsg.IncrementalAlterConfigOpSet:
+					topic.Configs[config.Name] = config.Value
+				case kmsg.IncrementalAlterConfigOpDelete:
+					delete(topic.Configs, config.Name)
+				}
+			}
+
+			resp.Resources = append(resp.Resources, altered)
+		}
+		return resp
+
+	case *kmsg.DescribeACLsRequest:
+		resp := kmsg.NewPtrDescribeACLsResponse()
+		for _, acl := range f.acls {
+			resp.Resources = append(resp.Resources, kmsg.DescribeACLsResponseResource{
+				ResourceType:        acl.ResourceType,
+				ResourceName:        acl.ResourceName,
+				ResourcePatternType: acl.ResourcePatternType,
+				ACLs: []kmsg.DescribeACLsResponseResourceACL{{
+					Principal:      acl.Principal,
+					Host:           acl.Host,
+					Operation:      acl.Operation,
+					PermissionType: acl.PermissionType,
+				}},
+			})
+		}
+		return resp
+
+	case *kmsg.CreateACLsRequest:
+		resp := kmsg.NewPtrCreateACLsResponse()
+		for _, creation := range req.Creations {
+			acl := ACL{
+				ResourceType:        creation.ResourceType,
+				ResourceName:        creation.ResourceName,
+				ResourcePatternType: creation.ResourcePatternType,
+				Principal:           creation.Principal,
+				Host:                creation.Host,
+				Operation:           creation.Operation,
+				PermissionType:      creation.PermissionType,
+			}
+			if !slices.Contains(f.acls, acl) {
+				f.acls = append(f.acls, acl)
+			}
+			resp.Results = append(resp.Results, kmsg.NewCreateACLsResponseResult())
+		}
+		return resp
+
+	case *kmsg.DescribeUserSCRAMCredentialsRequest:
+		resp := kmsg.NewPtrDescribeUserSCRAMCredentialsResponse()
+		for _, user := range req.Users {
+			result := kmsg.NewDescribeUserSCRAMCredentialsResponseResult()
+			result.User = user.Name
+
+			mechanism, ok := f.scram[user.Name]
+			if !ok {
+				result.ErrorCode = kerr.ResourceNotFound.Code
+				resp.Results = append(resp.Results, result)
+				continue
+			}
+
+			result.CredentialInfos = []kmsg.DescribeUserSCRAMCredentialsResponseResultCredentialInfo{{Mechanism: mechanism, Iterations: 4096}}
+			resp.Results = append(resp.Results, result)
+		}
+		return resp
+
+	default:
+		return req.ResponseKind()
+	}
+}
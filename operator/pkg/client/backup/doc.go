@@ -0,0 +1,16 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package backup holds logic for exporting the logical metadata of a Redpanda
+// cluster (topics, users, ACLs, schemas and cluster configuration) into a
+// versioned document and for idempotently replaying it into a cluster.
+//
+// Backups are independent of tiered storage and never contain any record
+// data or user passwords.
+package backup
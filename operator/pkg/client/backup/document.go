@@ -0,0 +1,124 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package backup
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sr"
+	"sigs.k8s.io/yaml"
+)
+
+// Version is the version of the Document format written by this package.
+// Documents with a newer version are rejected when being read.
+const Version = 1
+
+var ErrUnsupportedVersion = errors.New("unsupported backup document version")
+
+// Document is a snapshot of the logical metadata of a cluster.
+type Document struct {
+	// Version is the version of the format of the document.
+	Version int `json:"version"`
+	// CreatedAt is the time at which the snapshot was taken.
+	CreatedAt time.Time `json:"createdAt"`
+	// ClusterConfig contains the cluster configuration properties that
+	// were set to a non-default value.
+	ClusterConfig map[string]any `json:"clusterConfig,omitempty"`
+	// Topics contains every non-internal topic.
+	Topics []Topic `json:"topics,omitempty"`
+	// Users contains every SCRAM user. Passwords are never exported.
+	Users []User `json:"users,omitempty"`
+	// ACLs contains every ACL.
+	ACLs []ACL `json:"acls,omitempty"`
+	// Subjects contains every Schema Registry subject along with
+	// all of its versions.
+	Subjects []Subject `json:"subjects,omitempty"`
+}
+
+// Topic is a topic along with its dynamically set configuration.
+type Topic struct {
+	Name              string             `json:"name"`
+	Partitions        int32              `json:"partitions"`
+	ReplicationFactor int16              `json:"replicationFactor"`
+	Configs           map[string]*string `json:"configs,omitempty"`
+}
+
+// User is a SCRAM user.
+type User struct {
+	Name string `json:"name"`
+	// Mechanism is the SCRAM mechanism of the user's credentials, if it
+	// could be determined.
+	Mechanism string `json:"mechanism,omitempty"`
+}
+
+// ACL is a single ACL entry.
+type ACL struct {
+	ResourceType        kmsg.ACLResourceType        `json:"resourceType"`
+	ResourceName        string                      `json:"resourceName"`
+	ResourcePatternType kmsg.ACLResourcePatternType `json:"resourcePatternType"`
+	Principal           string                      `json:"principal"`
+	Host                string                      `json:"host"`
+	Operation           kmsg.ACLOperation           `json:"operation"`
+	PermissionType      kmsg.ACLPermissionType      `json:"permissionType"`
+}
+
+// Subject is a Schema Registry subject.
+type Subject struct {
+	Name string `json:"name"`
+	// Compatibility is the compatibility level set on the subject, if any.
+	Compatibility *sr.CompatibilityLevel `json:"compatibility,omitempty"`
+	// Versions contains every version of the subject in ascending order.
+	Versions []sr.SubjectSchema `json:"versions"`
+}
+
+// Marshal encodes the document as YAML.
+func (d *Document) Marshal() ([]byte, error) {
+	return yaml.Marshal(d)
+}
+
+// Unmarshal decodes a document previously encoded with Marshal.
+func Unmarshal(data []byte) (*Document, error) {
+	var document Document
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if document.Version < 1 || document.Version > Version {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "version %d", document.Version)
+	}
+
+	return &document, nil
+}
+
+// schemasInRegistrationOrder returns every schema version of the document
+// sorted by the order in which they were originally registered. As schemas
+// may only reference already registered schemas, replaying them in this order
+// ensures that references can always be resolved.
+func (d *Document) schemasInRegistrationOrder() []sr.SubjectSchema {
+	schemas := []sr.SubjectSchema{}
+	for _, subject := range d.Subjects {
+		schemas = append(schemas, subject.Versions...)
+	}
+
+	sort.SliceStable(schemas, func(i, j int) bool {
+		if schemas[i].ID != schemas[j].ID {
+			return schemas[i].ID < schemas[j].ID
+		}
+		if schemas[i].Subject != schemas[j].Subject {
+			return schemas[i].Subject < schemas[j].Subject
+		}
+		return schemas[i].Version < schemas[j].Version
+	})
+
+	return schemas
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package backup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sr"
+	"k8s.io/utils/ptr"
+)
+
+func TestDocumentRoundTrip(t *testing.T) {
+	document := &Document{
+		Version:   Version,
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClusterConfig: map[string]any{
+			"auto_create_topics_enabled": true,
+		},
+		Topics: []Topic{{
+			Name:              "orders",
+			Partitions:        3,
+			ReplicationFactor: 3,
+			Configs:           map[string]*string{"cleanup.policy": ptr.To("compact")},
+		}},
+		Users: []User{{Name: "alice", Mechanism: "SCRAM-SHA-512"}},
+		ACLs: []ACL{{
+			ResourceType:        kmsg.ACLResourceTypeTopic,
+			ResourceName:        "orders",
+			ResourcePatternType: kmsg.ACLResourcePatternTypeLiteral,
+			Principal:           "User:alice",
+			Host:                "*",
+			Operation:           kmsg.ACLOperationRead,
+			PermissionType:      kmsg.ACLPermissionTypeAllow,
+		}},
+		Subjects: []Subject{{
+			Name:          "orders-value",
+			Compatibility: ptr.To(sr.CompatBackward),
+			Versions: []sr.SubjectSchema{{
+				Subject: "orders-value",
+				Version: 1,
+				ID:      1,
+				Schema:  sr.Schema{Schema: `{"type":"string"}`, Type: sr.TypeJSON},
+			}},
+		}},
+	}
+
+	data, err := document.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, document, decoded)
+}
+
+func TestUnmarshalVersion(t *testing.T) {
+	for name, tt := range map[string]struct {
+		data string
+		err  bool
+	}{
+		"current": {
+			data: "version: 1",
+		},
+		"missing": {
+			data: "topics: []",
+			err:  true,
+		},
+		"newer": {
+			data: "version: 2",
+			err:  true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Unmarshal([]byte(tt.data))
+			if tt.err {
+				require.ErrorIs(t, err, ErrUnsupportedVersion)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSchemasInRegistrationOrder(t *testing.T) {
+	document := &Document{
+		Subjects: []Subject{{
+			Name: "a",
+			Versions: []sr.SubjectSchema{
+				{Subject: "a", Version: 1, ID: 3},
+				{Subject: "a", Version: 2, ID: 4},
+			},
+		}, {
+			Name: "b",
+			Versions: []sr.SubjectSchema{
+				{Subject: "b", Version: 1, ID: 1},
+			},
+		}, {
+			Name: "c",
+			Versions: []sr.SubjectSchema{
+				{Subject: "c", Version: 1, ID: 2},
+				{Subject: "c", Version: 2, ID: 3},
+			},
+		}},
+	}
+
+	var order []string
+	for _, schema := range document.schemasInRegistrationOrder() {
+		order = append(order, fmt.Sprintf("%s/%d", schema.Subject, schema.Version))
+	}
+
+	require.Equal(t, []string{"b/1", "c/1", "a/1", "c/2", "a/2"}, order)
+}
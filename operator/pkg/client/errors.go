@@ -50,6 +50,12 @@ func IsInvalidClusterError(err error) bool {
 	return errors.Is(err, ErrInvalidClusterRef)
 }
 
+// IsClusterRecoveringError returns whether this error stems from the
+// referenced cluster being recovered from tiered storage.
+func IsClusterRecoveringError(err error) bool {
+	return errors.Is(err, ErrClusterRecovering)
+}
+
 // IsTerminalClientError returns whether or not the error comes
 // from a terminal error from a failed API request by one of our clients.
 func IsTerminalClientError(err error) bool {
@@ -24,6 +24,7 @@ import (
 	"github.com/twmb/franz-go/pkg/sasl/scram"
 	"github.com/twmb/franz-go/pkg/sr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,13 +32,16 @@ import (
 	redpanda "github.com/redpanda-data/redpanda-operator/charts/redpanda/v5/client"
 	redpandav1alpha2 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha2"
 	vectorizedv1alpha1 "github.com/redpanda-data/redpanda-operator/operator/api/vectorized/v1alpha1"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/statuses"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/client/acls"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/client/backup"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/client/schemas"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/client/users"
 )
 
 var (
 	ErrInvalidClusterRef                 = errors.New("clusterRef refers to a cluster that does not exist")
+	ErrClusterRecovering                 = errors.New("clusterRef refers to a cluster that is being recovered from tiered storage")
 	ErrEmptyBrokerList                   = errors.New("empty broker list")
 	ErrEmptyURLList                      = errors.New("empty url list")
 	ErrInvalidKafkaClientObject          = errors.New("cannot initialize Kafka API client from given object")
@@ -91,6 +95,11 @@ type ClientFactory interface {
 
 	// Schemas returns a high-level client for synchronizing Schemas.
 	Schemas(ctx context.Context, object redpandav1alpha2.ClusterReferencingObject) (*schemas.Syncer, error)
+
+	// Backup returns a high-level client for exporting and restoring the metadata of a cluster. The struct
+	// has the same requirements as for KafkaClient. Callers should always call Close on the returned *backup.Client,
+	// or it will leak goroutines.
+	Backup(ctx context.Context, object any, opts ...kgo.Opt) (*backup.Client, error)
 }
 
 type Factory struct {
@@ -288,6 +297,32 @@ func (c *Factory) Users(ctx context.Context, obj redpandav1alpha2.ClusterReferen
 	return users.NewClient(ctx, c.Client, kadm.NewClient(kafkaClient), adminClient)
 }
 
+func (c *Factory) Backup(ctx context.Context, obj any, opts ...kgo.Opt) (*backup.Client, error) {
+	kafkaClient, err := c.KafkaClient(ctx, obj, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient, err := c.RedpandaAdminClient(ctx, obj)
+	if err != nil {
+		kafkaClient.Close()
+		return nil, err
+	}
+
+	// the schema registry is optional, subjects are skipped if it isn't configured
+	var schemaRegistryClient *sr.Client
+	if profile, ok := obj.(*rpkconfig.RpkProfile); !ok || len(profile.SR.Addresses) > 0 {
+		schemaRegistryClient, err = c.SchemaRegistryClient(ctx, obj)
+		if err != nil && !errors.Is(err, ErrEmptyURLList) {
+			kafkaClient.Close()
+			adminClient.Close()
+			return nil, err
+		}
+	}
+
+	return backup.NewClient(kafkaClient, adminClient, schemaRegistryClient), nil
+}
+
 func (c *Factory) getCluster(ctx context.Context, obj client.Object) (*redpandav1alpha2.Redpanda, error) {
 	o, ok := obj.(redpandav1alpha2.ClusterReferencingObject)
 	if !ok {
@@ -305,6 +340,12 @@ func (c *Factory) getCluster(ctx context.Context, obj client.Object) (*redpandav
 				return nil, err
 			}
 
+			// don't hand out clients while a cluster recovery may still
+			// restore resources that would conflict with ours
+			if isRecovering(&cluster) {
+				return nil, ErrClusterRecovering
+			}
+
 			return &cluster, nil
 		}
 	}
@@ -312,6 +353,22 @@ func (c *Factory) getCluster(ctx context.Context, obj client.Object) (*redpandav
 	return nil, nil
 }
 
+// isRecovering returns whether the cluster is expected to be, or is being,
+// recovered from Tiered Storage. Failed recoveries aren't considered to be in
+// progress so that they don't block dependent resources indefinitely.
+func isRecovering(cluster *redpandav1alpha2.Redpanda) bool {
+	if cluster.Spec.ClusterRecovery == nil || !cluster.Spec.ClusterRecovery.Enabled {
+		return false
+	}
+
+	condition := apimeta.FindStatusCondition(cluster.Status.Conditions, statuses.ClusterRecovered)
+	if condition == nil {
+		return true
+	}
+
+	return statuses.ClusterRecoveredCondition(condition.Reason) == statuses.ClusterRecoveredReasonInProgress
+}
+
 func (c *Factory) getKafkaSpec(obj client.Object) *redpandav1alpha2.KafkaAPISpec {
 	if o, ok := obj.(redpandav1alpha2.ClusterReferencingObject); ok {
 		if source := o.GetClusterSource(); source != nil {
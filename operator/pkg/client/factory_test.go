@@ -38,6 +38,7 @@ import (
 	crds "github.com/redpanda-data/redpanda-operator/operator/config/crd/bases"
 	"github.com/redpanda-data/redpanda-operator/operator/internal/controller"
 	"github.com/redpanda-data/redpanda-operator/operator/internal/controller/vectorized"
+	"github.com/redpanda-data/redpanda-operator/operator/internal/statuses"
 	"github.com/redpanda-data/redpanda-operator/operator/internal/testenv"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/admin"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/resources"
@@ -491,3 +492,31 @@ func TestIntegrationClientFactoryTLSListeners(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, brokers, 1)
 }
+
+func TestIsRecovering(t *testing.T) {
+	condition := func(reason statuses.ClusterRecoveredCondition) []metav1.Condition {
+		return []metav1.Condition{{Type: statuses.ClusterRecovered, Reason: string(reason)}}
+	}
+
+	for name, tt := range map[string]struct {
+		recovery   *redpandav1alpha2.ClusterRecovery
+		conditions []metav1.Condition
+		expected   bool
+	}{
+		"unset":       {},
+		"disabled":    {recovery: &redpandav1alpha2.ClusterRecovery{}},
+		"not-started": {recovery: &redpandav1alpha2.ClusterRecovery{Enabled: true}, expected: true},
+		"in-progress": {recovery: &redpandav1alpha2.ClusterRecovery{Enabled: true}, conditions: condition(statuses.ClusterRecoveredReasonInProgress), expected: true},
+		"recovered":   {recovery: &redpandav1alpha2.ClusterRecovery{Enabled: true}, conditions: condition(statuses.ClusterRecoveredReasonRecovered)},
+		"failed":      {recovery: &redpandav1alpha2.ClusterRecovery{Enabled: true}, conditions: condition(statuses.ClusterRecoveredReasonFailed)},
+		"not-fresh":   {recovery: &redpandav1alpha2.ClusterRecovery{Enabled: true}, conditions: condition(statuses.ClusterRecoveredReasonNotFresh)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rp := &redpandav1alpha2.Redpanda{
+				Spec:   redpandav1alpha2.RedpandaSpec{ClusterRecovery: tt.recovery},
+				Status: redpandav1alpha2.RedpandaStatus{Conditions: tt.conditions},
+			}
+			require.Equal(t, tt.expected, isRecovering(rp))
+		})
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package clusterconfiguration
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/netip"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sigs.k8s.io/yaml"
+)
+
+// These are the functions of the richer fixup library, see [StdLibFactory].
+const (
+	CELBase64Encode  = "base64Encode"
+	CELBase64Decode  = "base64Decode"
+	CELHexEncode     = "hexEncode"
+	CELHexDecode     = "hexDecode"
+	CELFromJSON      = "fromJson"
+	CELToJSON        = "toJson"
+	CELFromYAML      = "fromYaml"
+	CELToYAML        = "toYaml"
+	CELReadFile      = "readFile"
+	CELDNSLabel      = "dnsLabel"
+	CELDNSName       = "dnsName"
+	CELCIDRContains  = "cidrContains"
+	CELCIDRHost      = "cidrHost"
+	CELAddressHost   = "addressHost"
+	CELAddressPort   = "addressPort"
+	CELJoinHostPort  = "joinHostPort"
+	CELPodLabel      = "podLabel"
+	CELPodAnnotation = "podAnnotation"
+	CELDownwardAPI   = "downwardAPI"
+)
+
+// DefaultDownwardAPIDir is where the functions reading the downward API
+// expect its volume to be mounted by default.
+const DefaultDownwardAPIDir = "/etc/podinfo"
+
+// StdLibOption customises the environment constructed by [StdLibFactory].
+type StdLibOption func(*stdLibOptions)
+
+type stdLibOptions struct {
+	fsys           fs.FS
+	downwardAPIDir string
+}
+
+// WithFS enables the functions reading files and the downward API volume,
+// which read from the given file system.
+func WithFS(fsys fs.FS) StdLibOption {
+	return func(o *stdLibOptions) {
+		o.fsys = fsys
+	}
+}
+
+// WithDownwardAPIDir sets the directory that a downward API volume is
+// mounted at, which defaults to [DefaultDownwardAPIDir].
+func WithDownwardAPIDir(dir string) StdLibOption {
+	return func(o *stdLibOptions) {
+		o.downwardAPIDir = dir
+	}
+}
+
+func encodingFunctions() []cel.EnvOption {
+	return []cel.EnvOption{
+		wrapStringToString(CELBase64Encode, func(s string) (string, error) {
+			return base64.StdEncoding.EncodeToString([]byte(s)), nil
+		}),
+		wrapStringToString(CELBase64Decode, func(s string) (string, error) {
+			decoded, err := base64.StdEncoding.DecodeString(s)
+			return string(decoded), err
+		}),
+		wrapStringToString(CELHexEncode, func(s string) (string, error) {
+			return hex.EncodeToString([]byte(s)), nil
+		}),
+		wrapStringToString(CELHexDecode, func(s string) (string, error) {
+			decoded, err := hex.DecodeString(s)
+			return string(decoded), err
+		}),
+		wrapStringToDyn(CELFromJSON, func(s string) (any, error) {
+			var v any
+			if err := json.Unmarshal([]byte(s), &v); err != nil {
+				return nil, fmt.Errorf("cannot parse JSON: %w", err)
+			}
+			return v, nil
+		}),
+		wrapStringToDyn(CELFromYAML, func(s string) (any, error) {
+			var v any
+			if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+				return nil, fmt.Errorf("cannot parse YAML: %w", err)
+			}
+			return v, nil
+		}),
+		wrapDynToString(CELToJSON, func(v any) (string, error) {
+			buf, err := json.Marshal(v)
+			return string(buf), err
+		}),
+		wrapDynToString(CELToYAML, func(v any) (string, error) {
+			buf, err := yaml.Marshal(v)
+			return string(buf), err
+		}),
+	}
+}
+
+func fileFunctions(o *stdLibOptions) []cel.EnvOption {
+	return []cel.EnvOption{
+		wrapStringToString(CELReadFile, readFile(o.fsys)),
+		wrapStringToString(CELPodLabel, downwardAPIMapEntry(o.fsys, path.Join(o.downwardAPIDir, "labels"))),
+		wrapStringToString(CELPodAnnotation, downwardAPIMapEntry(o.fsys, path.Join(o.downwardAPIDir, "annotations"))),
+		wrapStringToString(CELDownwardAPI, func(name string) (string, error) {
+			contents, err := readFile(o.fsys)(path.Join(o.downwardAPIDir, name))
+			return strings.TrimSpace(contents), err
+		}),
+	}
+}
+
+func networkFunctions() []cel.EnvOption {
+	return []cel.EnvOption{
+		wrapStringToString(CELDNSLabel, dnsLabel),
+		cel.Function(CELDNSName,
+			cel.Overload(fmt.Sprintf("%s_list", CELDNSName), []*cel.Type{cel.ListType(cel.StringType)}, cel.StringType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					parts, err := arg.ConvertToNative(reflect.TypeFor[[]string]())
+					if err != nil {
+						return types.WrapErr(err)
+					}
+					name, err := dnsName(parts.([]string))
+					if err != nil {
+						return types.WrapErr(err)
+					}
+					return types.String(name)
+				}),
+			),
+		),
+		cel.Function(CELCIDRContains,
+			cel.Overload(fmt.Sprintf("%s_string_string", CELCIDRContains), []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(arg1, arg2 ref.Val) ref.Val {
+					prefix, err := netip.ParsePrefix(fmt.Sprint(arg1.Value()))
+					if err != nil {
+						return types.WrapErr(err)
+					}
+					addr, err := netip.ParseAddr(fmt.Sprint(arg2.Value()))
+					if err != nil {
+						return types.WrapErr(err)
+					}
+					return types.Bool(prefix.Contains(addr))
+				}),
+			),
+		),
+		cel.Function(CELCIDRHost,
+			cel.Overload(fmt.Sprintf("%s_string_int", CELCIDRHost), []*cel.Type{cel.StringType, cel.IntType}, cel.StringType,
+				cel.BinaryBinding(func(arg1, arg2 ref.Val) ref.Val {
+					host, err := cidrHost(fmt.Sprint(arg1.Value()), int64(arg2.(types.Int)))
+					if err != nil {
+						return types.WrapErr(err)
+					}
+					return types.String(host)
+				}),
+			),
+		),
+		wrapStringToString(CELAddressHost, func(address string) (string, error) {
+			host, _, err := net.SplitHostPort(address)
+			return host, err
+		}),
+		cel.Function(CELAddressPort,
+			cel.Overload(fmt.Sprintf("%s_string", CELAddressPort), []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					_, port, err := net.SplitHostPort(fmt.Sprint(arg.Value()))
+					if err != nil {
+						return types.WrapErr(err)
+					}
+					n, err := strconv.ParseUint(port, 10, 16)
+					if err != nil {
+						return types.WrapErr(fmt.Errorf("invalid port %q: %w", port, err))
+					}
+					return types.Int(n)
+				}),
+			),
+		),
+		cel.Function(CELJoinHostPort,
+			cel.Overload(fmt.Sprintf("%s_string_int", CELJoinHostPort), []*cel.Type{cel.StringType, cel.IntType}, cel.StringType,
+				cel.BinaryBinding(func(arg1, arg2 ref.Val) ref.Val {
+					return types.String(net.JoinHostPort(fmt.Sprint(arg1.Value()), strconv.FormatInt(int64(arg2.(types.Int)), 10)))
+				}),
+			),
+		),
+	}
+}
+
+func wrapStringToDyn(name string, f func(string) (any, error)) cel.EnvOption {
+	return cel.Function(name,
+		cel.Overload(fmt.Sprintf("%s_string", name), []*cel.Type{cel.StringType}, cel.DynType,
+			cel.UnaryBinding(func(arg1 ref.Val) ref.Val {
+				req, err := arg1.ConvertToNative(reflect.TypeFor[string]())
+				if err != nil {
+					return types.WrapErr(err)
+				}
+				res, err := f(req.(string))
+				if err != nil {
+					return types.WrapErr(err)
+				}
+				return types.DefaultTypeAdapter.NativeToValue(res)
+			}),
+		),
+	)
+}
+
+func wrapDynToString(name string, f func(any) (string, error)) cel.EnvOption {
+	return cel.Function(name,
+		cel.Overload(fmt.Sprintf("%s_dyn", name), []*cel.Type{cel.DynType}, cel.StringType,
+			cel.UnaryBinding(func(arg1 ref.Val) ref.Val {
+				// Going through a protobuf Value yields plain JSON-compatible
+				// Go values for lists and maps
+				req, err := arg1.ConvertToNative(reflect.TypeFor[*structpb.Value]())
+				if err != nil {
+					return types.WrapErr(err)
+				}
+				res, err := f(req.(*structpb.Value).AsInterface())
+				if err != nil {
+					return types.WrapErr(err)
+				}
+				return types.String(res)
+			}),
+		),
+	)
+}
+
+// readFile reads a mounted file; absolute paths are resolved against the
+// root of the file system.
+func readFile(fsys fs.FS) func(string) (string, error) {
+	return func(name string) (string, error) {
+		if fsys == nil {
+			return "", fmt.Errorf("cannot read file %q: reading files is not enabled", name)
+		}
+		contents, err := fs.ReadFile(fsys, strings.TrimPrefix(path.Clean(name), "/"))
+		if err != nil {
+			return "", fmt.Errorf("cannot read file %q: %w", name, err)
+		}
+		return string(contents), nil
+	}
+}
+
+// downwardAPIMapEntry looks up a key in a file of a downward API volume that
+// holds a map, such as the labels or annotations of the pod. Those files
+// contain a key="value" line per entry, with Go-style quoting.
+func downwardAPIMapEntry(fsys fs.FS, file string) func(string) (string, error) {
+	return func(key string) (string, error) {
+		contents, err := readFile(fsys)(file)
+		if err != nil {
+			return "", err
+		}
+
+		scanner := bufio.NewScanner(strings.NewReader(contents))
+		for scanner.Scan() {
+			k, quoted, ok := strings.Cut(scanner.Text(), "=")
+			if !ok || k != key {
+				continue
+			}
+			value, err := strconv.Unquote(quoted)
+			if err != nil {
+				return "", fmt.Errorf("cannot parse value of %q in %q: %w", key, file, err)
+			}
+			return value, nil
+		}
+
+		return "", fmt.Errorf("%q not found in %q", key, file)
+	}
+}
+
+var dnsLabelInvalid = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// dnsLabel turns an arbitrary string into a valid RFC 1123 label by lower
+// casing it, replacing invalid characters with dashes and truncating it to
+// 63 characters.
+func dnsLabel(s string) (string, error) {
+	label := dnsLabelInvalid.ReplaceAllString(strings.ToLower(s), "-")
+	if len(label) > 63 {
+		label = label[:63]
+	}
+	label = strings.Trim(label, "-")
+	if label == "" {
+		return "", fmt.Errorf("%q has no characters valid in a DNS label", s)
+	}
+	return label, nil
+}
+
+// dnsName joins the given parts into a DNS name, turning each into a label.
+func dnsName(parts []string) (string, error) {
+	labels := make([]string, 0, len(parts))
+	for _, part := range parts {
+		label, err := dnsLabel(part)
+		if err != nil {
+			return "", err
+		}
+		labels = append(labels, label)
+	}
+
+	name := strings.Join(labels, ".")
+	if len(name) > 253 {
+		return "", fmt.Errorf("DNS name %q is longer than 253 characters", name)
+	}
+	return name, nil
+}
+
+// cidrHost returns the address with the given offset within a CIDR range.
+// Negative offsets count back from the end of the range.
+func cidrHost(cidr string, offset int64) (string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", err
+	}
+	prefix = prefix.Masked()
+
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	size := new(big.Int).Lsh(big.NewInt(1), uint(hostBits)) //nolint:gosec // bit lengths are never negative
+
+	n := big.NewInt(offset)
+	if offset < 0 {
+		n.Add(n, size)
+	}
+	if n.Sign() < 0 || n.Cmp(size) >= 0 {
+		return "", fmt.Errorf("offset %d is outside of %s", offset, cidr)
+	}
+
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	buf := base.Add(base, n).FillBytes(make([]byte, prefix.Addr().BitLen()/8))
+	addr, _ := netip.AddrFromSlice(buf)
+	return addr.String(), nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package clusterconfiguration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdLibFunctions(t *testing.T) {
+	fsys := fstest.MapFS{
+		"etc/redpanda/ca.crt":     {Data: []byte("certificate")},
+		"etc/podinfo/labels":      {Data: []byte("app.kubernetes.io/name=\"redpanda\"\ntopology.kubernetes.io/zone=\"us-east-1a\"\n")},
+		"etc/podinfo/cpu_limit":   {Data: []byte("4\n")},
+		"etc/podinfo/annotations": {Data: []byte(`note="line one\nline two"` + "\n")},
+	}
+	factory := StdLibFactory(context.Background(), map[string]string{"POD_NAME": "Redpanda_0"}, nil, WithFS(fsys))
+
+	for name, tt := range map[string]struct {
+		expr     string
+		expected any
+		err      string
+	}{
+		"base64":               {expr: `base64Decode(base64Encode("redpanda"))`, expected: "redpanda"},
+		"base64-encode":        {expr: `base64Encode("redpanda")`, expected: "cmVkcGFuZGE="},
+		"base64-invalid":       {expr: `base64Decode("%%%")`, err: "illegal base64 data"},
+		"hex":                  {expr: `hexEncode("rp")`, expected: "7270"},
+		"hex-decode":           {expr: `hexDecode("7270")`, expected: "rp"},
+		"from-json":            {expr: `fromJson('{"port": 9092, "hosts": ["a", "b"]}').hosts[1]`, expected: "b"},
+		"from-json-invalid":    {expr: `fromJson("{")`, err: "cannot parse JSON"},
+		"from-yaml":            {expr: `fromYaml("port: 9092\n").port == 9092.0`, expected: true},
+		"to-json":              {expr: `toJson({"name": "redpanda", "ports": [9092, 9093]})`, expected: `{"name":"redpanda","ports":[9092,9093]}`},
+		"to-yaml":              {expr: `toYaml(["a", "b"])`, expected: "- a\n- b\n"},
+		"round-trip":           {expr: `toJson(fromYaml("a: [1, 2]"))`, expected: `{"a":[1,2]}`},
+		"read-file":            {expr: `readFile("/etc/redpanda/ca.crt")`, expected: "certificate"},
+		"read-file-missing":    {expr: `readFile("/etc/redpanda/tls.key")`, err: "cannot read file"},
+		"pod-label":            {expr: `podLabel("topology.kubernetes.io/zone")`, expected: "us-east-1a"},
+		"pod-label-missing":    {expr: `podLabel("missing")`, err: `"missing" not found`},
+		"pod-annotation":       {expr: `podAnnotation("note")`, expected: "line one\nline two"},
+		"downward-api":         {expr: `downwardAPI("cpu_limit")`, expected: "4"},
+		"dns-label":            {expr: `dnsLabel(envString("POD_NAME"))`, expected: "redpanda-0"},
+		"dns-label-invalid":    {expr: `dnsLabel("___")`, err: "no characters valid"},
+		"dns-name":             {expr: `dnsName([envString("POD_NAME"), "Kafka.Internal"])`, expected: "redpanda-0.kafka-internal"},
+		"cidr-contains":        {expr: `cidrContains("10.0.0.0/16", "10.0.12.1")`, expected: true},
+		"cidr-not-contains":    {expr: `cidrContains("10.0.0.0/16", "10.1.0.1")`, expected: false},
+		"cidr-host":            {expr: `cidrHost("10.0.0.0/24", 5)`, expected: "10.0.0.5"},
+		"cidr-host-negative":   {expr: `cidrHost("10.0.0.0/24", -2)`, expected: "10.0.0.254"},
+		"cidr-host-ipv6":       {expr: `cidrHost("fd00::/64", 17)`, expected: "fd00::11"},
+		"cidr-host-outside":    {expr: `cidrHost("10.0.0.0/30", 4)`, err: "outside of"},
+		"address-host":         {expr: `addressHost("redpanda-0.redpanda:9092")`, expected: "redpanda-0.redpanda"},
+		"address-port":         {expr: `addressPort("[::1]:9644") + 1`, expected: int64(9645)},
+		"address-port-invalid": {expr: `addressPort("redpanda:kafka")`, err: "invalid port"},
+		"join-host-port":       {expr: `joinHostPort("::1", 9092)`, expected: "[::1]:9092"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			env, err := factory(reflect.Value{})
+			require.NoError(t, err)
+
+			ast, issues := env.Compile(tt.expr)
+			require.NoError(t, issues.Err())
+
+			prog, err := env.Program(ast)
+			require.NoError(t, err)
+
+			result, _, err := prog.Eval(map[string]any{"it": nil})
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result.Value())
+		})
+	}
+}
+
+func TestFileFunctionsAreOptIn(t *testing.T) {
+	env, err := StdLibFactory(context.Background(), nil, nil)(reflect.Value{})
+	require.NoError(t, err)
+
+	for _, expr := range []string{
+		`readFile("/var/run/secrets/kubernetes.io/serviceaccount/token")`,
+		`podLabel("app.kubernetes.io/name")`,
+		`downwardAPI("cpu_limit")`,
+	} {
+		// the functions compile, so fixups for the pods can be checked,
+		// but can't be evaluated without a file system
+		ast, issues := env.Compile(expr)
+		require.NoError(t, issues.Err())
+
+		prog, err := env.Program(ast)
+		require.NoError(t, err)
+
+		_, _, err = prog.Eval(map[string]any{"it": nil})
+		require.ErrorContains(t, err, "reading files is not enabled", expr)
+	}
+}
+
+func TestCheckFixups(t *testing.T) {
+	factory := StdLibFactory(context.Background(), nil, nil)
+
+	require.NoError(t, CheckFixups([]Fixup{
+		{Field: "a", CEL: `envString("A")`},
+		{Field: "b", CEL: `base64Decode(readFile("/etc/secret"))`},
+	}, factory))
+
+	err := CheckFixups([]Fixup{
+		{Field: "ok", CEL: `repr("x")`},
+		{Field: "syntax", CEL: `envString(`},
+		{Field: "unknown", CEL: `noSuchFunction("x")`},
+		{Field: "types", CEL: `base64Encode(1)`},
+	}, factory)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), `"ok"`)
+	require.Contains(t, err.Error(), `field "syntax"`)
+	require.Contains(t, err.Error(), `field "unknown"`)
+	require.Contains(t, err.Error(), `field "types"`)
+}
+
+func TestTemplatesRejectsInvalidFixups(t *testing.T) {
+	cfg := NewConfig("namespace", nil, nil)
+	cfg.Node.AddFixup("redpanda.rack", `podLabel(`)
+
+	_, err := cfg.Templates()
+	require.ErrorContains(t, err, "invalid redpanda.yaml fixups")
+
+	// the failure isn't cached
+	_, err = cfg.Templates()
+	require.Error(t, err)
+}
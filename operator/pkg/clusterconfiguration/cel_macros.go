@@ -24,10 +24,46 @@ const (
 	CELErrorToWarning        = "errorToWarning"
 )
 
-func StdLibFactory(ctx context.Context, environ map[string]string, cloudExpander *pkgsecrets.CloudExpander) CelFactory {
+// StdLibFactory returns the CEL environment that fixups are evaluated with.
+// Besides the CEL standard library and the value being fixed up as `it`, it
+// provides the following functions:
+//
+//   - envString(name) reads a variable of the environment.
+//   - repr(s) returns the YAML representation of a string.
+//   - appendYamlStringArray(repr, s) appends to the representation of a
+//     string array.
+//   - externalSecretRef(ref) reads a secret of the cloud secret store.
+//   - errorToWarning(expr) turns an error of its argument into a warning.
+//   - base64Encode(s), base64Decode(s), hexEncode(s) and hexDecode(s)
+//     encode and decode strings.
+//   - fromJson(s) and fromYaml(s) parse documents into values;
+//     toJson(v) and toYaml(v) emit them.
+//   - readFile(path) reads a file mounted into the pod.
+//   - dnsLabel(s) turns a string into a valid DNS label and
+//     dnsName([s, ...]) joins strings into a valid DNS name.
+//   - cidrContains(cidr, ip) checks whether an address is within a range
+//     and cidrHost(cidr, n) returns its n-th address, counting back from
+//     the end for negative n.
+//   - addressHost(address) and addressPort(address) split a host:port
+//     address; joinHostPort(host, port) joins one.
+//   - podLabel(key), podAnnotation(key) and downwardAPI(name) read the
+//     downward API volume, see [WithDownwardAPIDir].
+//
+// readFile, podLabel, podAnnotation and downwardAPI can always be compiled,
+// but fail to evaluate unless a file system is provided with [WithFS]. Only
+// the configurator, which runs within the broker pods, should provide one;
+// expressions evaluated by the operator would otherwise be able to read its
+// files, such as its service account token.
+func StdLibFactory(ctx context.Context, environ map[string]string, cloudExpander *pkgsecrets.CloudExpander, opts ...StdLibOption) CelFactory {
+	o := stdLibOptions{
+		downwardAPIDir: DefaultDownwardAPIDir,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return func(v reflect.Value) (*cel.Env, error) {
-		cel.StdLib()
-		return cel.NewEnv(
+		options := []cel.EnvOption{
 			cel.Variable("it", cel.AnyType),
 			wrapStringToString(CELEnvString, envString(environ)),
 			wrapStringToString(CELRepr, repr),
@@ -35,7 +71,11 @@ func StdLibFactory(ctx context.Context, environ map[string]string, cloudExpander
 			wrapStringToString(CELExternalSecretRef, externalSecretRef(ctx, cloudExpander)),
 			wrapStringToString("makeError", makeError),
 			errorToWarning(),
-		)
+		}
+		options = append(options, encodingFunctions()...)
+		options = append(options, fileFunctions(&o)...)
+		options = append(options, networkFunctions()...)
+		return cel.NewEnv(options...)
 	}
 }
 
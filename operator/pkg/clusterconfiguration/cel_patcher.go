@@ -97,6 +97,24 @@ func (t *Template[T]) Fixup(engine func(reflect.Value) (*cel.Env, error)) error
 	return stderrors.Join(errs...)
 }
 
+// CheckFixups compiles, and so type-checks, fixup expressions without
+// evaluating them. This surfaces mistakes when configuration is rendered
+// rather than inside pods, where evaluation takes place.
+func CheckFixups(fixups []Fixup, engine CelFactory) error {
+	var errs []error
+	for _, f := range fixups {
+		env, err := engine(reflect.Value{})
+		if err != nil {
+			errs = append(errs, errors.Newf("problem readying CEL engine for field %q: %w", f.Field, err))
+			continue
+		}
+		if _, issues := env.Compile(f.CEL); issues != nil {
+			errs = append(errs, errors.Newf("problem compiling CEL for field %q: %w", f.Field, issues.Err()))
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
 type fieldAssigner struct {
 	reflect.Value
 	parent *reflect.Value
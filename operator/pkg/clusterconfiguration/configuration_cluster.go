@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -130,9 +131,9 @@ func (c *clusterCfg) compile(k string, v vectorizedv1alpha1.ClusterConfigValue)
 		// If that's not the case, and the referred value's octets should be injected into the template verbatim,
 		// then the user can specify that explicitly.
 		if v.UseRawValue {
-			c.AddFixup(k, fmt.Sprintf(`%s("%s")`, CELEnvString, envName))
+			c.AddFixup(k, fmt.Sprintf(`%s(%s)`, CELEnvString, strconv.Quote(envName)))
 		} else {
-			c.AddFixup(k, fmt.Sprintf(`%s(%s("%s"))`, CELRepr, CELEnvString, envName))
+			c.AddFixup(k, fmt.Sprintf(`%s(%s(%s))`, CELRepr, CELEnvString, strconv.Quote(envName)))
 		}
 		return ptr.To(``)
 	case v.SecretKeyRef != nil:
@@ -151,9 +152,9 @@ func (c *clusterCfg) compile(k string, v vectorizedv1alpha1.ClusterConfigValue)
 		// If that's not the case, and the referred value's octets should be injected into the template verbatim,
 		// then the user can specify that explicitly.
 		if v.UseRawValue {
-			c.AddFixup(k, fmt.Sprintf(`%s("%s")`, CELEnvString, envName))
+			c.AddFixup(k, fmt.Sprintf(`%s(%s)`, CELEnvString, strconv.Quote(envName)))
 		} else {
-			c.AddFixup(k, fmt.Sprintf(`%s(%s("%s"))`, CELRepr, CELEnvString, envName))
+			c.AddFixup(k, fmt.Sprintf(`%s(%s(%s))`, CELRepr, CELEnvString, strconv.Quote(envName)))
 		}
 		return ptr.To(``)
 	case v.ExternalSecretRef != nil: // nolint:staticcheck // ignore deprecation for now
@@ -167,7 +168,8 @@ func (c *clusterCfg) compile(k string, v vectorizedv1alpha1.ClusterConfigValue)
 		// If that's not the case, and the referred value's octets should be injected into the template verbatim,
 		// then the user can specify that explicitly.
 		// We wrap the returned value in `errorToWarning` in the case where the key is marked as optional.
-		fixup := fmt.Sprintf(`%s("%s")`, CELExternalSecretRef, v.ExternalSecretRefSelector.Name)
+		// The name is quoted as a CEL string literal so that it can't inject an expression of its own.
+		fixup := fmt.Sprintf(`%s(%s)`, CELExternalSecretRef, strconv.Quote(v.ExternalSecretRefSelector.Name))
 		if !v.UseRawValue {
 			fixup = fmt.Sprintf(`%s(%s)`, CELRepr, fixup)
 		}
@@ -232,14 +234,20 @@ func (c *clusterCfg) Reify(ctx context.Context, reader k8sclient.Reader, cloudEx
 
 	// Finally, use the schema to turn those representations into concrete values
 	properties := make(map[string]any, len(representations))
+	var invalid ValidationErrors
 	for k, v := range representations {
 		metadata := schema[k]
 		value, err := ParseRepresentation(v, &metadata)
 		if err != nil {
-			return nil, errors.WithStack(fmt.Errorf("trouble converting configuration entry %q to value: %w", k, err))
+			invalid = append(invalid, &ValidationError{Key: k, Message: fmt.Sprintf("trouble converting configuration entry to value: %v", err)})
+			continue
 		}
 		properties[k] = value
 	}
+	if len(invalid) > 0 {
+		sortValidationErrors(invalid)
+		return nil, errors.WithStack(invalid)
+	}
 	c.concrete = properties
 	return properties, nil
 }
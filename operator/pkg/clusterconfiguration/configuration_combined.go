@@ -161,6 +161,18 @@ func (c *CombinedCfg) Templates() (map[string]string, error) {
 	if c.templates != nil {
 		return c.templates, nil
 	}
+	// Fixups are only evaluated by the pods, so at least make sure that they
+	// will compile there
+	factory := StdLibFactory(context.Background(), nil, nil)
+	if err := CheckFixups(c.Node.fixups, factory); err != nil {
+		return nil, fmt.Errorf("invalid redpanda.yaml fixups: %w", err)
+	}
+	if err := CheckFixups(c.Cluster.fixups, factory); err != nil {
+		return nil, fmt.Errorf("invalid bootstrap.yaml fixups: %w", err)
+	}
+	if err := CheckFixups(c.RPK.fixups, factory); err != nil {
+		return nil, fmt.Errorf("invalid rpk.yaml fixups: %w", err)
+	}
 	c.templates = make(map[string]string)
 	if err := c.Node.Template(c.templates); err != nil {
 		return nil, fmt.Errorf("cannot template redpanda.yaml: %w", err)
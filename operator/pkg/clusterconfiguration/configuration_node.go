@@ -14,6 +14,7 @@ import (
 	"gopkg.in/yaml.v3"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
 	pkgsecrets "github.com/redpanda-data/redpanda-operator/operator/pkg/secrets"
 )
 
@@ -28,6 +29,8 @@ type nodeCfg struct {
 	*PodContext
 	*config.RedpandaYaml
 	fixups []Fixup
+	// sources are evaluated for each broker, see [ValueSourceResolver.ApplyNodeConfig].
+	sources map[string]redpandav1alpha3.ValueSource
 
 	// These are created only once, on demand
 	concrete *config.RedpandaYaml
@@ -80,11 +83,41 @@ func (n *nodeCfg) AddFixup(field, cel string) {
 	})
 }
 
+// SetValueSource sets a property of redpanda.yaml, e.g. redpanda.rack, from
+// a [redpandav1alpha3.ValueSource]. Literal values are set right away while
+// expressions are evaluated for each broker by the configurator.
+func (n *nodeCfg) SetValueSource(k string, src redpandav1alpha3.ValueSource) error {
+	switch {
+	case src.Expr != "":
+		if n.sources == nil {
+			n.sources = map[string]redpandav1alpha3.ValueSource{}
+		}
+		n.sources[k] = src
+		return nil
+	case src.ConfigMapKeyRef != nil, src.SecretKeyRef != nil:
+		// The configurator isn't permitted to read ConfigMaps and Secrets.
+		return fmt.Errorf("setting %q: node configuration may not reference ConfigMaps or Secrets", k)
+	default:
+		if err := config.Set(n.RedpandaYaml, k, src.Value); err != nil {
+			return fmt.Errorf("setting %q: %w", k, err)
+		}
+		return nil
+	}
+}
+
 const (
-	RedpandaYamlTemplateFile = "redpanda.yaml"
-	RedpandaYamlFixupFile    = "redpanda.yaml.fixups"
+	RedpandaYamlTemplateFile     = "redpanda.yaml"
+	RedpandaYamlFixupFile        = "redpanda.yaml.fixups"
+	RedpandaYamlValueSourcesFile = "redpanda.yaml.sources"
 )
 
+// PoolValueSourcesFile is the file holding the value sources of redpanda.yaml
+// specific to the brokers of a node pool, which take precedence over those
+// of [RedpandaYamlValueSourcesFile].
+func PoolValueSourcesFile(pool string) string {
+	return pool + "." + RedpandaYamlValueSourcesFile
+}
+
 func (n *nodeCfg) Template(contents map[string]string) error {
 	rpConfig, err := yaml.Marshal(n.RedpandaYaml)
 	if err != nil {
@@ -99,6 +132,15 @@ func (n *nodeCfg) Template(contents map[string]string) error {
 		return fmt.Errorf("could not serialize node fixups: %w", err)
 	}
 	contents[RedpandaYamlFixupFile] = string(fixups)
+	// Only written when used, to not change the ConfigMaps of existing
+	// clusters.
+	if len(n.sources) > 0 {
+		sources, err := json.Marshal(n.sources)
+		if err != nil {
+			return fmt.Errorf("could not serialize node value sources: %w", err)
+		}
+		contents[RedpandaYamlValueSourcesFile] = string(sources)
+	}
 	return nil
 }
 
@@ -113,6 +113,21 @@ func TestStringSliceProperties(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestExternalSecretRefIsQuoted(t *testing.T) {
+	// a secret name can't break out of the string literal that it's put in
+	name := `x") + readFile("/var/run/secrets/kubernetes.io/serviceaccount/token") + ("`
+
+	config := clusterconfiguration.NewConfig("namespace", nil, nil)
+	config.Cluster.Set("a", vectorizedv1alpha1.ClusterConfigValue{
+		ExternalSecretRefSelector: &vectorizedv1alpha1.ExternalSecretKeySelector{Name: name},
+	})
+
+	_, err := config.ReifyClusterConfiguration(context.TODO(), nil)
+	// the whole name is looked up as a single secret
+	require.ErrorContains(t, err, "external secret references are unsupported")
+	require.ErrorContains(t, err, "serviceaccount/token")
+}
+
 func TestHash_FieldsWithNoHashChange(t *testing.T) {
 	config := clusterconfiguration.NewConfig("namespace", nil, nil)
 	config.Node.Redpanda.SeedServers = []rpkcfg.SeedServer{}
@@ -0,0 +1,248 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package clusterconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/redpanda-data/common-go/rpadmin"
+)
+
+// visibilityDeprecated is the visibility the Admin API reports for
+// properties that are slated for removal.
+const visibilityDeprecated = "deprecated"
+
+// ValidationError is a problem with the value of a single cluster
+// configuration property.
+type ValidationError struct {
+	Key     string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%q: %s", e.Key, e.Message)
+}
+
+// ValidationErrors are the problems found while validating a cluster
+// configuration, sorted by key.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "invalid cluster configuration: " + strings.Join(messages, "; ")
+}
+
+// ValidateClusterConfiguration checks a reified cluster configuration against
+// the schema reported by the Admin API. It returns the problems that would make
+// brokers reject or silently ignore a property, and separately warnings about
+// properties that are deprecated but still accepted.
+//
+// The schema only describes types and enumerations, so numeric values are
+// bound by the range of their type rather than by the limits that brokers
+// may enforce on individual properties.
+func ValidateClusterConfiguration(config map[string]any, schema rpadmin.ConfigSchema) (errs ValidationErrors, deprecated ValidationErrors) {
+	// without a schema, there's nothing to validate against
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	aliases := map[string]string{}
+	for name, metadata := range schema {
+		for _, alias := range metadata.Aliases {
+			aliases[alias] = name
+		}
+	}
+
+	for key, value := range config {
+		name := key
+		if canonical, ok := aliases[key]; ok {
+			name = canonical
+		}
+
+		metadata, ok := schema[name]
+		if !ok {
+			message := "unknown property"
+			if suggestion := closestProperty(key, schema); suggestion != "" {
+				message = fmt.Sprintf("unknown property, did you mean %q?", suggestion)
+			}
+			errs = append(errs, &ValidationError{Key: key, Message: message})
+			continue
+		}
+
+		if message := validateValue(value, &metadata); message != "" {
+			errs = append(errs, &ValidationError{Key: key, Message: message})
+		}
+
+		if metadata.Visibility == visibilityDeprecated {
+			deprecated = append(deprecated, &ValidationError{Key: key, Message: "property is deprecated"})
+		}
+	}
+
+	sortValidationErrors(errs)
+	sortValidationErrors(deprecated)
+
+	return errs, deprecated
+}
+
+func validateValue(value any, metadata *rpadmin.ConfigPropertyMetadata) string {
+	if value == nil {
+		if metadata.Nullable {
+			return ""
+		}
+		return "property is not nullable"
+	}
+
+	if metadata.Type == "array" {
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+			return fmt.Sprintf("expected an array, got %s", describe(value))
+		}
+
+		for i := 0; i < items.Len(); i++ {
+			item := items.Index(i).Interface()
+			if message := validateScalar(item, metadata.Items.Type, metadata.EnumValues); message != "" {
+				return fmt.Sprintf("item %d: %s", i, message)
+			}
+		}
+		return ""
+	}
+
+	return validateScalar(value, metadata.Type, metadata.EnumValues)
+}
+
+func validateScalar(value any, typ string, enum []string) string {
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Sprintf("expected a string, got %s", describe(value))
+		}
+		if len(enum) > 0 && !slices.Contains(enum, s) {
+			return fmt.Sprintf("%q is not one of %s", s, strings.Join(enum, ", "))
+		}
+	case "integer":
+		f, ok := toFloat(value)
+		if !ok {
+			return fmt.Sprintf("expected an integer, got %s", describe(value))
+		}
+		if f != math.Trunc(f) {
+			return fmt.Sprintf("expected an integer, got %v", value)
+		}
+		// float64 can't represent math.MaxInt64 exactly, so compare against 2^63
+		if f < math.MinInt64 || f >= math.MaxInt64 {
+			return fmt.Sprintf("%v is out of the range of a 64-bit integer", value)
+		}
+	case "number":
+		f, ok := toFloat(value)
+		if !ok {
+			return fmt.Sprintf("expected a number, got %s", describe(value))
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return fmt.Sprintf("%v is not a finite number", value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Sprintf("expected a boolean, got %s", describe(value))
+		}
+	}
+	// other types, such as objects, aren't described any further by the
+	// schema and are left for the brokers to validate
+	return ""
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0))).Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func describe(value any) string {
+	switch value.(type) {
+	case string:
+		return fmt.Sprintf("the string %q", value)
+	case bool:
+		return "a boolean"
+	default:
+		if _, ok := toFloat(value); ok {
+			return "a number"
+		}
+		kind := reflect.ValueOf(value).Kind()
+		if kind == reflect.Slice || kind == reflect.Array {
+			return "an array"
+		}
+		if kind == reflect.Map {
+			return "an object"
+		}
+		return fmt.Sprintf("a value of type %T", value)
+	}
+}
+
+// closestProperty returns the property of the schema that the given key is
+// most likely a typo of, if any.
+func closestProperty(key string, schema rpadmin.ConfigSchema) string {
+	// allow roughly one typo for every few characters, up to a limit, so that
+	// short keys don't match arbitrary properties
+	threshold := min(len(key)/4+1, 3)
+
+	best, bestDistance := "", threshold+1
+	for name := range schema {
+		if distance := levenshtein(key, name); distance < bestDistance || (distance == bestDistance && name < best) {
+			best, bestDistance = name, distance
+		}
+	}
+
+	if bestDistance > threshold {
+		return ""
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func sortValidationErrors(errs ValidationErrors) {
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Key < errs[j].Key
+	})
+}
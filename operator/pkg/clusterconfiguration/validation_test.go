@@ -0,0 +1,161 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package clusterconfiguration_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/rpadmin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+)
+
+var validationSchema = rpadmin.ConfigSchema{
+	"log_segment_size":          {Type: "integer"},
+	"log_retention_ms":          {Type: "integer", Nullable: true},
+	"raft_io_timeout_ms":        {Type: "number"},
+	"enable_rack_awareness":     {Type: "boolean"},
+	"cloud_storage_region":      {Type: "string"},
+	"log_cleanup_policy":        {Type: "string", EnumValues: []string{"compact", "delete", "compact,delete"}},
+	"sasl_mechanisms":           {Type: "array", Items: rpadmin.ConfigPropertyItems{Type: "string"}, EnumValues: []string{"SCRAM", "GSSAPI", "OAUTHBEARER"}},
+	"kafka_nodelete_topics":     {Type: "array", Items: rpadmin.ConfigPropertyItems{Type: "string"}},
+	"kafka_throughput_control":  {Type: "array", Items: rpadmin.ConfigPropertyItems{Type: "object"}},
+	"enable_idempotence":        {Type: "boolean", Visibility: "deprecated"},
+	"retention_local_target_ms": {Type: "integer", Aliases: []string{"retention_local_target_ms_default"}},
+}
+
+func TestValidateClusterConfiguration(t *testing.T) {
+	for name, tt := range map[string]struct {
+		config     map[string]any
+		errors     map[string]string
+		deprecated []string
+	}{
+		"valid": {
+			config: map[string]any{
+				"log_segment_size":         int64(1024),
+				"log_retention_ms":         nil,
+				"raft_io_timeout_ms":       1.5,
+				"enable_rack_awareness":    true,
+				"cloud_storage_region":     "us-west-1",
+				"log_cleanup_policy":       "compact,delete",
+				"sasl_mechanisms":          []string{"SCRAM", "OAUTHBEARER"},
+				"kafka_nodelete_topics":    []any{"audit"},
+				"kafka_throughput_control": []any{map[string]any{"name": "first"}},
+			},
+		},
+		"json-numbers": {
+			config: map[string]any{
+				"log_segment_size":   json.Number("1024"),
+				"raft_io_timeout_ms": json.Number("1.5"),
+			},
+		},
+		"alias": {
+			config: map[string]any{"retention_local_target_ms_default": 1000},
+		},
+		"unknown-with-suggestion": {
+			config: map[string]any{"log_segmnet_size": 1024},
+			errors: map[string]string{"log_segmnet_size": `unknown property, did you mean "log_segment_size"?`},
+		},
+		"unknown": {
+			config: map[string]any{"something_else_entirely": 1},
+			errors: map[string]string{"something_else_entirely": "unknown property"},
+		},
+		"type-mismatches": {
+			config: map[string]any{
+				"log_segment_size":      "big",
+				"enable_rack_awareness": "yes",
+				"cloud_storage_region":  12,
+				"kafka_nodelete_topics": "audit",
+			},
+			errors: map[string]string{
+				"log_segment_size":      `expected an integer, got the string "big"`,
+				"enable_rack_awareness": `expected a boolean, got the string "yes"`,
+				"cloud_storage_region":  "expected a string, got a number",
+				"kafka_nodelete_topics": `expected an array, got the string "audit"`,
+			},
+		},
+		"not-nullable": {
+			config: map[string]any{"log_segment_size": nil},
+			errors: map[string]string{"log_segment_size": "property is not nullable"},
+		},
+		"enums": {
+			config: map[string]any{
+				"log_cleanup_policy": "remove",
+				"sasl_mechanisms":    []string{"SCRAM", "PLAIN"},
+			},
+			errors: map[string]string{
+				"log_cleanup_policy": `"remove" is not one of compact, delete, compact,delete`,
+				"sasl_mechanisms":    `item 1: "PLAIN" is not one of SCRAM, GSSAPI, OAUTHBEARER`,
+			},
+		},
+		"bounds": {
+			config: map[string]any{
+				"log_segment_size":   1.5,
+				"log_retention_ms":   math.Pow(2, 64),
+				"raft_io_timeout_ms": math.Inf(1),
+			},
+			errors: map[string]string{
+				"log_segment_size":   "expected an integer, got 1.5",
+				"log_retention_ms":   "1.8446744073709552e+19 is out of the range of a 64-bit integer",
+				"raft_io_timeout_ms": "+Inf is not a finite number",
+			},
+		},
+		"deprecated": {
+			config:     map[string]any{"enable_idempotence": true},
+			deprecated: []string{"enable_idempotence"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			errs, deprecated := clusterconfiguration.ValidateClusterConfiguration(tt.config, validationSchema)
+
+			actual := map[string]string{}
+			for _, err := range errs {
+				actual[err.Key] = err.Message
+			}
+			if tt.errors == nil {
+				tt.errors = map[string]string{}
+			}
+			require.Equal(t, tt.errors, actual)
+
+			var deprecatedKeys []string
+			for _, err := range deprecated {
+				deprecatedKeys = append(deprecatedKeys, err.Key)
+			}
+			require.Equal(t, tt.deprecated, deprecatedKeys)
+		})
+	}
+}
+
+func TestValidateClusterConfigurationWithoutSchema(t *testing.T) {
+	errs, deprecated := clusterconfiguration.ValidateClusterConfiguration(map[string]any{"anything": "goes"}, nil)
+	require.Empty(t, errs)
+	require.Empty(t, deprecated)
+}
+
+func TestReifyReportsEveryInvalidProperty(t *testing.T) {
+	config := clusterconfiguration.NewConfig("namespace", nil, nil)
+	config.Cluster.SetAdditionalConfiguration("log_segment_size", "big")
+	config.Cluster.SetAdditionalConfiguration("enable_rack_awareness", "maybe")
+	config.Cluster.SetAdditionalConfiguration("cloud_storage_region", "us-west-1")
+
+	_, err := config.ReifyClusterConfiguration(context.TODO(), validationSchema)
+	require.Error(t, err)
+
+	var invalid clusterconfiguration.ValidationErrors
+	require.True(t, errors.As(err, &invalid))
+	require.Len(t, invalid, 2)
+	require.Equal(t, "enable_rack_awareness", invalid[0].Key)
+	require.Equal(t, "log_segment_size", invalid[1].Key)
+}
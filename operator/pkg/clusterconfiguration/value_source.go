@@ -0,0 +1,390 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package clusterconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/config"
+	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
+)
+
+// The pod-scoped variables available to ValueSource expressions, in addition
+// to the functions of [StdLibFactory].
+const (
+	CELOrdinal    = "ordinal"
+	CELPodName    = "podName"
+	CELNodeName   = "nodeName"
+	CELNodeLabels = "nodeLabels"
+	CELPoolName   = "poolName"
+)
+
+// PodScope is what ValueSource expressions know about the broker they are
+// evaluated for.
+type PodScope struct {
+	// Ordinal is the ordinal of the pod within its StatefulSet.
+	Ordinal int64
+	// PodName is the name of the pod.
+	PodName string
+	// NodeName is the name of the Kubernetes node the pod is scheduled on.
+	NodeName string
+	// NodeLabels are the labels of that node, which commonly hold its
+	// topology, e.g. topology.kubernetes.io/zone.
+	NodeLabels map[string]string
+	// PoolName is the name of the node pool the pod belongs to.
+	PoolName string
+}
+
+// PodScopeFor returns the [PodScope] of a pod scheduled on the given node,
+// which may be nil if it isn't known.
+func PodScopeFor(pod *corev1.Pod, node *corev1.Node) (PodScope, error) {
+	// StatefulSet pods are named <statefulset>-<ordinal>
+	i := strings.LastIndex(pod.Name, "-")
+	ordinal, err := strconv.ParseInt(pod.Name[i+1:], 10, 64)
+	if i < 0 || err != nil {
+		return PodScope{}, errors.Newf("cannot determine the ordinal of pod %q", pod.Name)
+	}
+
+	scope := PodScope{
+		Ordinal:    ordinal,
+		PodName:    pod.Name,
+		NodeName:   pod.Spec.NodeName,
+		NodeLabels: map[string]string{},
+		PoolName:   pod.Labels[labels.NodePoolKey],
+	}
+	if node != nil {
+		scope.NodeLabels = node.Labels
+	}
+	return scope, nil
+}
+
+func (s PodScope) activation() map[string]any {
+	nodeLabels := s.NodeLabels
+	if nodeLabels == nil {
+		nodeLabels = map[string]string{}
+	}
+	return map[string]any{
+		CELOrdinal:    s.Ordinal,
+		CELPodName:    s.PodName,
+		CELNodeName:   s.NodeName,
+		CELNodeLabels: nodeLabels,
+		CELPoolName:   s.PoolName,
+	}
+}
+
+// ValueSourceResolver resolves [redpandav1alpha3.ValueSource]s for a single
+// pod, evaluating expressions with the CEL environment of its Factory
+// extended by the variables of its Pod.
+type ValueSourceResolver struct {
+	Reader    k8sclient.Reader
+	Namespace string
+	Factory   CelFactory
+	Pod       PodScope
+}
+
+// ResolveAll resolves every value of the given map, as used by
+// [redpandav1alpha3.BrokerTemplate] for node and rpk configuration. Problems
+// with individual keys are reported together.
+func (r *ValueSourceResolver) ResolveAll(ctx context.Context, sources map[string]redpandav1alpha3.ValueSource) (map[string]any, error) {
+	keys := make([]string, 0, len(sources))
+	for key := range sources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resolved := make(map[string]any, len(sources))
+	var errs []error
+	for _, key := range keys {
+		src := sources[key]
+		value, err := r.Resolve(ctx, &src)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "resolving %q", key))
+			continue
+		}
+		resolved[key] = value
+	}
+
+	return resolved, stderrors.Join(errs...)
+}
+
+// ApplyNodeConfig resolves the given sources, keyed by property of
+// redpanda.yaml, e.g. redpanda.rack, and sets those properties of cfg.
+// Sources that resolve to null leave their property as is.
+func (r *ValueSourceResolver) ApplyNodeConfig(ctx context.Context, cfg *config.RedpandaYaml, sources map[string]redpandav1alpha3.ValueSource) error {
+	resolved, err := r.ResolveAll(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(resolved))
+	for key := range resolved {
+		keys = append(keys, key)
+	}
+	// Elements of lists, e.g. redpanda.advertised_kafka_api[1].address, may
+	// only be appended in order.
+	sort.Slice(keys, func(i, j int) bool { return lessPropertyPath(keys[i], keys[j]) })
+
+	var errs []error
+	for _, key := range keys {
+		var repr string
+		switch value := resolved[key].(type) {
+		case nil:
+			continue
+		case string:
+			// Strings are parsed into the type of the property as done by
+			// `rpk redpanda config set`.
+			repr = value
+		default:
+			// JSON is YAML, which config.Set parses into the type of the
+			// property.
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				errs = append(errs, errors.Wrapf(err, "encoding %q", key))
+				continue
+			}
+			repr = string(encoded)
+		}
+		if err := config.Set(cfg, key, repr); err != nil {
+			errs = append(errs, errors.Wrapf(err, "setting %q", key))
+		}
+	}
+
+	return stderrors.Join(errs...)
+}
+
+// lessPropertyPath orders property paths lexically, except for list indices
+// which are ordered numerically.
+func lessPropertyPath(a, b string) bool {
+	for a != "" && b != "" {
+		i, j := strings.IndexByte(a, '['), strings.IndexByte(b, '[')
+		if i < 0 || j < 0 || a[:i] != b[:j] {
+			break
+		}
+		a, b = a[i+1:], b[j+1:]
+
+		i, j = strings.IndexByte(a, ']'), strings.IndexByte(b, ']')
+		if i < 0 || j < 0 {
+			break
+		}
+		x, errX := strconv.Atoi(a[:i])
+		y, errY := strconv.Atoi(b[:j])
+		if errX != nil || errY != nil {
+			break
+		}
+		if x != y {
+			return x < y
+		}
+		a, b = a[i+1:], b[j+1:]
+	}
+	return a < b
+}
+
+// NodeValueSources returns the value sources, keyed by property of
+// redpanda.yaml, of the node and rpk configuration of a broker template and
+// of the advertised addresses of listeners, either of which may be nil. Node
+// configuration keys are relative to the redpanda section of redpanda.yaml
+// and rpk configuration keys to its rpk section.
+//
+// These sources are evaluated by brokers as they start, see
+// [RedpandaYamlValueSourcesFile]. As brokers aren't permitted to read them,
+// they may not reference ConfigMaps or Secrets.
+func NodeValueSources(listeners *redpandav1alpha3.Listeners, template *redpandav1alpha3.BrokerTemplate) (map[string]redpandav1alpha3.ValueSource, error) {
+	sources := map[string]redpandav1alpha3.ValueSource{}
+
+	if template != nil {
+		for key, src := range template.NodeConfig {
+			sources["redpanda."+key] = src
+		}
+		for key, src := range template.RPKConfig {
+			sources["rpk."+key] = src
+		}
+	}
+
+	if listeners != nil {
+		if err := advertisedValueSources(sources, "redpanda.advertised_rpc_api", listeners.RPC); err != nil {
+			return nil, err
+		}
+		for _, advertised := range []struct {
+			property  string
+			listeners []redpandav1alpha3.Listener
+		}{
+			{property: "redpanda.advertised_kafka_api", listeners: listeners.Kafka},
+			{property: "pandaproxy.advertised_pandaproxy_api", listeners: listeners.HTTP},
+		} {
+			// Only listeners that are advertised are listed.
+			i := 0
+			for _, listener := range advertised.listeners {
+				if listener.AdvertisedHost == nil && listener.AdvertisedPort == nil {
+					continue
+				}
+				property := fmt.Sprintf("%s[%d]", advertised.property, i)
+				sources[property+".name"] = redpandav1alpha3.ValueSource{Value: listener.Name}
+				if err := advertisedValueSources(sources, property, listener); err != nil {
+					return nil, err
+				}
+				i++
+			}
+		}
+		for _, listener := range append(slices.Clone(listeners.Admin), listeners.SchemaRegistry...) {
+			if listener.AdvertisedHost != nil || listener.AdvertisedPort != nil {
+				return nil, errors.Newf("listener %q: admin and schema registry listeners can't be advertised", listener.Name)
+			}
+		}
+	}
+
+	var errs []error
+	for key, src := range sources {
+		if src.ConfigMapKeyRef != nil || src.SecretKeyRef != nil {
+			errs = append(errs, errors.Newf("setting %q: node configuration may not reference ConfigMaps or Secrets", key))
+		}
+	}
+	if err := stderrors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+// advertisedValueSources adds the sources of the address and port that a
+// listener is advertised at under the given property.
+func advertisedValueSources(sources map[string]redpandav1alpha3.ValueSource, property string, listener redpandav1alpha3.Listener) error {
+	switch {
+	case listener.AdvertisedHost == nil && listener.AdvertisedPort == nil:
+		return nil
+	case listener.AdvertisedHost == nil:
+		return errors.Newf("listener %q: advertisedPort requires advertisedHost", listener.Name)
+	}
+
+	sources[property+".address"] = *listener.AdvertisedHost
+	if listener.AdvertisedPort != nil {
+		sources[property+".port"] = *listener.AdvertisedPort
+	} else {
+		sources[property+".port"] = redpandav1alpha3.ValueSource{Value: strconv.Itoa(int(listener.Port))}
+	}
+	return nil
+}
+
+// Resolve returns the value of the given source. Literal values and values
+// read from ConfigMaps and Secrets are strings; expressions may result in
+// any JSON compatible value.
+func (r *ValueSourceResolver) Resolve(ctx context.Context, src *redpandav1alpha3.ValueSource) (any, error) {
+	switch {
+	case src.Expr != "":
+		return r.Eval(ctx, src.Expr)
+
+	case src.ConfigMapKeyRef != nil:
+		ref := src.ConfigMapKeyRef
+
+		var cm corev1.ConfigMap
+		if err := r.Reader.Get(ctx, k8sclient.ObjectKey{Namespace: r.Namespace, Name: ref.Name}, &cm); err != nil {
+			if apierrors.IsNotFound(err) && ptr.Deref(ref.Optional, false) {
+				return nil, nil
+			}
+			return nil, errors.WithStack(err)
+		}
+
+		value, ok := cm.Data[ref.Key]
+		if !ok && !ptr.Deref(ref.Optional, false) {
+			return nil, errors.Newf("key %q not found in ConfigMap %q", ref.Key, ref.Name)
+		}
+		return value, nil
+
+	case src.SecretKeyRef != nil:
+		ref := src.SecretKeyRef
+
+		var secret corev1.Secret
+		if err := r.Reader.Get(ctx, k8sclient.ObjectKey{Namespace: r.Namespace, Name: ref.Name}, &secret); err != nil {
+			if apierrors.IsNotFound(err) && ptr.Deref(ref.Optional, false) {
+				return nil, nil
+			}
+			return nil, errors.WithStack(err)
+		}
+
+		value, ok := secret.Data[ref.Key]
+		if !ok && !ptr.Deref(ref.Optional, false) {
+			return nil, errors.Newf("key %q not found in Secret %q", ref.Key, ref.Name)
+		}
+		return string(value), nil
+
+	default:
+		return src.Value, nil
+	}
+}
+
+// Eval compiles and evaluates an expression for the pod of the resolver.
+func (r *ValueSourceResolver) Eval(ctx context.Context, expr redpandav1alpha3.Expr) (any, error) {
+	env, err := r.Factory(reflect.Value{})
+	if err != nil {
+		return nil, errors.Wrap(err, "problem readying CEL engine")
+	}
+
+	env, err = env.Extend(
+		cel.Variable(CELOrdinal, cel.IntType),
+		cel.Variable(CELPodName, cel.StringType),
+		cel.Variable(CELNodeName, cel.StringType),
+		cel.Variable(CELNodeLabels, cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable(CELPoolName, cel.StringType),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem readying CEL engine")
+	}
+
+	ast, issues := env.Compile(string(expr))
+	if issues != nil {
+		return nil, errors.Newf("problem compiling CEL %q: %w", expr, issues.Err())
+	}
+	prog, err := env.Program(ast)
+	if err != nil {
+		return nil, errors.Newf("problem readying CEL program %q: %w", expr, err)
+	}
+
+	result, _, err := prog.ContextEval(ctx, r.Pod.activation())
+	if err != nil {
+		return nil, errors.Newf("problem running CEL expression %q: %w", expr, err)
+	}
+
+	return nativeValue(result)
+}
+
+// nativeValue converts the result of an expression to plain Go values. Lists
+// and maps are converted through their JSON representation.
+func nativeValue(val ref.Val) (any, error) {
+	switch val.Type() {
+	case types.BoolType, types.IntType, types.UintType, types.DoubleType, types.StringType, types.BytesType:
+		return val.Value(), nil
+	case types.NullType:
+		return nil, nil
+	}
+
+	native, err := val.ConvertToNative(reflect.TypeFor[*structpb.Value]())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot convert result of type %s", val.Type().TypeName())
+	}
+	return native.(*structpb.Value).AsInterface(), nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package clusterconfiguration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	redpandav1alpha3 "github.com/redpanda-data/redpanda-operator/operator/api/redpanda/v1alpha3"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
+	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
+)
+
+func TestPodScopeFor(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "redpanda-blue-2", Labels: map[string]string{labels.NodePoolKey: "blue"}},
+		Spec:       corev1.PodSpec{NodeName: "node-a"},
+	}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}}}
+
+	scope, err := clusterconfiguration.PodScopeFor(pod, node)
+	require.NoError(t, err)
+	require.Equal(t, clusterconfiguration.PodScope{
+		Ordinal:    2,
+		PodName:    "redpanda-blue-2",
+		NodeName:   "node-a",
+		NodeLabels: map[string]string{"topology.kubernetes.io/zone": "us-east-1a"},
+		PoolName:   "blue",
+	}, scope)
+
+	_, err = clusterconfiguration.PodScopeFor(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone"}}, nil)
+	require.Error(t, err)
+}
+
+func TestValueSourceResolver(t *testing.T) {
+	ctx := context.Background()
+
+	reader := fake.NewClientBuilder().WithObjects(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cm"}, Data: map[string]string{"domain": "example.com"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "secret"}, Data: map[string][]byte{"password": []byte("hunter2")}},
+	).Build()
+
+	resolver := clusterconfiguration.ValueSourceResolver{
+		Reader:    reader,
+		Namespace: "ns",
+		Factory:   clusterconfiguration.StdLibFactory(ctx, map[string]string{"DOMAIN": "example.com"}, nil),
+		Pod: clusterconfiguration.PodScope{
+			Ordinal:    1,
+			PodName:    "redpanda-1",
+			NodeName:   "node-b",
+			NodeLabels: map[string]string{"topology.kubernetes.io/zone": "us-east-1b"},
+			PoolName:   "default",
+		},
+	}
+
+	resolved, err := resolver.ResolveAll(ctx, map[string]redpandav1alpha3.ValueSource{
+		"literal":        {Value: "value"},
+		"configmap":      {ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cm"}, Key: "domain"}},
+		"secret":         {SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "password"}},
+		"host":           {Expr: `podName + "." + poolName + "." + envString("DOMAIN")`},
+		"port":           {Expr: `30092 + ordinal`},
+		"rack":           {Expr: `nodeLabels["topology.kubernetes.io/zone"]`},
+		"seeds":          {Expr: `[0, 1, 2].map(i, "redpanda-" + string(i))`},
+		"node":           {Expr: `{"name": nodeName}`},
+		"optional-unset": {ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "key", Optional: ptr.To(true)}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"literal":        "value",
+		"configmap":      "example.com",
+		"secret":         "hunter2",
+		"host":           "redpanda-1.default.example.com",
+		"port":           int64(30093),
+		"rack":           "us-east-1b",
+		"seeds":          []any{"redpanda-0", "redpanda-1", "redpanda-2"},
+		"node":           map[string]any{"name": "node-b"},
+		"optional-unset": nil,
+	}, resolved)
+
+	_, err = resolver.ResolveAll(ctx, map[string]redpandav1alpha3.ValueSource{
+		"invalid":   {Expr: `ordinal +`},
+		"unknown":   {Expr: `nodeLabels["missing"]`},
+		"missing":   {SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "key"}},
+		"valid-one": {Value: "ok"},
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, `resolving "invalid"`)
+	require.ErrorContains(t, err, `resolving "unknown"`)
+	require.ErrorContains(t, err, `resolving "missing"`)
+	require.NotContains(t, err.Error(), "valid-one")
+}
+
+func TestNodeValueSources(t *testing.T) {
+	host := redpandav1alpha3.ValueSource{Expr: `podName + ".example.com"`}
+	port := redpandav1alpha3.ValueSource{Expr: `30092 + ordinal`}
+
+	sources, err := clusterconfiguration.NodeValueSources(&redpandav1alpha3.Listeners{
+		RPC: redpandav1alpha3.Listener{Name: "rpc", Port: 33145, AdvertisedHost: &host},
+		Kafka: []redpandav1alpha3.Listener{
+			{Name: "internal", Port: 9093},
+			{Name: "external", Port: 9094, AdvertisedHost: &host, AdvertisedPort: &port},
+		},
+	}, &redpandav1alpha3.BrokerTemplate{
+		NodeConfig: map[string]redpandav1alpha3.ValueSource{"rack": {Expr: `nodeLabels["topology.kubernetes.io/zone"]`}},
+		RPKConfig:  map[string]redpandav1alpha3.ValueSource{"tune_aio_events": {Value: "true"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]redpandav1alpha3.ValueSource{
+		"redpanda.rack":                            {Expr: `nodeLabels["topology.kubernetes.io/zone"]`},
+		"rpk.tune_aio_events":                      {Value: "true"},
+		"redpanda.advertised_rpc_api.address":      host,
+		"redpanda.advertised_rpc_api.port":         {Value: "33145"},
+		"redpanda.advertised_kafka_api[0].name":    {Value: "external"},
+		"redpanda.advertised_kafka_api[0].address": host,
+		"redpanda.advertised_kafka_api[0].port":    port,
+	}, sources)
+
+	for name, tc := range map[string]struct {
+		listeners redpandav1alpha3.Listeners
+		template  redpandav1alpha3.BrokerTemplate
+		err       string
+	}{
+		"port-without-host": {
+			listeners: redpandav1alpha3.Listeners{Kafka: []redpandav1alpha3.Listener{{Name: "external", AdvertisedPort: &port}}},
+			err:       `listener "external": advertisedPort requires advertisedHost`,
+		},
+		"advertised-admin": {
+			listeners: redpandav1alpha3.Listeners{Admin: []redpandav1alpha3.Listener{{Name: "admin", AdvertisedHost: &host}}},
+			err:       `listener "admin": admin and schema registry listeners can't be advertised`,
+		},
+		"secret": {
+			template: redpandav1alpha3.BrokerTemplate{NodeConfig: map[string]redpandav1alpha3.ValueSource{
+				"rack": {SecretKeyRef: &corev1.SecretKeySelector{Key: "rack"}},
+			}},
+			err: `setting "redpanda.rack": node configuration may not reference ConfigMaps or Secrets`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := clusterconfiguration.NodeValueSources(&tc.listeners, &tc.template)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
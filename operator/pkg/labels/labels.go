@@ -36,6 +36,11 @@ const (
 	// PodNodeIDKey is used to store the Redpanda NodeID of this pod.
 	PodNodeIDKey = "operator.redpanda.com/node-id"
 
+	// PodRestartRequestedKey is set on a broker's pod, e.g. by its sidecar
+	// once the broker's certificates have been rotated, to request the
+	// operator to restart it.
+	PodRestartRequestedKey = "operator.redpanda.com/restart-requested"
+
 	// NodePoolSpecKey is used to store the NodePoolSpec in a StatefulSet's annotations.
 	// This allows the operator to correctly reconstruct a NodePoolSpec even
 	// after it was removed from Spec already.
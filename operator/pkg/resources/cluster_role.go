@@ -86,7 +86,7 @@ func (r *ClusterRoleResource) obj() k8sclient.Object {
 			{
 				Verbs:     []string{"get"},
 				APIGroups: []string{corev1.GroupName},
-				Resources: []string{"nodes"},
+				Resources: []string{"nodes", "pods"},
 			},
 		},
 	}
@@ -41,6 +41,7 @@ import (
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/clusterconfiguration"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/labels"
 	resourcetypes "github.com/redpanda-data/redpanda-operator/operator/pkg/resources/types"
+	pkgsecrets "github.com/redpanda-data/redpanda-operator/operator/pkg/secrets"
 	"github.com/redpanda-data/redpanda-operator/operator/pkg/utils"
 )
 
@@ -95,6 +96,8 @@ type ConfiguratorSettings struct {
 	CloudSecretsAWSRoleARN       string
 	CloudSecretsGCPProjectID     string
 	CloudSecretsAzureKeyVaultURI string
+	CloudSecretsVault            pkgsecrets.VaultConfiguration
+	CloudSecretsK8sNamespace     string
 }
 
 // StatefulSetResource is part of the reconciliation of redpanda.vectorized.io CRD
@@ -796,6 +799,10 @@ func (r *StatefulSetResource) getConfiguratorArgs() []string {
 		if r.configuratorSettings.CloudSecretsAzureKeyVaultURI != "" {
 			result = append(result, fmt.Sprintf("--cloud-secrets-azure-key-vault-uri=%s", r.configuratorSettings.CloudSecretsAzureKeyVaultURI))
 		}
+		result = append(result, r.configuratorSettings.CloudSecretsVault.ConfiguratorArgs()...)
+		if r.configuratorSettings.CloudSecretsK8sNamespace != "" {
+			result = append(result, fmt.Sprintf("--cloud-secrets-kubernetes-namespace=%s", r.configuratorSettings.CloudSecretsK8sNamespace))
+		}
 	}
 	return result
 }
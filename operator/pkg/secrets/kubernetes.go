@@ -0,0 +1,80 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/redpanda-data/common-go/secrets"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type kubernetesSecretsManager struct {
+	reader    client.Reader
+	namespace string
+	logger    *slog.Logger
+}
+
+// NewKubernetesSecretsManager creates a secret API for the Kubernetes Secrets
+// of a namespace, usually other than that of the cluster. Secrets are returned
+// as JSON objects of their keys, so a single key of a secret is referenced as
+// <name>.<key>.
+//
+// No RBAC is generated for Redpanda's pods to read Secrets of that namespace,
+// as neither the charts nor the operator manage objects outside of the
+// cluster's namespace. Users must grant the service account of the pods that
+// run bootstrap or configurator with this backend get on secrets there, e.g.
+// through a Role and RoleBinding in that namespace. The operator's own access
+// is granted by the rbac.secretNamespaces value of its chart.
+func NewKubernetesSecretsManager(logger *slog.Logger, reader client.Reader, namespace string) secrets.SecretAPI {
+	return &kubernetesSecretsManager{
+		reader:    reader,
+		namespace: namespace,
+		logger:    logger,
+	}
+}
+
+func (k *kubernetesSecretsManager) GetSecretValue(ctx context.Context, key string) (string, bool) {
+	var secret corev1.Secret
+	if err := k.reader.Get(ctx, client.ObjectKey{Namespace: k.namespace, Name: key}, &secret); err != nil {
+		switch {
+		case apierrors.IsNotFound(err):
+		case apierrors.IsForbidden(err):
+			k.logger.With("error", err, "key", key, "namespace", k.namespace).Error("Not permitted to get secret, the service account must be granted get on secrets in its namespace")
+		default:
+			k.logger.With("error", err, "key", key).Error("Failed to look up secret")
+		}
+		return "", false
+	}
+
+	data := make(map[string]string, len(secret.Data)+len(secret.StringData))
+	for name, value := range secret.Data {
+		data[name] = string(value)
+	}
+	for name, value := range secret.StringData {
+		data[name] = value
+	}
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		k.logger.With("error", err, "key", key).Error("Failed to encode secret")
+		return "", false
+	}
+	return string(value), true
+}
+
+func (k *kubernetesSecretsManager) CheckSecretExists(ctx context.Context, key string) bool {
+	var secret corev1.Secret
+	return k.reader.Get(ctx, client.ObjectKey{Namespace: k.namespace, Name: key}, &secret) == nil
+}
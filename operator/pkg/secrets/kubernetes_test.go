@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/redpanda-data/common-go/secrets"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestKubernetesSecretsManager(t *testing.T) {
+	ctx := context.Background()
+
+	reader := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "secrets", Name: "cloud-storage"},
+			Data:       map[string][]byte{"secret_key": []byte("hunter2")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "redpanda", Name: "other-namespace"},
+			Data:       map[string][]byte{"secret_key": []byte("nope")},
+		},
+	).Build()
+
+	api := NewKubernetesSecretsManager(slog.Default(), reader, "secrets")
+
+	value, found := api.GetSecretValue(ctx, "cloud-storage")
+	require.True(t, found)
+	require.JSONEq(t, `{"secret_key": "hunter2"}`, value)
+
+	require.True(t, api.CheckSecretExists(ctx, "cloud-storage"))
+	require.False(t, api.CheckSecretExists(ctx, "other-namespace"))
+
+	_, found = api.GetSecretValue(ctx, "other-namespace")
+	require.False(t, found)
+
+	forbidden := NewKubernetesSecretsManager(slog.Default(), interceptor.NewClient(reader, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			return apierrors.NewForbidden(corev1.Resource("secrets"), key.Name, errors.New("no RBAC"))
+		},
+	}), "secrets")
+	_, found = forbidden.GetSecretValue(ctx, "cloud-storage")
+	require.False(t, found)
+
+	expander := NewCloudExpanderFromAPI(mustProvider(t, api, ""))
+	secretKey, err := expander.Expand(ctx, "cloud-storage.secret_key")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", secretKey)
+}
+
+func mustProvider(t *testing.T, api secrets.SecretAPI, prefix string) secrets.SecretAPI {
+	provider, err := secrets.NewSecretProvider(api, prefix, "")
+	require.NoError(t, err)
+	return provider
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/go-logr/logr"
 	"github.com/redpanda-data/common-go/secrets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -22,6 +24,23 @@ type ExpanderCloudConfiguration struct {
 	AWSRoleARN       string
 	GCPProjectID     string
 	AzureKeyVaultURI string
+	Vault            VaultConfiguration
+	// KubernetesNamespace is the namespace of Kubernetes Secrets to read.
+	KubernetesNamespace string
+}
+
+// SecretBackend creates the [secrets.SecretAPI] of a secret store if the
+// configuration selects it, and returns nil otherwise.
+type SecretBackend func(ctx context.Context, logger *slog.Logger, cloudConfig ExpanderCloudConfiguration) (secrets.SecretAPI, error)
+
+// SecretBackends are the secret stores that a CloudExpander can read from.
+// The first one selected by the configuration is used.
+var SecretBackends = []SecretBackend{
+	awsBackend,
+	gcpBackend,
+	azureBackend,
+	vaultBackend,
+	kubernetesBackend,
 }
 
 // NewCloudExpander creates a new CloudExpander
@@ -34,37 +53,20 @@ func NewCloudExpander(
 	slogLogger := slog.New(logr.ToSlogHandler(logger.WithName("slog").WithValues("mode", "slog")))
 
 	var secretsAPI secrets.SecretAPI
-	var err error
-	if cloudConfig.AWSRegion != "" {
-		secretsAPI, err = secrets.NewAWSSecretsManager(
-			ctx,
-			slogLogger,
-			cloudConfig.AWSRegion,
-			cloudConfig.AWSRoleARN,
-		)
-		if err != nil {
-			return nil, err
-		}
-	} else if cloudConfig.GCPProjectID != "" {
-		secretsAPI, err = secrets.NewGCPSecretsManager(
-			ctx,
-			slogLogger,
-			cloudConfig.GCPProjectID,
-		)
+	for _, backend := range SecretBackends {
+		var err error
+		secretsAPI, err = backend(ctx, slogLogger, cloudConfig)
 		if err != nil {
 			return nil, err
 		}
-	} else if cloudConfig.AzureKeyVaultURI != "" {
-		secretsAPI, err = secrets.NewAzSecretsManager(
-			slogLogger,
-			cloudConfig.AzureKeyVaultURI,
-		)
-		if err != nil {
-			return nil, err
+		if secretsAPI != nil {
+			break
 		}
-	} else {
+	}
+	if secretsAPI == nil {
 		return nil, errors.New("incorrect cloud secret store configuration")
 	}
+
 	provider, err := secrets.NewSecretProvider(secretsAPI, prefix, "")
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -72,6 +74,49 @@ func NewCloudExpander(
 	return &CloudExpander{client: provider, logger: slogLogger}, nil
 }
 
+func awsBackend(ctx context.Context, logger *slog.Logger, cloudConfig ExpanderCloudConfiguration) (secrets.SecretAPI, error) {
+	if cloudConfig.AWSRegion == "" {
+		return nil, nil
+	}
+	return secrets.NewAWSSecretsManager(ctx, logger, cloudConfig.AWSRegion, cloudConfig.AWSRoleARN)
+}
+
+func gcpBackend(ctx context.Context, logger *slog.Logger, cloudConfig ExpanderCloudConfiguration) (secrets.SecretAPI, error) {
+	if cloudConfig.GCPProjectID == "" {
+		return nil, nil
+	}
+	return secrets.NewGCPSecretsManager(ctx, logger, cloudConfig.GCPProjectID)
+}
+
+func azureBackend(_ context.Context, logger *slog.Logger, cloudConfig ExpanderCloudConfiguration) (secrets.SecretAPI, error) {
+	if cloudConfig.AzureKeyVaultURI == "" {
+		return nil, nil
+	}
+	return secrets.NewAzSecretsManager(logger, cloudConfig.AzureKeyVaultURI)
+}
+
+func vaultBackend(_ context.Context, logger *slog.Logger, cloudConfig ExpanderCloudConfiguration) (secrets.SecretAPI, error) {
+	if cloudConfig.Vault.Address == "" {
+		return nil, nil
+	}
+	return NewVaultSecretsManager(logger, cloudConfig.Vault)
+}
+
+func kubernetesBackend(_ context.Context, logger *slog.Logger, cloudConfig ExpanderCloudConfiguration) (secrets.SecretAPI, error) {
+	if cloudConfig.KubernetesNamespace == "" {
+		return nil, nil
+	}
+	restConfig, err := config.GetConfig()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return NewKubernetesSecretsManager(logger, c, cloudConfig.KubernetesNamespace), nil
+}
+
 // NewCloudExpanderFromAPI creates a new CloudExpander
 func NewCloudExpanderFromAPI(api secrets.SecretAPI) *CloudExpander {
 	return &CloudExpander{client: api}
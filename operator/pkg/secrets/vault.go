@@ -0,0 +1,239 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redpanda-data/common-go/secrets"
+)
+
+const (
+	// DefaultVaultAuthMount is where the Kubernetes auth method is mounted
+	// unless configured otherwise.
+	DefaultVaultAuthMount = "kubernetes"
+	// DefaultVaultKVMount is where the KV v2 secrets engine is mounted
+	// unless configured otherwise.
+	DefaultVaultKVMount = "secret"
+	// DefaultVaultTokenPath is the service account token that is used to log
+	// in to Vault unless configured otherwise.
+	DefaultVaultTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec // this is a path, not a credential
+)
+
+// VaultConfiguration configures reading secrets from the KV v2 secrets
+// engine of HashiCorp Vault, logging in through its Kubernetes auth method.
+type VaultConfiguration struct {
+	// Address of the Vault server, e.g. https://vault.vault.svc:8200.
+	Address string
+	// Role is the role to log in as.
+	Role string
+	// AuthMount is the path that the Kubernetes auth method is mounted at.
+	AuthMount string
+	// KVMount is the path that the KV v2 secrets engine is mounted at.
+	KVMount string
+	// TokenPath is the service account token to log in with.
+	TokenPath string
+}
+
+// ConfiguratorArgs returns the flags that pass this configuration on to
+// the configurator and bootstrap commands.
+func (c VaultConfiguration) ConfiguratorArgs() []string {
+	if c.Address == "" {
+		return nil
+	}
+
+	args := []string{
+		fmt.Sprintf("--cloud-secrets-vault-address=%s", c.Address),
+		fmt.Sprintf("--cloud-secrets-vault-role=%s", c.Role),
+	}
+	if c.AuthMount != "" {
+		args = append(args, fmt.Sprintf("--cloud-secrets-vault-auth-mount=%s", c.AuthMount))
+	}
+	if c.KVMount != "" {
+		args = append(args, fmt.Sprintf("--cloud-secrets-vault-kv-mount=%s", c.KVMount))
+	}
+	if c.TokenPath != "" {
+		args = append(args, fmt.Sprintf("--cloud-secrets-vault-token-path=%s", c.TokenPath))
+	}
+	return args
+}
+
+type vaultSecretsManager struct {
+	config VaultConfiguration
+	client *http.Client
+	logger *slog.Logger
+
+	mu    sync.Mutex
+	token string
+}
+
+// NewVaultSecretsManager creates a secret API for the KV v2 secrets engine of
+// HashiCorp Vault. Secrets are returned as JSON objects of their keys, so a
+// single key of a secret is referenced as <path>.<key>.
+func NewVaultSecretsManager(logger *slog.Logger, config VaultConfiguration) (secrets.SecretAPI, error) {
+	if config.Address == "" || config.Role == "" {
+		return nil, errors.New("both the address of Vault and a role to log in as are required")
+	}
+	if config.AuthMount == "" {
+		config.AuthMount = DefaultVaultAuthMount
+	}
+	if config.KVMount == "" {
+		config.KVMount = DefaultVaultKVMount
+	}
+	if config.TokenPath == "" {
+		config.TokenPath = DefaultVaultTokenPath
+	}
+	config.Address = strings.TrimSuffix(config.Address, "/")
+
+	return &vaultSecretsManager{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+		logger: logger,
+	}, nil
+}
+
+func (v *vaultSecretsManager) GetSecretValue(ctx context.Context, key string) (string, bool) {
+	var response struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+
+	found, err := v.get(ctx, fmt.Sprintf("/v1/%s/data/%s", v.config.KVMount, key), &response)
+	if err != nil {
+		v.logger.With("error", err, "key", key).Error("Failed to look up secret")
+		return "", false
+	}
+	if !found {
+		return "", false
+	}
+
+	value, err := json.Marshal(response.Data.Data)
+	if err != nil {
+		v.logger.With("error", err, "key", key).Error("Failed to encode secret")
+		return "", false
+	}
+	return string(value), true
+}
+
+func (v *vaultSecretsManager) CheckSecretExists(ctx context.Context, key string) bool {
+	found, err := v.get(ctx, fmt.Sprintf("/v1/%s/metadata/%s", v.config.KVMount, key), nil)
+	return err == nil && found
+}
+
+// get reads from the Vault API, logging in again once if the current token
+// has been rejected, e.g. because it expired.
+func (v *vaultSecretsManager) get(ctx context.Context, path string, into any) (bool, error) {
+	for attempt := 0; ; attempt++ {
+		token, err := v.loginToken(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.config.Address+path, nil)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+		req.Header.Set("X-Vault-Token", token)
+
+		resp, err := v.client.Do(req)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusForbidden && attempt == 0:
+			v.mu.Lock()
+			v.token = ""
+			v.mu.Unlock()
+			continue
+		case resp.StatusCode == http.StatusNotFound:
+			return false, nil
+		case resp.StatusCode != http.StatusOK:
+			return false, errors.Newf("unexpected response from Vault: %s: %s", resp.Status, body)
+		}
+
+		if into != nil {
+			if err := json.Unmarshal(body, into); err != nil {
+				return false, errors.Wrap(err, "decoding response from Vault")
+			}
+		}
+		return true, nil
+	}
+}
+
+func (v *vaultSecretsManager) loginToken(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.token != "" {
+		return v.token, nil
+	}
+
+	jwt, err := os.ReadFile(v.config.TokenPath)
+	if err != nil {
+		return "", errors.Wrap(err, "reading service account token")
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"role": v.config.Role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/auth/%s/login", v.config.Address, v.config.AuthMount), bytes.NewReader(payload))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.Newf("logging in to Vault as %q: %s: %s", v.config.Role, resp.Status, body)
+	}
+
+	var login struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&login); err != nil {
+		return "", errors.Wrap(err, "decoding Vault login response")
+	}
+	if login.Auth.ClientToken == "" {
+		return "", errors.New("Vault login response contains no token")
+	}
+
+	v.token = login.Auth.ClientToken
+	return v.token, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// vaultStub fakes the Kubernetes auth method and KV v2 secrets engine of
+// Vault, issuing a new token on every login.
+type vaultStub struct {
+	t       *testing.T
+	secrets map[string]map[string]any
+	logins  int
+	token   string
+}
+
+func (s *vaultStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/v1/auth/k8s/login" {
+		var login map[string]string
+		require.NoError(s.t, json.NewDecoder(r.Body).Decode(&login))
+		require.Equal(s.t, map[string]string{"role": "redpanda", "jwt": "service-account-token"}, login)
+
+		s.logins++
+		s.token = "token-" + string(rune('0'+s.logins))
+		require.NoError(s.t, json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{"client_token": s.token}}))
+		return
+	}
+
+	if r.Header.Get("X-Vault-Token") != s.token {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	for path, data := range s.secrets {
+		switch r.URL.Path {
+		case "/v1/kv/data/" + path:
+			require.NoError(s.t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}}))
+			return
+		case "/v1/kv/metadata/" + path:
+			require.NoError(s.t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{}}))
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func TestVaultSecretsManager(t *testing.T) {
+	ctx := context.Background()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("service-account-token\n"), 0o600))
+
+	stub := &vaultStub{t: t, secrets: map[string]map[string]any{
+		"redpanda/cloud-storage": {"access_key": "AKIA", "secret_key": "hunter2"},
+	}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	api, err := NewVaultSecretsManager(slog.Default(), VaultConfiguration{
+		Address:   server.URL + "/",
+		Role:      "redpanda",
+		AuthMount: "k8s",
+		KVMount:   "kv",
+		TokenPath: tokenPath,
+	})
+	require.NoError(t, err)
+
+	value, found := api.GetSecretValue(ctx, "redpanda/cloud-storage")
+	require.True(t, found)
+	require.JSONEq(t, `{"access_key": "AKIA", "secret_key": "hunter2"}`, value)
+
+	require.True(t, api.CheckSecretExists(ctx, "redpanda/cloud-storage"))
+	require.False(t, api.CheckSecretExists(ctx, "redpanda/missing"))
+
+	_, found = api.GetSecretValue(ctx, "redpanda/missing")
+	require.False(t, found)
+
+	// tokens are reused until Vault rejects them
+	require.Equal(t, 1, stub.logins)
+	stub.token = "expired"
+	_, found = api.GetSecretValue(ctx, "redpanda/cloud-storage")
+	require.True(t, found)
+	require.Equal(t, 2, stub.logins)
+
+	// keys of secrets are selected through the secret provider
+	expander := NewCloudExpanderFromAPI(mustProvider(t, api, "redpanda/"))
+	secretKey, err := expander.Expand(ctx, "cloud-storage.secret_key")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", secretKey)
+}
+
+func TestVaultConfiguration(t *testing.T) {
+	_, err := NewVaultSecretsManager(slog.Default(), VaultConfiguration{Address: "https://vault:8200"})
+	require.Error(t, err)
+
+	require.Nil(t, VaultConfiguration{}.ConfiguratorArgs())
+	require.Equal(t, []string{
+		"--cloud-secrets-vault-address=https://vault:8200",
+		"--cloud-secrets-vault-role=redpanda",
+		"--cloud-secrets-vault-kv-mount=kv",
+		"--cloud-secrets-vault-token-path=/var/run/secrets/vault/token",
+	}, VaultConfiguration{Address: "https://vault:8200", Role: "redpanda", KVMount: "kv", TokenPath: "/var/run/secrets/vault/token"}.ConfiguratorArgs())
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// SourceMap maps the lines of a chart's templates, as transpiled by gotohelm,
+// back to the go source they were transpiled from.
+type SourceMap struct {
+	// Templates holds the source map of each transpiled template, keyed by
+	// its file name within the chart's templates directory.
+	Templates map[string]TemplateSourceMap `json:"templates"`
+}
+
+// TemplateSourceMap maps the lines of a single transpiled template back to
+// the go file it was transpiled from.
+type TemplateSourceMap struct {
+	// Source is the name of the go file the template was transpiled from.
+	Source string `json:"source"`
+	// Mappings holds the go source of every line of the template that has
+	// one, ordered by line.
+	Mappings []SourceMapping `json:"mappings"`
+}
+
+// SourceMapping maps a line of a transpiled template to the go source it was
+// transpiled from.
+type SourceMapping struct {
+	// Line is the 1-indexed line of the template.
+	Line int `json:"line"`
+	// SourceLine is the 1-indexed line of the go file.
+	SourceLine int `json:"sourceLine"`
+	// Function is the name of the template, as it would be included, that
+	// the line belongs to.
+	Function string `json:"function"`
+}
+
+// LoadSourceMap reads a [SourceMap], as written by gotohelm's -sourcemap
+// flag, from the given path.
+func LoadSourceMap(path string) (*SourceMap, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceMap SourceMap
+	if err := json.Unmarshal(data, &sourceMap); err != nil {
+		return nil, err
+	}
+
+	return &sourceMap, nil
+}
+
+// Lookup returns the go source of the given line of a template.
+func (m *SourceMap) Lookup(template string, line int) (source string, mapping SourceMapping, ok bool) {
+	tpl, ok := m.Templates[template]
+	if !ok {
+		return "", SourceMapping{}, false
+	}
+
+	i, ok := slices.BinarySearchFunc(tpl.Mappings, line, func(m SourceMapping, line int) int {
+		return m.Line - line
+	})
+	if !ok {
+		return "", SourceMapping{}, false
+	}
+
+	return tpl.Source, tpl.Mappings[i], true
+}
+
+// Annotate rewrites any references to lines of the chart's transpiled
+// templates within msg, such as those in the errors reported by helm, to
+// include the go source they were transpiled from. chart is the path that
+// helm prefixes the chart's templates with, e.g. redpanda or
+// redpanda/charts/console for a subchart.
+//
+//	template: redpanda/templates/_helpers.go.tpl:522:45: executing "redpanda.mergeSliceBy" ...
+//
+// Will get rewritten to:
+//
+//	template: redpanda/templates/_helpers.go.tpl:522:45 (redpanda.mergeSliceBy at helpers.go:213): executing "redpanda.mergeSliceBy" ...
+func (m *SourceMap) Annotate(chart, msg string) string {
+	re := regexp.MustCompile(regexp.QuoteMeta(chart) + `/templates/([^\s:()"]+):(\d+)(?::\d+)?`)
+
+	return re.ReplaceAllStringFunc(msg, func(ref string) string {
+		groups := re.FindStringSubmatch(ref)
+
+		line, err := strconv.Atoi(groups[2])
+		if err != nil {
+			return ref
+		}
+
+		source, mapping, ok := m.Lookup(groups[1], line)
+		if !ok {
+			return ref
+		}
+
+		return fmt.Sprintf("%s (%s at %s:%d)", ref, mapping.Function, source, mapping.SourceLine)
+	})
+}
+
+// AnnotateError is [SourceMap.Annotate] for errors. The returned error wraps
+// err.
+func (m *SourceMap) AnnotateError(chart string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &sourceMappedError{msg: m.Annotate(chart, err.Error()), err: err}
+}
+
+type sourceMappedError struct {
+	msg string
+	err error
+}
+
+func (e *sourceMappedError) Error() string {
+	return e.msg
+}
+
+func (e *sourceMappedError) Unwrap() error {
+	return e.err
+}
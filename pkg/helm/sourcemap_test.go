@@ -0,0 +1,57 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package helm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceMapAnnotate(t *testing.T) {
+	sourceMap := &SourceMap{Templates: map[string]TemplateSourceMap{
+		"_helpers.go.tpl": {
+			Source: "helpers.go",
+			Mappings: []SourceMapping{
+				{Line: 3, SourceLine: 10, Function: "redpanda.Fullname"},
+				{Line: 4, SourceLine: 12, Function: "redpanda.Fullname"},
+				{Line: 522, SourceLine: 213, Function: "redpanda.mergeSliceBy"},
+			},
+		},
+	}}
+
+	for _, tc := range []struct {
+		In  string
+		Out string
+	}{
+		{
+			In:  `template: redpanda/templates/_helpers.go.tpl:522:45: executing "redpanda.mergeSliceBy" at <include>: error calling include`,
+			Out: `template: redpanda/templates/_helpers.go.tpl:522:45 (redpanda.mergeSliceBy at helpers.go:213): executing "redpanda.mergeSliceBy" at <include>: error calling include`,
+		},
+		{
+			In:  `parse error at (redpanda/templates/_helpers.go.tpl:4): unexpected EOF`,
+			Out: `parse error at (redpanda/templates/_helpers.go.tpl:4 (redpanda.Fullname at helpers.go:12)): unexpected EOF`,
+		},
+		{
+			// Unmapped lines, templates, and charts are left as is.
+			In:  `template: redpanda/templates/_helpers.go.tpl:1:1: redpanda/templates/notes.txt:4:1: console/templates/_helpers.go.tpl:4:1`,
+			Out: `template: redpanda/templates/_helpers.go.tpl:1:1: redpanda/templates/notes.txt:4:1: console/templates/_helpers.go.tpl:4:1`,
+		},
+	} {
+		require.Equal(t, tc.Out, sourceMap.Annotate("redpanda", tc.In))
+	}
+
+	err := errors.New(`template: redpanda/templates/_helpers.go.tpl:3:1: boom`)
+	annotated := sourceMap.AnnotateError("redpanda", err)
+	require.EqualError(t, annotated, `template: redpanda/templates/_helpers.go.tpl:3:1 (redpanda.Fullname at helpers.go:10): boom`)
+	require.ErrorIs(t, annotated, err)
+	require.NoError(t, sourceMap.AnnotateError("redpanda", nil))
+}
@@ -16,6 +16,7 @@
 package rapidutil
 
 import (
+	"math/rand"
 	"reflect"
 	"time"
 
@@ -66,3 +67,23 @@ var (
 		},
 	}
 )
+
+// Rand returns a [rand.Rand] that draws its randomness from t. Anything
+// generated from it, such as values from valuesutil.Generate, is therefore
+// shrunk by rapid upon failure as rapid shrinks the underlying draws towards
+// zero.
+func Rand(t *rapid.T) *rand.Rand {
+	return rand.New(&source{t: t}) //nolint:gosec // Not used for security purposes.
+}
+
+// source is a [rand.Source] backed by a [rapid.T].
+type source struct {
+	t *rapid.T
+}
+
+func (s *source) Int63() int64 {
+	return rapid.Int64Min(0).Draw(s.t, "rand")
+}
+
+// Seed is a no-op, the randomness of source is controlled by rapid.
+func (s *source) Seed(int64) {}
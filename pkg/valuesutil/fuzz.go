@@ -118,7 +118,7 @@ func (g *generator) generate(depth int, s *jsonschema.Schema) any {
 			// boundaries being applied.
 			// max = math.MaxInt64
 		}
-		return int(min) + g.rng.Intn(int(max-min))
+		return between(g.rng, int(min), int(max))
 
 	case "string":
 		if s.Pattern != "" {
@@ -133,7 +133,7 @@ func (g *generator) generate(depth int, s *jsonschema.Schema) any {
 
 		var result string
 		const alphabet = "1234567890abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ!@#$%^&*()-_=+"
-		for i := 0; i < min+g.rng.Intn(max-min); i++ {
+		for i := 0; i < between(g.rng, min, max); i++ {
 			result += string(alphabet[g.rng.Intn(len(alphabet))])
 		}
 		return result
@@ -148,7 +148,7 @@ func (g *generator) generate(depth int, s *jsonschema.Schema) any {
 		}
 
 		items := []any{}
-		for i := 0; i < min+g.rng.Intn(max-min); i++ {
+		for i := 0; i < between(g.rng, min, max); i++ {
 			items = append(items, g.generate(depth, itemSchema))
 		}
 		return items
@@ -208,7 +208,7 @@ func (g *generator) generate(depth int, s *jsonschema.Schema) any {
 			return val
 		}
 
-		for i := len(val); i < min+g.rng.Intn(max-min); i++ {
+		for i := len(val); i < between(g.rng, min, max); i++ {
 			j := g.rng.Intn(len(patterns))
 
 			schema := schemas[j]
@@ -232,6 +232,15 @@ func must[T any](v T, err error) T {
 	return v
 }
 
+// between returns a random integer in [min, max) or min if the range is
+// empty, e.g. when minProperties equals maxProperties.
+func between(rng *rand.Rand, min, max int) int {
+	if max <= min {
+		return min
+	}
+	return min + rng.Intn(max-min)
+}
+
 func pickone[T any](rng *rand.Rand, l []T) T {
 	return l[rng.Intn(len(l))]
 }
@@ -67,6 +67,25 @@ func TestGenerateDeterministic(t *testing.T) {
 	require.NoError(t, quick.CheckEqual(f, f, &quick.Config{}))
 }
 
+// TestGenerateEmptyRanges asserts that [Generate] handles schemas whose
+// minimums and maximums are equal.
+func TestGenerateEmptyRanges(t *testing.T) {
+	schema := &jsonschema.Schema{
+		Type:          "object",
+		MinProperties: ptr.To[uint64](1),
+		MaxProperties: ptr.To[uint64](1),
+		PatternProperties: map[string]*jsonschema.Schema{
+			"^[a-z]+$": {Type: "string", MinLength: ptr.To[uint64](2), MaxLength: ptr.To[uint64](2)},
+		},
+		AdditionalProperties: jsonschema.FalseSchema,
+	}
+
+	for seed := int64(0); seed < 100; seed++ {
+		instance := Generate(rand.New(rand.NewSource(seed)), schema)
+		require.NoError(t, Validate(schema, instance))
+	}
+}
+
 // FuzzGenerate is effectively equivalent to [TestGenerate]'s 1st property with
 // the added benefit that it can save failures as regression cases to
 // ./testdata.